@@ -36,19 +36,44 @@ type BlockChaosAction string
 
 const (
 	BlockDelay BlockChaosAction = "delay"
+	BlockError BlockChaosAction = "error"
+	BlockLimit BlockChaosAction = "limit"
+)
+
+// The injection ids of the actions which are not injected by chaos-driver, whose ids are non-negative
+const (
+	// BlockErrorInjectionID is the id of the error injected by fail_make_request, which is returned
+	// by the chaos-daemon without MaxBlockErrorInjectionID
+	BlockErrorInjectionID = -1
+	// BlockWriteErrorInjectionID is the id of the write-only error injected by bpfki
+	BlockWriteErrorInjectionID = -2
+	// BlockLimitInjectionID is the id of the limit injected by cgroup
+	BlockLimitInjectionID = -3
+	// MaxBlockErrorInjectionID is the max id of the errors injected by fail_make_request. The ids are
+	// allocated downwards from it by chaos-daemon, so the errors could be recovered by the ids even if
+	// the pods have gone
+	MaxBlockErrorInjectionID = -16
 )
 
 // BlockChaosSpec is the content of the specification for a BlockChaos
 type BlockChaosSpec struct {
 	// Action defines the specific block chaos action.
-	// Supported action: delay
-	// +kubebuilder:validation:Enum=delay
+	// Supported action: delay / error / limit
+	// +kubebuilder:validation:Enum=delay;error;limit
 	Action BlockChaosAction `json:"action"`
 
 	// Delay defines the delay distribution.
 	// +optional
 	Delay *BlockDelaySpec `json:"delay,omitempty"`
 
+	// Error defines the percentage of failed io requests.
+	// +optional
+	Error *BlockErrorSpec `json:"error,omitempty"`
+
+	// Limit defines the bandwidth and IOPS caps.
+	// +optional
+	Limit *BlockLimitSpec `json:"limit,omitempty"`
+
 	ContainerNodeVolumePathSelector `json:",inline"`
 
 	// Duration represents the duration of the chaos action.
@@ -72,6 +97,45 @@ type BlockDelaySpec struct {
 	Jitter string `json:"jitter,omitempty" default:"0ms" webhook:"Duration"`
 }
 
+// BlockErrorSpec describes the block error specification
+type BlockErrorSpec struct {
+	// Percent defines the percentage of io requests which fail with EIO.
+	// By default, it's implemented by the fail_make_request fault injection of the kernel, which requires
+	// the kernel to be built with CONFIG_FAIL_MAKE_REQUEST. It fails the requests to the device from every
+	// process on the node, not only the selected container. And the percentage is shared by all the devices
+	// on the node, so it's refused to inject the device which is failing, or the other devices with a
+	// different percentage at the same time.
+	Percent int `json:"percent" webhook:"Percent"`
+
+	// WriteOnly fails only the write requests. It's implemented by the bio-failure of bpfki, which
+	// only fails the requests submitted by the processes of the container, so it doesn't fail the
+	// writeback of the page cache. It requires bpfki to be deployed with chaos-daemon.
+	// +optional
+	WriteOnly bool `json:"writeOnly,omitempty"`
+}
+
+// BlockLimitSpec describes the block limit specification, which is implemented by the io.max of
+// cgroup v2 or the blkio throttling of cgroup v1
+type BlockLimitSpec struct {
+	// ReadBytesPerSec limits the read bandwidth of the container, e.g. 10MB.
+	// +optional
+	ReadBytesPerSec string `json:"readBytesPerSec,omitempty"`
+
+	// WriteBytesPerSec limits the write bandwidth of the container, e.g. 10MB.
+	// +optional
+	WriteBytesPerSec string `json:"writeBytesPerSec,omitempty"`
+
+	// ReadIOPS limits the read io requests per second of the container.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ReadIOPS int64 `json:"readIOPS,omitempty"`
+
+	// WriteIOPS limits the write io requests per second of the container.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	WriteIOPS int64 `json:"writeIOPS,omitempty"`
+}
+
 // ContainerNodeVolumePathSelector is the selector to select a node and a PV on it
 type ContainerNodeVolumePathSelector struct {
 	ContainerSelector `json:",inline"`
@@ -83,9 +147,19 @@ type ContainerNodeVolumePathSelector struct {
 type BlockChaosStatus struct {
 	ChaosStatus `json:",inline"`
 
+	BlockChaosInjections `json:",inline"`
+}
+
+// BlockChaosInjections records the injections of a BlockChaos
+type BlockChaosInjections struct {
 	// InjectionIds always specifies the number of injected chaos action
 	// +optional
 	InjectionIds map[string]int `json:"ids,omitempty"`
+
+	// NodeNames records the node of each injection, so the errors injected by fail_make_request,
+	// which fail the volume on the whole node, could be recovered even if the pods have gone
+	// +optional
+	NodeNames map[string]string `json:"nodeNames,omitempty"`
 }
 
 func (obj *BlockChaos) GetSelectorSpecs() map[string]interface{} {
@@ -95,5 +169,5 @@ func (obj *BlockChaos) GetSelectorSpecs() map[string]interface{} {
 }
 
 func (obj *BlockChaos) GetCustomStatus() interface{} {
-	return &obj.Status.InjectionIds
+	return &obj.Status.BlockChaosInjections
 }
@@ -16,6 +16,9 @@
 package v1alpha1
 
 import (
+	"fmt"
+
+	"github.com/docker/go-units"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -28,5 +31,46 @@ func (in *BlockChaosSpec) Validate(root interface{}, path *field.Path) field.Err
 			allErrs = append(allErrs, field.Invalid(path.Child("delay"), in.Delay, err.Error()))
 		}
 	}
+	if in.Action == BlockError {
+		if in.Error == nil {
+			err := errors.Errorf("error should be set on %s action", in.Action)
+			allErrs = append(allErrs, field.Invalid(path.Child("error"), in.Error, err.Error()))
+		} else if in.Error.Percent <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("error", "percent"), in.Error.Percent, "percent should be greater than 0"))
+		}
+	}
+	if in.Action == BlockLimit {
+		if in.Limit == nil {
+			err := errors.Errorf("limit should be set on %s action", in.Action)
+			allErrs = append(allErrs, field.Invalid(path.Child("limit"), in.Limit, err.Error()))
+		} else {
+			allErrs = append(allErrs, in.Limit.validate(path.Child("limit"))...)
+		}
+	}
+	return allErrs
+}
+
+func (in *BlockLimitSpec) validate(path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, bandwidth := range []struct {
+		name  string
+		value string
+	}{
+		{"readBytesPerSec", in.ReadBytesPerSec},
+		{"writeBytesPerSec", in.WriteBytesPerSec},
+	} {
+		if len(bandwidth.value) == 0 {
+			continue
+		}
+		if _, err := units.FromHumanSize(bandwidth.value); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child(bandwidth.name), bandwidth.value,
+				fmt.Sprintf("incorrect bytes format: %s", err.Error())))
+		}
+	}
+
+	if len(in.ReadBytesPerSec) == 0 && len(in.WriteBytesPerSec) == 0 && in.ReadIOPS == 0 && in.WriteIOPS == 0 {
+		allErrs = append(allErrs, field.Invalid(path, in, "at least one of the bandwidth or IOPS caps should be set"))
+	}
 	return allErrs
 }
@@ -256,6 +256,143 @@ var _ = Describe("blockchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate error",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockError,
+							Error:  nil,
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate error percent",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockError,
+							Error: &BlockErrorSpec{
+								Percent: 101,
+							},
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate error percent",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockError,
+							Error: &BlockErrorSpec{
+								Percent: 0,
+							},
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate error",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockError,
+							Error: &BlockErrorSpec{
+								Percent: 50,
+							},
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate limit",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo18",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockLimit,
+							Limit:  &BlockLimitSpec{},
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate limit bandwidth",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo19",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockLimit,
+							Limit: &BlockLimitSpec{
+								WriteBytesPerSec: "10%",
+							},
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate limit",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo20",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockLimit,
+							Limit: &BlockLimitSpec{
+								ReadBytesPerSec: "10MB",
+								WriteIOPS:       100,
+							},
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -31,8 +31,37 @@ const (
 
 	// RandomAction represents get random IP when send DNS request.
 	RandomAction DNSChaosAction = "random"
+
+	// DNSNXDomainAction represents get NXDOMAIN when send DNS request.
+	DNSNXDomainAction DNSChaosAction = "nxdomain"
+
+	// DNSServFailAction represents get SERVFAIL when send DNS request.
+	DNSServFailAction DNSChaosAction = "servfail"
+
+	// DNSRefusedAction represents get REFUSED when send DNS request.
+	DNSRefusedAction DNSChaosAction = "refused"
+
+	// DNSDelayAction represents get response after a delay when send DNS request.
+	DNSDelayAction DNSChaosAction = "delay"
+
+	// DNSTimeoutAction represents get no response when send DNS request.
+	DNSTimeoutAction DNSChaosAction = "timeout"
+
+	// DNSTruncateAction represents get truncated response when send DNS request over UDP,
+	// which forces the client to retry over TCP.
+	DNSTruncateAction DNSChaosAction = "truncate"
+
+	// DNSFixedAction represents get the fixed IPs when send DNS request.
+	DNSFixedAction DNSChaosAction = "fixed"
 )
 
+// IsServedByChaosDNSServer returns whether the action could be served by the chaos DNS server
+// deployed with chaos mesh. The other actions, and all the actions if the chaos DNS server is
+// not deployed, are served inside the network namespace of the target container by chaos-daemon.
+func (in DNSChaosAction) IsServedByChaosDNSServer() bool {
+	return in == ErrorAction || in == RandomAction
+}
+
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
@@ -60,9 +89,9 @@ var _ InnerObject = (*DNSChaos)(nil)
 // DNSChaosSpec defines the desired state of DNSChaos
 type DNSChaosSpec struct {
 	// Action defines the specific DNS chaos action.
-	// Supported action: error, random
+	// Supported action: error, random, nxdomain, servfail, refused, delay, timeout, truncate, fixed
 	// Default action: error
-	// +kubebuilder:validation:Enum=error;random
+	// +kubebuilder:validation:Enum=error;random;nxdomain;servfail;refused;delay;timeout;truncate;fixed
 	Action DNSChaosAction `json:"action"`
 
 	ContainerSelector `json:",inline"`
@@ -80,14 +109,53 @@ type DNSChaosSpec struct {
 	// +optional
 	DomainNamePatterns []string `json:"patterns,omitempty"`
 
+	// Rules specifies the domain name patterns together with the parameters of the action.
+	// The delay action requires the delay of each rule, and the fixed action requires the
+	// answers of each rule. Rules are not supported by the error and random actions.
+	// +optional
+	Rules []DNSChaosRule `json:"rules,omitempty"`
+
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
 }
 
+// DNSChaosRule specifies the parameters of the DNS chaos action for the domain names matching the pattern
+type DNSChaosRule struct {
+	// Pattern is the domain name pattern, which has the same syntax as the patterns.
+	Pattern string `json:"pattern"`
+
+	// Delay specifies the latency before the DNS request is resolved for the delay action,
+	// such as "100ms" or "2s".
+	// +optional
+	Delay string `json:"delay,omitempty"`
+
+	// Answers specifies the IPv4 or IPv6 addresses responded for the fixed action.
+	// +optional
+	Answers []string `json:"answers,omitempty"`
+}
+
 // DNSChaosStatus defines the observed state of DNSChaos
 type DNSChaosStatus struct {
 	ChaosStatus `json:",inline"`
+
+	// Instances records the chaos DNS servers started by chaos-daemon for each container
+	// +optional
+	Instances map[string]DNSChaosInstance `json:"instances,omitempty"`
+}
+
+// DNSChaosInstance is a chaos DNS server started by chaos-daemon
+type DNSChaosInstance struct {
+	// UID is the pid of the chaos DNS server
+	// +optional
+	UID string `json:"uid,omitempty"`
+	// InstanceUID is the uid of the chaos DNS server in the background process
+	// manager of chaos-daemon
+	// +optional
+	InstanceUID string `json:"instanceUid,omitempty"`
+	// StartTime specifies when the chaos DNS server starts
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
 }
 
 func (obj *DNSChaos) GetSelectorSpecs() map[string]interface{} {
@@ -95,3 +163,7 @@ func (obj *DNSChaos) GetSelectorSpecs() map[string]interface{} {
 		".": &obj.Spec.ContainerSelector,
 	}
 }
+
+func (obj *DNSChaos) GetCustomStatus() interface{} {
+	return &obj.Status.Instances
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates whether the rules are well defined for the action
+func (in *DNSChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	rulesField := path.Child("rules")
+
+	if in.Action.IsServedByChaosDNSServer() {
+		if len(in.Rules) != 0 {
+			allErrs = append(allErrs, field.Invalid(rulesField, in.Rules, fmt.Sprintf("rules are not supported by action %s", in.Action)))
+		}
+		return allErrs
+	}
+
+	if (in.Action == DNSDelayAction || in.Action == DNSFixedAction) && len(in.DomainNamePatterns) != 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("patterns"), in.DomainNamePatterns,
+			fmt.Sprintf("action %s requires rules instead of patterns", in.Action)))
+	}
+	if (in.Action == DNSDelayAction || in.Action == DNSFixedAction) && len(in.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(rulesField, fmt.Sprintf("action %s requires rules", in.Action)))
+	}
+
+	for i, rule := range in.Rules {
+		ruleField := rulesField.Index(i)
+
+		if in.Action == DNSDelayAction {
+			delay, err := time.ParseDuration(rule.Delay)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(ruleField.Child("delay"), rule.Delay, fmt.Sprintf("parse delay field error: %s", err)))
+			} else if delay <= 0 {
+				allErrs = append(allErrs, field.Invalid(ruleField.Child("delay"), rule.Delay, "delay should be positive"))
+			}
+		} else if len(rule.Delay) != 0 {
+			allErrs = append(allErrs, field.Invalid(ruleField.Child("delay"), rule.Delay, fmt.Sprintf("delay is not supported by action %s", in.Action)))
+		}
+
+		if in.Action == DNSFixedAction {
+			if len(rule.Answers) == 0 {
+				allErrs = append(allErrs, field.Required(ruleField.Child("answers"), "action fixed requires answers"))
+			}
+			for j, answer := range rule.Answers {
+				if net.ParseIP(answer) == nil {
+					allErrs = append(allErrs, field.Invalid(ruleField.Child("answers").Index(j), answer, "answer should be an IPv4 or IPv6 address"))
+				}
+			}
+		} else if len(rule.Answers) != 0 {
+			allErrs = append(allErrs, field.Invalid(ruleField.Child("answers"), rule.Answers, fmt.Sprintf("answers are not supported by action %s", in.Action)))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("dnschaos_webhook", func() {
+	Context("webhook.Validator of dnschaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name    string
+				chaos   DNSChaos
+				execute func(chaos *DNSChaos) error
+				expect  string
+			}
+
+			tcs := []TestCase{
+				{
+					name: "simple ValidateCreate",
+					chaos: DNSChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo1",
+						},
+						Spec: DNSChaosSpec{
+							Action:             ErrorAction,
+							DomainNamePatterns: []string{"google.com"},
+						},
+					},
+					execute: func(chaos *DNSChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate rules with random action",
+					chaos: DNSChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo2",
+						},
+						Spec: DNSChaosSpec{
+							Action: RandomAction,
+							Rules:  []DNSChaosRule{{Pattern: "google.com"}},
+						},
+					},
+					execute: func(chaos *DNSChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate nxdomain",
+					chaos: DNSChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo3",
+						},
+						Spec: DNSChaosSpec{
+							Action:             DNSNXDomainAction,
+							DomainNamePatterns: []string{"google.com", "github.*"},
+						},
+					},
+					execute: func(chaos *DNSChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate delay without rules",
+					chaos: DNSChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo4",
+						},
+						Spec: DNSChaosSpec{
+							Action:             DNSDelayAction,
+							DomainNamePatterns: []string{"google.com"},
+						},
+					},
+					execute: func(chaos *DNSChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate delay with invalid delay",
+					chaos: DNSChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo5",
+						},
+						Spec: DNSChaosSpec{
+							Action: DNSDelayAction,
+							Rules:  []DNSChaosRule{{Pattern: "google.com", Delay: "1S"}},
+						},
+					},
+					execute: func(chaos *DNSChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate delay",
+					chaos: DNSChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: DNSChaosSpec{
+							Action: DNSDelayAction,
+							Rules:  []DNSChaosRule{{Pattern: "google.com", Delay: "2s"}},
+						},
+					},
+					execute: func(chaos *DNSChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate fixed with invalid answer",
+					chaos: DNSChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: DNSChaosSpec{
+							Action: DNSFixedAction,
+							Rules:  []DNSChaosRule{{Pattern: "google.com", Answers: []string{"10.0.0.256"}}},
+						},
+					},
+					execute: func(chaos *DNSChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate fixed",
+					chaos: DNSChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: DNSChaosSpec{
+							Action: DNSFixedAction,
+							Rules:  []DNSChaosRule{{Pattern: "google.com", Answers: []string{"10.0.0.1", "fd00::1"}}},
+						},
+					},
+					execute: func(chaos *DNSChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate answers with servfail",
+					chaos: DNSChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: DNSChaosSpec{
+							Action: DNSServFailAction,
+							Rules:  []DNSChaosRule{{Pattern: "google.com", Answers: []string{"10.0.0.1"}}},
+						},
+					},
+					execute: func(chaos *DNSChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.execute(&tc.chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	})
+})
@@ -46,8 +46,8 @@ type IOChaosSpec struct {
 	ContainerSelector `json:",inline"`
 
 	// Action defines the specific pod chaos action.
-	// Supported action: latency / fault / attrOverride / mistake
-	// +kubebuilder:validation:Enum=latency;fault;attrOverride;mistake
+	// Supported action: latency / fault / attrOverride / mistake / shortRW / diskFill
+	// +kubebuilder:validation:Enum=latency;fault;attrOverride;mistake;shortRW;diskFill
 	Action IOChaosType `json:"action"`
 
 	// Delay defines the value of I/O chaos action delay.
@@ -75,6 +75,22 @@ type IOChaosSpec struct {
 	// +optional
 	Mistake *MistakeSpec `json:"mistake,omitempty"`
 
+	// ShortRW defines how the length of read or write results is truncated.
+	// It only takes effect on `read` and `write` methods, which cover
+	// read/pread and write/pwrite of the target process.
+	// The action is passed through to toda, and it's rejected by the webhook
+	// until the toda shipped with chaos-daemon implements it.
+	// +ui:form:when=action=='shortRW'
+	// +optional
+	ShortRW *ShortRWSpec `json:"shortRW,omitempty"`
+
+	// DiskFill defines how much data is allocated in the volume.
+	// The allocated file is placed under the VolumePath, and will be removed
+	// when the chaos is recovered.
+	// +ui:form:when=action=='diskFill'
+	// +optional
+	DiskFill *DiskFillVolumeSpec `json:"diskFill,omitempty"`
+
 	// Path defines the path of files for injecting I/O chaos action.
 	// +optional
 	Path string `json:"path,omitempty"`
@@ -107,6 +123,22 @@ type IOChaosSpec struct {
 	RemoteCluster string `json:"remoteCluster,omitempty"`
 }
 
+// DiskFillVolumeSpec represents how much data is allocated in the volume.
+// Exactly one of Size and UsagePercent should be set.
+type DiskFillVolumeSpec struct {
+	// Size is the amount of data to allocate, e.g. "512Mi", "1Gi".
+	// +optional
+	Size string `json:"size,omitempty"`
+
+	// UsagePercent is the target usage of the volume in percent of its
+	// capacity. Data is allocated until the usage reaches it, and nothing
+	// is allocated if the usage is already higher.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	UsagePercent int `json:"usagePercent,omitempty"`
+}
+
 // IOChaosStatus defines the observed state of IOChaos
 type IOChaosStatus struct {
 	ChaosStatus `json:",inline"`
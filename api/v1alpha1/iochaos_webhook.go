@@ -18,7 +18,9 @@ package v1alpha1
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/chaos-mesh/chaos-mesh/api/genericwebhook"
@@ -39,6 +41,91 @@ func (in *IOErrno) Validate(root interface{}, path *field.Path) field.ErrorList
 	return allErrs
 }
 
+func (in *IOChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch in.Action {
+	case IoShortRW:
+		// TODO: accept shortRW once the toda shipped with chaos-daemon implements it
+		allErrs = append(allErrs, field.Forbidden(path.Child("action"),
+			fmt.Sprintf("action %s is not supported by the toda shipped with chaos-daemon yet", in.Action)))
+		allErrs = append(allErrs, in.validateShortRW(path)...)
+	case IoDiskFill:
+		allErrs = append(allErrs, in.validateDiskFill(path)...)
+	}
+	return allErrs
+}
+
+func (in *IOChaosSpec) validateShortRW(path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	shortRWField := path.Child("shortRW")
+	if in.ShortRW == nil {
+		return append(allErrs, field.Invalid(shortRWField, in.ShortRW,
+			fmt.Sprintf("shortRW should be set on %s action", in.Action)))
+	}
+
+	if (len(in.ShortRW.Ratio) == 0) == (in.ShortRW.Size == 0) {
+		allErrs = append(allErrs, field.Invalid(shortRWField, in.ShortRW,
+			"exactly one of ratio and size should be set"))
+	}
+
+	if len(in.ShortRW.Ratio) != 0 {
+		ratio, err := strconv.ParseFloat(in.ShortRW.Ratio, 64)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(shortRWField.Child("ratio"), in.ShortRW.Ratio,
+				fmt.Sprintf("parse ratio field error:%s", err)))
+		} else if ratio <= 0 || ratio >= 1 {
+			allErrs = append(allErrs, field.Invalid(shortRWField.Child("ratio"), in.ShortRW.Ratio,
+				"ratio should be in (0, 1)"))
+		}
+	}
+
+	if in.ShortRW.Size < 0 {
+		allErrs = append(allErrs, field.Invalid(shortRWField.Child("size"), in.ShortRW.Size,
+			"size should be greater than 0"))
+	}
+
+	for i, method := range in.Methods {
+		if method != Read && method != Write {
+			allErrs = append(allErrs, field.Invalid(path.Child("methods").Index(i), method,
+				fmt.Sprintf("action %s only supports %s and %s methods", in.Action, Read, Write)))
+		}
+	}
+
+	return allErrs
+}
+
+func (in *IOChaosSpec) validateDiskFill(path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	diskFillField := path.Child("diskFill")
+	if in.DiskFill == nil {
+		return append(allErrs, field.Invalid(diskFillField, in.DiskFill,
+			fmt.Sprintf("diskFill should be set on %s action", in.Action)))
+	}
+
+	if (len(in.DiskFill.Size) == 0) == (in.DiskFill.UsagePercent == 0) {
+		allErrs = append(allErrs, field.Invalid(diskFillField, in.DiskFill,
+			"exactly one of size and usagePercent should be set"))
+	}
+
+	if len(in.DiskFill.Size) != 0 {
+		size, err := resource.ParseQuantity(in.DiskFill.Size)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(diskFillField.Child("size"), in.DiskFill.Size,
+				fmt.Sprintf("parse size field error:%s", err)))
+		} else if size.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(diskFillField.Child("size"), in.DiskFill.Size,
+				"size should be greater than 0"))
+		}
+	}
+
+	if in.DiskFill.UsagePercent < 0 || in.DiskFill.UsagePercent > 100 {
+		allErrs = append(allErrs, field.Invalid(diskFillField.Child("usagePercent"), in.DiskFill.UsagePercent,
+			"usagePercent should be in (0, 100]"))
+	}
+
+	return allErrs
+}
+
 func init() {
 	genericwebhook.Register("IOErrno", reflect.PtrTo(reflect.TypeOf(IOErrno(0))))
 }
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 var _ = Describe("iochaos_webhook", func() {
@@ -232,6 +233,184 @@ var _ = Describe("iochaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate shortRW without spec",
+					chaos: IOChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: IOChaosSpec{
+							Action: IoShortRW,
+						},
+					},
+					execute: func(chaos *IOChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate shortRW with both ratio and size",
+					chaos: IOChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: IOChaosSpec{
+							Action: IoShortRW,
+							ShortRW: &ShortRWSpec{
+								Ratio: "0.5",
+								Size:  10,
+							},
+						},
+					},
+					execute: func(chaos *IOChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate shortRW with invalid ratio",
+					chaos: IOChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: IOChaosSpec{
+							Action: IoShortRW,
+							ShortRW: &ShortRWSpec{
+								Ratio: "1.5",
+							},
+						},
+					},
+					execute: func(chaos *IOChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate shortRW with unsupported method",
+					chaos: IOChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: IOChaosSpec{
+							Action:  IoShortRW,
+							Methods: []IoMethod{Read, Open},
+							ShortRW: &ShortRWSpec{
+								Size: 1,
+							},
+						},
+					},
+					execute: func(chaos *IOChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate shortRW which is not supported by toda yet",
+					chaos: IOChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: IOChaosSpec{
+							Action:  IoShortRW,
+							Methods: []IoMethod{Read, Write},
+							ShortRW: &ShortRWSpec{
+								Ratio: "0.5",
+							},
+						},
+					},
+					execute: func(chaos *IOChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate diskFill without spec",
+					chaos: IOChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo18",
+						},
+						Spec: IOChaosSpec{
+							Action: IoDiskFill,
+						},
+					},
+					execute: func(chaos *IOChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate diskFill with invalid size",
+					chaos: IOChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo19",
+						},
+						Spec: IOChaosSpec{
+							Action: IoDiskFill,
+							DiskFill: &DiskFillVolumeSpec{
+								Size: "1GB",
+							},
+						},
+					},
+					execute: func(chaos *IOChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate diskFill with both size and usagePercent",
+					chaos: IOChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo20",
+						},
+						Spec: IOChaosSpec{
+							Action: IoDiskFill,
+							DiskFill: &DiskFillVolumeSpec{
+								Size:         "1Gi",
+								UsagePercent: 90,
+							},
+						},
+					},
+					execute: func(chaos *IOChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate diskFill",
+					chaos: IOChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo21",
+						},
+						Spec: IOChaosSpec{
+							Action: IoDiskFill,
+							DiskFill: &DiskFillVolumeSpec{
+								UsagePercent: 90,
+							},
+						},
+					},
+					execute: func(chaos *IOChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -243,5 +422,19 @@ var _ = Describe("iochaos_webhook", func() {
 				}
 			}
 		})
+
+		It("reject shortRW until toda implements it", func() {
+			spec := IOChaosSpec{
+				Action:  IoShortRW,
+				Methods: []IoMethod{Read, Write},
+				ShortRW: &ShortRWSpec{Size: 1},
+			}
+			Expect(spec.validateShortRW(field.NewPath("spec"))).To(BeEmpty())
+
+			errs := spec.Validate(nil, field.NewPath("spec"))
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Type).To(Equal(field.ErrorTypeForbidden))
+			Expect(errs[0].Field).To(Equal("spec.action"))
+		})
 	})
 })
@@ -28,8 +28,8 @@ type JVMChaosSpec struct {
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
 
 	// Action defines the specific jvm chaos action.
-	// Supported action: latency;return;exception;stress;gc;ruleData
-	// +kubebuilder:validation:Enum=latency;return;exception;stress;gc;ruleData;mysql
+	// Supported action: latency;return;exception;stress;gc;ruleData;mysql;threadPool;monitorHold;heapPressure;jdbc;kafka;redis;httpClient
+	// +kubebuilder:validation:Enum=latency;return;exception;stress;gc;ruleData;mysql;threadPool;monitorHold;heapPressure;jdbc;kafka;redis;httpClient
 	Action JVMChaosAction `json:"action"`
 
 	// JVMParameter represents the detail about jvm chaos action definition
@@ -66,6 +66,27 @@ const (
 
 	// JVMMySQLAction represents the JVM chaos action of mysql java client fault injection
 	JVMMySQLAction JVMChaosAction = "mysql"
+
+	// JVMThreadPoolAction represents the JVM chaos action of blocking the threads of an executor
+	JVMThreadPoolAction JVMChaosAction = "threadPool"
+
+	// JVMMonitorHoldAction represents the JVM chaos action of holding the monitor of a synchronized method
+	JVMMonitorHoldAction JVMChaosAction = "monitorHold"
+
+	// JVMHeapPressureAction represents the JVM chaos action of retaining heap to a target occupancy
+	JVMHeapPressureAction JVMChaosAction = "heapPressure"
+
+	// JVMJDBCAction represents the JVM chaos action of generic JDBC fault injection
+	JVMJDBCAction JVMChaosAction = "jdbc"
+
+	// JVMKafkaAction represents the JVM chaos action of kafka java client fault injection
+	JVMKafkaAction JVMChaosAction = "kafka"
+
+	// JVMRedisAction represents the JVM chaos action of redis java client fault injection
+	JVMRedisAction JVMChaosAction = "redis"
+
+	// JVMHTTPClientAction represents the JVM chaos action of http java client fault injection
+	JVMHTTPClientAction JVMChaosAction = "httpClient"
 )
 
 // JVMParameter represents the detail about jvm chaos action definition
@@ -78,6 +99,14 @@ type JVMParameter struct {
 
 	JVMMySQLSpec `json:",inline"`
 
+	JVMThreadPoolSpec `json:",inline"`
+
+	JVMKafkaSpec `json:",inline"`
+
+	JVMRedisSpec `json:",inline"`
+
+	JVMHTTPClientSpec `json:",inline"`
+
 	// byteman rule name, should be unique, and will generate one if not set
 	// +optional
 	Name string `json:"name"`
@@ -87,15 +116,23 @@ type JVMParameter struct {
 	ReturnValue string `json:"returnValue"`
 
 	// the exception which needs to throw for action `exception`
-	// or the exception message needs to throw in action `mysql`
+	// or the exception message needs to throw in action `mysql`, `jdbc`, `kafka`, `redis` and `httpClient`
 	// +optional
 	ThrowException string `json:"exception"`
 
 	// the latency duration for action 'latency', unit ms
-	// or the latency duration in action `mysql`
+	// or the latency duration in action `mysql`, `jdbc`, `kafka`, `redis` and `httpClient`
+	// or the duration to hold the threads, the monitor or the heap in action `threadPool`, `monitorHold` and `heapPressure`
 	// +optional
 	LatencyDuration int `json:"latency"`
 
+	// the max time to wait for the byteman rules to be injected into the target classes,
+	// the injection fails if any rule isn't injected in time, default 10s.
+	// Set it to 0s to skip waiting, e.g. if the target class is loaded lazily, then the
+	// injection only fails if any rule fails to compile
+	// +optional
+	TransformTimeout string `json:"transformTimeout,omitempty" webhook:"Duration"`
+
 	// the byteman rule's data for action 'ruleData'
 	// +optional
 	RuleData string `json:"ruleData"`
@@ -117,7 +154,8 @@ type JVMClassMethodSpec struct {
 	// +optional
 	Class string `json:"class,omitempty"`
 
-	// the method in Java class
+	// the method in Java class, it should be a synchronized method in action `monitorHold`,
+	// otherwise no monitor is held and only the latency is injected
 	// +optional
 	Method string `json:"method,omitempty"`
 }
@@ -131,9 +169,30 @@ type JVMStressCfgSpec struct {
 	// the memory type needs to locate, only set it when action is stress, the value can be 'stack' or 'heap'
 	// +optional
 	MemoryType string `json:"memType,omitempty"`
+
+	// the target occupancy of the max heap in percent, only set it when action is heapPressure
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	HeapOccupancy int `json:"heapOccupancy,omitempty"`
+}
+
+// JVMThreadPoolSpec is the specification for blocking the threads of an executor.
+// The threads are blocked before executing the tasks, in ThreadPoolExecutor.beforeExecute
+// by default, and the class and method could be set for the executors which don't extend
+// ThreadPoolExecutor.
+type JVMThreadPoolSpec struct {
+	// the name prefix of the threads in the executor, only set it when action is threadPool
+	// +optional
+	ThreadNamePrefix string `json:"threadNamePrefix,omitempty"`
+
+	// the number of the threads to block, only set it when action is threadPool
+	// +optional
+	ThreadCount int `json:"threadCount,omitempty"`
 }
 
-// JVMMySQLSpec is the specification of MySQL fault injection in JVM
+// JVMMySQLSpec is the specification of MySQL fault injection in JVM, the Database, Table and
+// SQLType are also used to match the SQL in action `jdbc`
 // only when SQL match the Database, Table and SQLType, JVMChaos mesh will inject fault
 // for examle:
 //
@@ -157,9 +216,83 @@ type JVMMySQLSpec struct {
 	SQLType string `json:"sqlType,omitempty"`
 }
 
+// JVMKafkaSpec is the specification of kafka java client fault injection in JVM.
+// The producer fails or delays in KafkaProducer.send, and the consumer fails or
+// delays in KafkaConsumer.poll(Duration).
+type JVMKafkaSpec struct {
+	// the kafka client to inject fault into, the value can be 'producer' or 'consumer'
+	// +optional
+	KafkaClient string `json:"kafkaClient,omitempty"`
+
+	// the match topic
+	// default value is "", means match all topics
+	// +optional
+	Topic string `json:"topic,omitempty"`
+}
+
+// JVMRedisSpec is the specification of redis java client fault injection in JVM.
+// The commands fail or delay in Connection.sendCommand of jedis 3.x, or in
+// AbstractRedisAsyncCommands.dispatch of lettuce.
+type JVMRedisSpec struct {
+	// the redis client to inject fault into, the value can be 'jedis' or 'lettuce'
+	// +optional
+	RedisClient string `json:"redisClient,omitempty"`
+
+	// the match redis command, for example 'GET'
+	// default value is "", means match all commands
+	// +optional
+	RedisCommand string `json:"redisCommand,omitempty"`
+}
+
+// JVMHTTPClientSpec is the specification of http java client fault injection in JVM.
+// The requests fail or delay in the synchronous Call.execute of okhttp, or in
+// CloseableHttpClient.doExecute of apache httpclient 4.x.
+type JVMHTTPClientSpec struct {
+	// the http client to inject fault into, the value can be 'okhttp' or 'apache'
+	// +optional
+	HTTPClient string `json:"httpClient,omitempty"`
+
+	// the match host of the requests
+	// default value is "", means match all hosts
+	// +optional
+	Host string `json:"host,omitempty"`
+}
+
 // JVMChaosStatus defines the observed state of JVMChaos
 type JVMChaosStatus struct {
 	ChaosStatus `json:",inline"`
+
+	// Instances always specifies the byteman rules installed in each container
+	// +optional
+	Instances map[string]JVMChaosInstance `json:"instances,omitempty"`
+}
+
+// JVMChaosInstance is the status of the byteman rules installed in a container
+type JVMChaosInstance struct {
+	// Rules are the status of the installed byteman rules
+	// +optional
+	Rules []JVMRuleStatus `json:"rules,omitempty"`
+
+	// InstallTime is the time when the rules were installed, the injection fails
+	// if any rule isn't injected into the target classes within the transform timeout
+	// +optional
+	InstallTime *metav1.Time `json:"installTime,omitempty"`
+}
+
+// JVMRuleStatus is the status of a byteman rule reported by the byteman agent
+type JVMRuleStatus struct {
+	// Name is the name of the rule
+	Name string `json:"name"`
+
+	// Transformed represents whether the rule has been injected into the target class and method
+	Transformed bool `json:"transformed"`
+
+	// Hits is the number of times the rule has fired, it's refreshed periodically while the rule is injected
+	Hits int64 `json:"hits"`
+
+	// Error is the error of compiling or executing the rule
+	// +optional
+	Error string `json:"error,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -189,3 +322,7 @@ func (obj *JVMChaos) GetSelectorSpecs() map[string]interface{} {
 		".": &obj.Spec.ContainerSelector,
 	}
 }
+
+func (obj *JVMChaos) GetCustomStatus() interface{} {
+	return &obj.Status.Instances
+}
@@ -18,6 +18,7 @@ package v1alpha1
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -88,11 +89,77 @@ func (in *JVMChaosSpec) Validate(root interface{}, path *field.Path) field.Error
 		if len(in.ThrowException) == 0 && in.LatencyDuration == 0 {
 			allErrs = append(allErrs, field.Invalid(path, in, "must set one of exception or latency"))
 		}
+	case JVMThreadPoolAction:
+		if len(in.ThreadNamePrefix) == 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "thread name prefix not provided"))
+		}
+		if in.ThreadCount <= 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "thread count should be greater than 0"))
+		}
+		if (len(in.Class) == 0) != (len(in.Method) == 0) {
+			allErrs = append(allErrs, field.Invalid(path, in, "class and method should be set together"))
+		}
+		if in.LatencyDuration <= 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "latency not provided"))
+		}
+	case JVMMonitorHoldAction:
+		if len(in.Class) == 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "class not provided"))
+		}
+		if len(in.Method) == 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "method not provided"))
+		} else if !mayBeSynchronized(in.Method) {
+			allErrs = append(allErrs, field.Invalid(path, in, fmt.Sprintf("method %s can't be synchronized, monitorHold only works on a synchronized method", in.Method)))
+		}
+		if in.LatencyDuration <= 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "latency not provided"))
+		}
+	case JVMHeapPressureAction:
+		if in.HeapOccupancy <= 0 || in.HeapOccupancy > 100 {
+			allErrs = append(allErrs, field.Invalid(path, in, "heap occupancy should be in (0, 100]"))
+		}
+		if in.LatencyDuration <= 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "latency not provided"))
+		}
+	case JVMJDBCAction:
+		if len(in.ThrowException) == 0 && in.LatencyDuration == 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "must set one of exception or latency"))
+		}
+	case JVMKafkaAction:
+		if in.KafkaClient != "producer" && in.KafkaClient != "consumer" {
+			allErrs = append(allErrs, field.Invalid(path, in, "kafka client should be 'producer' or 'consumer'"))
+		}
+		if len(in.ThrowException) == 0 && in.LatencyDuration == 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "must set one of exception or latency"))
+		}
+	case JVMRedisAction:
+		if in.RedisClient != "jedis" && in.RedisClient != "lettuce" {
+			allErrs = append(allErrs, field.Invalid(path, in, "redis client should be 'jedis' or 'lettuce'"))
+		}
+		if len(in.ThrowException) == 0 && in.LatencyDuration == 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "must set one of exception or latency"))
+		}
+	case JVMHTTPClientAction:
+		if in.HTTPClient != "okhttp" && in.HTTPClient != "apache" {
+			allErrs = append(allErrs, field.Invalid(path, in, "http client should be 'okhttp' or 'apache'"))
+		}
+		if len(in.ThrowException) == 0 && in.LatencyDuration == 0 {
+			allErrs = append(allErrs, field.Invalid(path, in, "must set one of exception or latency"))
+		}
 	case "":
 		allErrs = append(allErrs, field.Invalid(path, in, "action not provided"))
 	default:
-		allErrs = append(allErrs, field.Invalid(path, in, fmt.Sprintf("action %s not supported, action can be 'latency', 'exception', 'return', 'stress', 'gc' or 'ruleData'", in.Action)))
+		allErrs = append(allErrs, field.Invalid(path, in, fmt.Sprintf("action %s not supported, action can be 'latency', 'exception', 'return', 'stress', 'gc', 'ruleData', 'mysql', 'threadPool', 'monitorHold', 'heapPressure', 'jdbc', 'kafka', 'redis' or 'httpClient'", in.Action)))
 	}
 
 	return allErrs
 }
+
+// mayBeSynchronized returns whether the method of byteman rule may be a synchronized method.
+// The modifiers are unknown until the class is loaded, so only the constructors and the class
+// initializers, which can never be synchronized, are excluded.
+func mayBeSynchronized(method string) bool {
+	// the method may be followed by its parameter types, e.g. foo(int, String)
+	name := strings.TrimSpace(strings.SplitN(method, "(", 2)[0])
+	return name != "<init>" && name != "<clinit>"
+}
@@ -265,6 +265,239 @@ var _ = Describe("jvmchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate threadPool",
+					chaos: JVMChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: JVMChaosSpec{
+							Action: JVMThreadPoolAction,
+							JVMParameter: JVMParameter{
+								JVMThreadPoolSpec: JVMThreadPoolSpec{
+									ThreadNamePrefix: "pool-1-thread-",
+									ThreadCount:      4,
+								},
+								LatencyDuration: 1000,
+							},
+						},
+					},
+					execute: func(chaos *JVMChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "missing thread count",
+					chaos: JVMChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo12",
+						},
+						Spec: JVMChaosSpec{
+							Action: JVMThreadPoolAction,
+							JVMParameter: JVMParameter{
+								JVMThreadPoolSpec: JVMThreadPoolSpec{
+									ThreadNamePrefix: "pool-1-thread-",
+								},
+								LatencyDuration: 1000,
+							},
+						},
+					},
+					execute: func(chaos *JVMChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "missing method in monitorHold",
+					chaos: JVMChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: JVMChaosSpec{
+							Action: JVMMonitorHoldAction,
+							JVMParameter: JVMParameter{
+								JVMClassMethodSpec: JVMClassMethodSpec{
+									Class: "Main",
+								},
+								LatencyDuration: 1000,
+							},
+						},
+					},
+					execute: func(chaos *JVMChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "constructor in monitorHold",
+					chaos: JVMChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: JVMChaosSpec{
+							Action: JVMMonitorHoldAction,
+							JVMParameter: JVMParameter{
+								JVMClassMethodSpec: JVMClassMethodSpec{
+									Class:  "Main",
+									Method: "<init>(int)",
+								},
+								LatencyDuration: 1000,
+							},
+						},
+					},
+					execute: func(chaos *JVMChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate heapPressure",
+					chaos: JVMChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: JVMChaosSpec{
+							Action: JVMHeapPressureAction,
+							JVMParameter: JVMParameter{
+								JVMStressCfgSpec: JVMStressCfgSpec{
+									HeapOccupancy: 90,
+								},
+								LatencyDuration: 1000,
+							},
+						},
+					},
+					execute: func(chaos *JVMChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "invalid heap occupancy",
+					chaos: JVMChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: JVMChaosSpec{
+							Action: JVMHeapPressureAction,
+							JVMParameter: JVMParameter{
+								JVMStressCfgSpec: JVMStressCfgSpec{
+									HeapOccupancy: 120,
+								},
+								LatencyDuration: 1000,
+							},
+						},
+					},
+					execute: func(chaos *JVMChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate kafka",
+					chaos: JVMChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: JVMChaosSpec{
+							Action: JVMKafkaAction,
+							JVMParameter: JVMParameter{
+								JVMKafkaSpec: JVMKafkaSpec{
+									KafkaClient: "producer",
+									Topic:       "orders",
+								},
+								ThrowException: "BOOM",
+							},
+						},
+					},
+					execute: func(chaos *JVMChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "invalid kafka client",
+					chaos: JVMChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: JVMChaosSpec{
+							Action: JVMKafkaAction,
+							JVMParameter: JVMParameter{
+								JVMKafkaSpec: JVMKafkaSpec{
+									KafkaClient: "streams",
+								},
+								ThrowException: "BOOM",
+							},
+						},
+					},
+					execute: func(chaos *JVMChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "missing exception and latency in redis",
+					chaos: JVMChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo18",
+						},
+						Spec: JVMChaosSpec{
+							Action: JVMRedisAction,
+							JVMParameter: JVMParameter{
+								JVMRedisSpec: JVMRedisSpec{
+									RedisClient: "jedis",
+								},
+							},
+						},
+					},
+					execute: func(chaos *JVMChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate httpClient",
+					chaos: JVMChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo19",
+						},
+						Spec: JVMChaosSpec{
+							Action: JVMHTTPClientAction,
+							JVMParameter: JVMParameter{
+								JVMHTTPClientSpec: JVMHTTPClientSpec{
+									HTTPClient: "okhttp",
+									Host:       "example.com",
+								},
+								LatencyDuration: 1000,
+							},
+						},
+					},
+					execute: func(chaos *JVMChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -0,0 +1,152 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var syscallNameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+var kernelVersionRegexp = regexp.MustCompile(`^(\d+)\.(\d+)`)
+
+// bioFailureMinKernelVersion is the min kernel version supported by the bio-failure preset, which
+// matches the device through bio->bi_bdev since linux 5.12
+var bioFailureMinKernelVersion = [2]uint64{5, 12}
+
+// syscallPrefixes are the prefixes of the syscall entries on the architectures of the nodes, which
+// are the values of `kubernetes.io/arch`
+var syscallPrefixes = map[string]string{
+	"amd64": "__x64_sys_",
+	"arm64": "__arm64_sys_",
+	"s390x": "__s390x_sys_",
+}
+
+// Compile returns a copy of the request with the Preset compiled into the FailType, Headers and
+// Callchain for the node with the architecture, e.g. amd64. The copy is the same as the request
+// if the Preset is not set.
+func (in *FailKernRequest) Compile(arch string) (*FailKernRequest, error) {
+	out := in.DeepCopy()
+	if in.Preset == nil {
+		return out, nil
+	}
+
+	out.Preset = nil
+	out.Headers = nil
+	out.Callchain = nil
+
+	preset := in.Preset
+	switch preset.Type {
+	case SlabFailurePreset:
+		if len(preset.Syscall) == 0 {
+			return nil, errors.Errorf("syscall is required by %s preset", preset.Type)
+		}
+		frame, err := syscallFrame(preset.Syscall, arch)
+		if err != nil {
+			return nil, err
+		}
+		out.FailType = 0
+		out.Callchain = []Frame{frame}
+	case PageAllocFailurePreset:
+		out.FailType = 1
+		if len(preset.Syscall) > 0 {
+			frame, err := syscallFrame(preset.Syscall, arch)
+			if err != nil {
+				return nil, err
+			}
+			out.Callchain = []Frame{frame}
+		}
+	case BIOFailurePreset:
+		if len(preset.Device) == 0 {
+			return nil, errors.Errorf("device is required by %s preset", preset.Type)
+		}
+		major, minor, err := parseDeviceNumber(preset.Device)
+		if err != nil {
+			return nil, err
+		}
+		// the block device of the bio, which is the partition if the bio is submitted to a partition,
+		// is referenced since linux 5.12
+		predicate := fmt.Sprintf("bio->bi_bdev->bd_dev == MKDEV(%d, %d)", major, minor)
+		out.FailType = 2
+		out.Headers = []string{"linux/blkdev.h"}
+		// the frame without funcname puts the predicate on should_fail_bio(struct bio *bio) itself
+		out.Callchain = []Frame{
+			{
+				Predicate: predicate,
+			},
+		}
+	default:
+		return nil, errors.Errorf("unknown preset type %s", preset.Type)
+	}
+
+	return out, nil
+}
+
+// CheckKernelVersion returns an error if the preset isn't supported by the kernel of the node,
+// whose version is reported by the node, such as 5.15.0-91-generic. The version which couldn't
+// be parsed is not checked.
+func (in *KernelFaultPreset) CheckKernelVersion(kernelVersion string) error {
+	if in.Type != BIOFailurePreset {
+		return nil
+	}
+
+	matches := kernelVersionRegexp.FindStringSubmatch(kernelVersion)
+	if matches == nil {
+		return nil
+	}
+	major, _ := strconv.ParseUint(matches[1], 10, 64)
+	minor, _ := strconv.ParseUint(matches[2], 10, 64)
+	if major < bioFailureMinKernelVersion[0] || major == bioFailureMinKernelVersion[0] && minor < bioFailureMinKernelVersion[1] {
+		return errors.Errorf("%s preset requires linux %d.%d or later, but the kernel of the node is %s",
+			in.Type, bioFailureMinKernelVersion[0], bioFailureMinKernelVersion[1], kernelVersion)
+	}
+	return nil
+}
+
+func syscallFrame(syscall string, arch string) (Frame, error) {
+	if !syscallNameRegexp.MatchString(syscall) {
+		return Frame{}, errors.Errorf("invalid syscall name %s", syscall)
+	}
+	prefix, ok := syscallPrefixes[arch]
+	if !ok {
+		return Frame{}, errors.Errorf("syscall is not supported on architecture %s", arch)
+	}
+	return Frame{
+		Funcname: prefix + syscall,
+	}, nil
+}
+
+// parseDeviceNumber parses the device number in the format of `major:minor`
+func parseDeviceNumber(device string) (uint32, uint32, error) {
+	parts := strings.Split(device, ":")
+	if len(parts) != 2 {
+		return 0, 0, errors.Errorf("invalid device number %s, should be major:minor", device)
+	}
+	major, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "parse major of device %s", device)
+	}
+	minor, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "parse minor of device %s", device)
+	}
+	return uint32(major), uint32(minor), nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFailKernRequestCompile(t *testing.T) {
+	tests := []struct {
+		name    string
+		arch    string
+		request FailKernRequest
+		want    *FailKernRequest
+		wantErr bool
+	}{
+		{
+			name: "without preset",
+			request: FailKernRequest{
+				FailType:  1,
+				Callchain: []Frame{{Funcname: "ext4_mount"}},
+			},
+			want: &FailKernRequest{
+				FailType:  1,
+				Callchain: []Frame{{Funcname: "ext4_mount"}},
+			},
+		},
+		{
+			name: "slab failure in syscall",
+			arch: "amd64",
+			request: FailKernRequest{
+				Preset:      &KernelFaultPreset{Type: SlabFailurePreset, Syscall: "mount"},
+				Probability: 10,
+			},
+			want: &FailKernRequest{
+				FailType:    0,
+				Callchain:   []Frame{{Funcname: "__x64_sys_mount"}},
+				Probability: 10,
+			},
+		},
+		{
+			name: "page allocation failure",
+			request: FailKernRequest{
+				Preset: &KernelFaultPreset{Type: PageAllocFailurePreset},
+				Times:  3,
+			},
+			want: &FailKernRequest{
+				FailType: 1,
+				Times:    3,
+			},
+		},
+		{
+			name: "bio failure of device",
+			request: FailKernRequest{
+				Preset: &KernelFaultPreset{Type: BIOFailurePreset, Device: "8:16"},
+			},
+			want: &FailKernRequest{
+				FailType: 2,
+				Headers:  []string{"linux/blkdev.h"},
+				Callchain: []Frame{
+					{Predicate: "bio->bi_bdev->bd_dev == MKDEV(8, 16)"},
+				},
+			},
+		},
+		{
+			name: "invalid syscall",
+			arch: "amd64",
+			request: FailKernRequest{
+				Preset: &KernelFaultPreset{Type: SlabFailurePreset, Syscall: "mount; exit"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bio failure without device",
+			request: FailKernRequest{
+				Preset: &KernelFaultPreset{Type: BIOFailurePreset},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.request.Compile(tt.arch)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compile() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKernelFaultPresetCheckKernelVersion(t *testing.T) {
+	tests := []struct {
+		name          string
+		preset        KernelFaultPreset
+		kernelVersion string
+		wantErr       bool
+	}{
+		{
+			name:          "bio failure on linux 5.15",
+			preset:        KernelFaultPreset{Type: BIOFailurePreset, Device: "8:16"},
+			kernelVersion: "5.15.0-91-generic",
+		},
+		{
+			name:          "bio failure on linux 6.1",
+			preset:        KernelFaultPreset{Type: BIOFailurePreset, Device: "8:16"},
+			kernelVersion: "6.1.0",
+		},
+		{
+			name:          "bio failure on linux 5.10",
+			preset:        KernelFaultPreset{Type: BIOFailurePreset, Device: "8:16"},
+			kernelVersion: "5.10.0-28-amd64",
+			wantErr:       true,
+		},
+		{
+			name:          "bio failure on linux 4.19",
+			preset:        KernelFaultPreset{Type: BIOFailurePreset, Device: "8:16"},
+			kernelVersion: "4.19.0",
+			wantErr:       true,
+		},
+		{
+			name:          "slab failure on linux 4.19",
+			preset:        KernelFaultPreset{Type: SlabFailurePreset, Syscall: "mount"},
+			kernelVersion: "4.19.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.preset.CheckKernelVersion(tt.kernelVersion)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckKernelVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
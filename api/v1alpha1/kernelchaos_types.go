@@ -65,9 +65,16 @@ type FailKernRequest struct {
 	//   1. https://www.kernel.org/doc/html/latest/fault-injection/fault-injection.html
 	//   2. http://github.com/iovisor/bcc/blob/master/tools/inject_example.txt
 	// to learn more
+	// It's ignored if the Preset is set.
+	// +optional
 	// +kubebuilder:validation:Maximum=2
 	// +kubebuilder:validation:Minimum=0
-	FailType int32 `json:"failtype"`
+	FailType int32 `json:"failtype,omitempty"`
+
+	// Preset is a named kernel fault, which is compiled into the FailType, Headers and Callchain,
+	// so they should be omitted if the Preset is set.
+	// +optional
+	Preset *KernelFaultPreset `json:"preset,omitempty"`
 
 	// Headers indicates the appropriate kernel headers you need.
 	// Eg: "linux/mmzone.h", "linux/blkdev.h" and so on
@@ -97,6 +104,41 @@ type FailKernRequest struct {
 	Times uint32 `json:"times,omitempty"`
 }
 
+// KernelFaultPresetType represents the type of a kernel fault preset
+type KernelFaultPresetType string
+
+const (
+	// SlabFailurePreset fails the slab allocations (e.g. kmalloc) in the call chain of a syscall.
+	SlabFailurePreset KernelFaultPresetType = "slab-failure"
+
+	// PageAllocFailurePreset fails the page allocations, optionally only in the call chain of a syscall.
+	PageAllocFailurePreset KernelFaultPresetType = "page-alloc-failure"
+
+	// BIOFailurePreset fails the block io requests to a device, which could be a partition.
+	// It requires linux 5.12 or later, the chaos isn't applied on the nodes with an older kernel.
+	BIOFailurePreset KernelFaultPresetType = "bio-failure"
+)
+
+// KernelFaultPreset describes a common kernel fault without writing the call chain
+type KernelFaultPreset struct {
+	// Type is the type of the kernel fault.
+	// Supported type: slab-failure / page-alloc-failure / bio-failure
+	// +kubebuilder:validation:Enum=slab-failure;page-alloc-failure;bio-failure
+	Type KernelFaultPresetType `json:"type"`
+
+	// Syscall is the name of the syscall in whose call chain the allocations fail, such as `mount`.
+	// It's required by slab-failure and optional for page-alloc-failure.
+	// The syscall is hooked at its entry of the architecture of the node, such as `__x64_sys_<syscall>` on
+	// amd64 and `__arm64_sys_<syscall>` on arm64, the other architectures are not supported.
+	// +optional
+	Syscall string `json:"syscall,omitempty"`
+
+	// Device is the `major:minor` number of the disk or the partition whose io requests fail, such as `8:0`.
+	// It's required by bio-failure, which requires linux 5.12 or later.
+	// +optional
+	Device string `json:"device,omitempty"`
+}
+
 // Frame defines the function signature and predicate in function's body
 type Frame struct {
 	// Funcname can be find from kernel source or `/proc/kallsyms`, such as `ext4_mount`
@@ -0,0 +1,41 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (in *FailKernRequest) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if in.Preset == nil {
+		return allErrs
+	}
+
+	if len(in.Headers) > 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("headers"), in.Headers, "headers should be omitted if the preset is set"))
+	}
+	if len(in.Callchain) > 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("callchain"), in.Callchain, "callchain should be omitted if the preset is set"))
+	}
+	// the architecture and the kernel version of the node are unknown before the chaos is applied,
+	// so the preset is validated against amd64, and it's checked again with the architecture and
+	// the kernel version of the node
+	if _, err := in.Compile("amd64"); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("preset"), in.Preset, err.Error()))
+	}
+	return allErrs
+}
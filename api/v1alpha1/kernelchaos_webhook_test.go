@@ -85,6 +85,117 @@ var _ = Describe("kernelchaos_webhook", func() {
 					},
 					expect: "",
 				},
+				{
+					name: "validate slab-failure preset",
+					chaos: KernelChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo4",
+						},
+						Spec: KernelChaosSpec{
+							FailKernRequest: FailKernRequest{
+								Preset: &KernelFaultPreset{
+									Type:    SlabFailurePreset,
+									Syscall: "mount",
+								},
+							},
+						},
+					},
+					execute: func(chaos *KernelChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate slab-failure preset without syscall",
+					chaos: KernelChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo5",
+						},
+						Spec: KernelChaosSpec{
+							FailKernRequest: FailKernRequest{
+								Preset: &KernelFaultPreset{
+									Type: SlabFailurePreset,
+								},
+							},
+						},
+					},
+					execute: func(chaos *KernelChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate bio-failure preset",
+					chaos: KernelChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: KernelChaosSpec{
+							FailKernRequest: FailKernRequest{
+								Preset: &KernelFaultPreset{
+									Type:   BIOFailurePreset,
+									Device: "8:0",
+								},
+							},
+						},
+					},
+					execute: func(chaos *KernelChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate bio-failure preset with invalid device",
+					chaos: KernelChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: KernelChaosSpec{
+							FailKernRequest: FailKernRequest{
+								Preset: &KernelFaultPreset{
+									Type:   BIOFailurePreset,
+									Device: "sda",
+								},
+							},
+						},
+					},
+					execute: func(chaos *KernelChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate preset with callchain",
+					chaos: KernelChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: KernelChaosSpec{
+							FailKernRequest: FailKernRequest{
+								Preset: &KernelFaultPreset{
+									Type: PageAllocFailurePreset,
+								},
+								Callchain: []Frame{
+									{Funcname: "ext4_mount"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *KernelChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
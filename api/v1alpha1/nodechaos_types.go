@@ -0,0 +1,135 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="duration",type=string,JSONPath=`.spec.duration`
+// +chaos-mesh:experiment
+// +genclient
+
+// NodeChaos is the Schema for the nodechaos API
+type NodeChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeChaosSpec   `json:"spec"`
+	Status NodeChaosStatus `json:"status,omitempty"`
+}
+
+var _ InnerObjectWithSelector = (*NodeChaos)(nil)
+var _ InnerObject = (*NodeChaos)(nil)
+
+// NodeChaosAction represents the chaos action about nodes.
+type NodeChaosAction string
+
+const (
+	// NodeDrainAction represents the chaos action of cordoning the node and evicting the pods on it.
+	// The evictions respect the PodDisruptionBudgets, the blocked evictions are retried until the chaos is stopped,
+	// and the node is uncordoned when the chaos is recovered.
+	NodeDrainAction NodeChaosAction = "drain"
+	// NodeTaintAction represents the chaos action of adding a taint to the node.
+	// The taint is removed when the chaos is recovered. Adding the taints set by the node
+	// controller, e.g. node.kubernetes.io/not-ready, only affects the scheduling and the
+	// evictions, the node is still Ready and its pods keep running.
+	NodeTaintAction NodeChaosAction = "taint"
+	// KubeletStopAction represents the chaos action of stopping the kubelet on the node,
+	// which makes the node NotReady. The kubelet is started again when the chaos is recovered,
+	// or by the node itself after the duration.
+	KubeletStopAction NodeChaosAction = "kubelet-stop"
+)
+
+// NodeChaosSpec is the content of the specification for a NodeChaos
+type NodeChaosSpec struct {
+	NodeSelector `json:",inline"`
+
+	// Action defines the specific node chaos action.
+	// Supported action: drain / taint / kubelet-stop.
+	// Only kubelet-stop makes the node NotReady.
+	// +kubebuilder:validation:Enum=drain;taint;kubelet-stop
+	Action NodeChaosAction `json:"action"`
+
+	// Taint is the taint added to the node. It is required when the action is `NodeTaintAction`.
+	// +optional
+	Taint *corev1.Taint `json:"taint,omitempty"`
+
+	// GracePeriod is used in drain action. It represents the duration in seconds given to the evicted pods to terminate.
+	// The default value is -1 that indicates using the termination grace period of the pods.
+	// +optional
+	// +kubebuilder:validation:Minimum=-1
+	GracePeriod *int64 `json:"gracePeriod,omitempty"`
+
+	// Duration represents the duration of the chaos action.
+	// It is required when the action is `KubeletStopAction`.
+	// +optional
+	Duration *string `json:"duration,omitempty" webhook:"Duration"`
+
+	// RemoteCluster represents the remote cluster where the chaos will be deployed
+	// +optional
+	RemoteCluster string `json:"remoteCluster,omitempty"`
+}
+
+// NodeChaosStatus represents the status of a NodeChaos
+type NodeChaosStatus struct {
+	ChaosStatus `json:",inline"`
+}
+
+func (obj *NodeChaos) GetSelectorSpecs() map[string]interface{} {
+	return map[string]interface{}{
+		".": &obj.Spec.NodeSelector,
+	}
+}
+
+type NodeSelector struct {
+	// Selector is used to select nodes that are used to inject chaos action.
+	Selector NodeSelectorSpec `json:"selector"`
+
+	// Mode defines the mode to run chaos action.
+	// Supported mode: one / all / fixed / fixed-percent / random-max-percent
+	// +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent
+	Mode SelectorMode `json:"mode"`
+
+	// Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
+	// If `FixedMode`, provide an integer of nodes to do chaos action.
+	// If `FixedPercentMode`, provide a number from 0-100 to specify the percent of nodes the server can do chaos action.
+	// IF `RandomMaxPercentMode`,  provide a number from 0-100 to specify the max percent of nodes to do chaos action
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// NodeSelectorSpec defines some selectors to select nodes.
+// If the all selectors are empty, all nodes will be used in chaos experiment.
+type NodeSelectorSpec struct {
+	// Nodes is a set of node names.
+	// +optional
+	Nodes []string `json:"nodes,omitempty"`
+
+	// Map of string keys and values that can be used to select nodes.
+	// A selector based on labels.
+	// +optional
+	LabelSelectors map[string]string `json:"labelSelectors,omitempty"`
+
+	// a slice of label selector expressions that can be used to select nodes.
+	// A list of selectors based on set-based label expressions.
+	// +ui:form:ignore
+	// +optional
+	ExpressionSelectors LabelSelectorRequirements `json:"expressionSelectors,omitempty"`
+}
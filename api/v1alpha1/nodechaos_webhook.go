@@ -0,0 +1,61 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (in *NodeChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch in.Action {
+	case NodeTaintAction:
+		if in.Taint == nil {
+			err := errors.Errorf("taint should be set on %s action", in.Action)
+			allErrs = append(allErrs, field.Invalid(path.Child("taint"), in.Taint, err.Error()))
+		} else if len(in.Taint.Key) == 0 || len(in.Taint.Effect) == 0 {
+			err := errors.New("the key and effect of the taint are required")
+			allErrs = append(allErrs, field.Invalid(path.Child("taint"), in.Taint, err.Error()))
+		}
+	case KubeletStopAction:
+		// the kubelet is started again by the node itself after the duration,
+		// so the node could be recovered even if chaos mesh is not available
+		if in.Duration == nil || len(*in.Duration) == 0 {
+			err := errors.Errorf("duration should be set on %s action", in.Action)
+			allErrs = append(allErrs, field.Invalid(path.Child("duration"), in.Duration, err.Error()))
+		} else if duration, err := time.ParseDuration(*in.Duration); err == nil && duration <= 0 {
+			err := errors.Errorf("duration should be positive on %s action", in.Action)
+			allErrs = append(allErrs, field.Invalid(path.Child("duration"), in.Duration, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// Validate validates the value of the selector mode, in the same way as PodSelector
+func (s *NodeSelector) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if s == nil {
+		return nil
+	}
+
+	selector := &PodSelector{
+		Mode:  s.Mode,
+		Value: s.Value,
+	}
+	return selector.Validate(root, path)
+}
@@ -0,0 +1,286 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("nodechaos_webhook", func() {
+	Context("webhook.Validator of nodechaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name    string
+				chaos   NodeChaos
+				execute func(chaos *NodeChaos) error
+				expect  string
+			}
+			zeroDuration := "0s"
+			duration := "1m"
+
+			tcs := []TestCase{
+				{
+					name: "simple ValidateCreate",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo1",
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateUpdate",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo2",
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateUpdate(context.Background(), chaos, chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateDelete",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo3",
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateDelete(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate drain action",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo4",
+						},
+						Spec: NodeChaosSpec{
+							NodeSelector: NodeSelector{
+								Selector: NodeSelectorSpec{
+									Nodes: []string{"node1"},
+								},
+								Mode: OneMode,
+							},
+							Action: NodeDrainAction,
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate the taint is required",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo5",
+						},
+						Spec: NodeChaosSpec{
+							NodeSelector: NodeSelector{
+								Selector: NodeSelectorSpec{
+									Nodes: []string{"node1"},
+								},
+								Mode: OneMode,
+							},
+							Action: NodeTaintAction,
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the key and effect of the taint",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: NodeChaosSpec{
+							NodeSelector: NodeSelector{
+								Selector: NodeSelectorSpec{
+									Nodes: []string{"node1"},
+								},
+								Mode: OneMode,
+							},
+							Action: NodeTaintAction,
+							Taint: &corev1.Taint{
+								Key: "chaos",
+							},
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate taint action",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: NodeChaosSpec{
+							NodeSelector: NodeSelector{
+								Selector: NodeSelectorSpec{
+									Nodes: []string{"node1"},
+								},
+								Mode: OneMode,
+							},
+							Action: NodeTaintAction,
+							Taint: &corev1.Taint{
+								Key:    "chaos",
+								Effect: corev1.TaintEffectNoSchedule,
+							},
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate the duration is required",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: NodeChaosSpec{
+							NodeSelector: NodeSelector{
+								Selector: NodeSelectorSpec{
+									Nodes: []string{"node1"},
+								},
+								Mode: OneMode,
+							},
+							Action: KubeletStopAction,
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the duration is positive",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: NodeChaosSpec{
+							NodeSelector: NodeSelector{
+								Selector: NodeSelectorSpec{
+									Nodes: []string{"node1"},
+								},
+								Mode: OneMode,
+							},
+							Action:   KubeletStopAction,
+							Duration: &zeroDuration,
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate kubelet-stop action",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: NodeChaosSpec{
+							NodeSelector: NodeSelector{
+								Selector: NodeSelectorSpec{
+									Nodes: []string{"node1"},
+								},
+								Mode: OneMode,
+							},
+							Action:   KubeletStopAction,
+							Duration: &duration,
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate value with FixedMode",
+					chaos: NodeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: NodeChaosSpec{
+							NodeSelector: NodeSelector{
+								Mode:  FixedMode,
+								Value: "0",
+							},
+							Action: NodeDrainAction,
+						},
+					},
+					execute: func(chaos *NodeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.execute(&tc.chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	})
+})
@@ -51,6 +51,22 @@ const (
 	PodFailureAction PodChaosAction = "pod-failure"
 	// ContainerKillAction represents the chaos action of killing the container
 	ContainerKillAction PodChaosAction = "container-kill"
+	// ContainerFreezeAction represents the chaos action of freezing all processes of the container.
+	// The processes are resumed when the chaos is recovered.
+	ContainerFreezeAction PodChaosAction = "container-freeze"
+	// ContainerCrashLoopAction represents the chaos action of killing the container, and killing it
+	// again every CrashLoopInterval after it is restarted, until the chaos is recovered.
+	ContainerCrashLoopAction PodChaosAction = "container-crash-loop"
+)
+
+// FreezeMethod represents the way to freeze the processes of a container.
+type FreezeMethod string
+
+const (
+	// CgroupFreezeMethod freezes the processes with the cgroup freezer.
+	CgroupFreezeMethod FreezeMethod = "cgroup"
+	// SigstopFreezeMethod stops the processes with SIGSTOP, and resumes them with SIGCONT.
+	SigstopFreezeMethod FreezeMethod = "sigstop"
 )
 
 // PodChaosSpec defines the attributes that a user creates on a chaos experiment about pods.
@@ -58,9 +74,9 @@ type PodChaosSpec struct {
 	ContainerSelector `json:",inline"`
 
 	// Action defines the specific pod chaos action.
-	// Supported action: pod-kill / pod-failure / container-kill
+	// Supported action: pod-kill / pod-failure / container-kill / container-freeze / container-crash-loop
 	// Default action: pod-kill
-	// +kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill
+	// +kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill;container-freeze;container-crash-loop
 	Action PodChaosAction `json:"action"`
 
 	// Duration represents the duration of the chaos action.
@@ -72,12 +88,32 @@ type PodChaosSpec struct {
 	// +optional
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
 
-	// GracePeriod is used in pod-kill action. It represents the duration in seconds before the pod should be deleted.
+	// GracePeriod is used in pod-kill and container-kill action. It represents the duration in seconds before the pod should be deleted,
+	// or before the container is killed with SIGKILL after it receives the Signal.
 	// Value must be non-negative integer. The default value is zero that indicates delete immediately.
 	// +optional
 	// +kubebuilder:validation:Minimum=0
 	GracePeriod int64 `json:"gracePeriod,omitempty"`
 
+	// Signal is used in container-kill action. It represents the signal sent to the main process of the container.
+	// The default value is SIGKILL. The injection fails if the main process would ignore the signal, e.g. it's the
+	// init process of the pid namespace and has no handler for the signal.
+	// +optional
+	// +kubebuilder:validation:Enum=SIGKILL;SIGTERM;SIGINT;SIGQUIT;SIGHUP;SIGABRT;SIGUSR1;SIGUSR2
+	Signal string `json:"signal,omitempty"`
+
+	// FreezeMethod is used in container-freeze action. It represents the way to freeze the processes of the container.
+	// Supported method: cgroup / sigstop
+	// Default method: cgroup
+	// +optional
+	// +kubebuilder:validation:Enum=cgroup;sigstop
+	FreezeMethod FreezeMethod `json:"freezeMethod,omitempty"`
+
+	// CrashLoopInterval is used in container-crash-loop action. It represents how long the restarted container
+	// keeps running before it is killed again.
+	// +optional
+	CrashLoopInterval *string `json:"crashLoopInterval,omitempty" webhook:"Duration"`
+
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
@@ -86,6 +122,19 @@ type PodChaosSpec struct {
 // PodChaosStatus represents the current status of the chaos experiment about pods.
 type PodChaosStatus struct {
 	ChaosStatus `json:",inline"`
+
+	// CrashLoops records the crash loops started by chaos-daemon for each container in container-crash-loop action
+	// +optional
+	CrashLoops map[string]PodCrashLoopInstance `json:"crashLoops,omitempty"`
+}
+
+// PodCrashLoopInstance is a crash loop started by chaos-daemon, it's recorded so the loop could be
+// stopped even if the pod has gone
+type PodCrashLoopInstance struct {
+	// NodeName is the node where the crash loop runs
+	NodeName string `json:"nodeName"`
+	// PodUID is the uid of the pod, which identifies the crash loop with the container name
+	PodUID string `json:"podUID"`
 }
 
 func (obj *PodChaos) GetSelectorSpecs() map[string]interface{} {
@@ -94,7 +143,7 @@ func (obj *PodChaos) GetSelectorSpecs() map[string]interface{} {
 		return map[string]interface{}{
 			".": &obj.Spec.PodSelector,
 		}
-	case ContainerKillAction:
+	case ContainerKillAction, ContainerFreezeAction, ContainerCrashLoopAction:
 		return map[string]interface{}{
 			".": &obj.Spec.ContainerSelector,
 		}
@@ -102,3 +151,7 @@ func (obj *PodChaos) GetSelectorSpecs() map[string]interface{} {
 
 	return nil
 }
+
+func (obj *PodChaos) GetCustomStatus() interface{} {
+	return &obj.Status.CrashLoops
+}
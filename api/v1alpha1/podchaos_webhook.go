@@ -16,6 +16,8 @@
 package v1alpha1
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -23,11 +25,21 @@ import (
 // validateContainerNames validates the ContainerNames
 func (in *PodChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	if in.Action == ContainerKillAction {
+	switch in.Action {
+	case ContainerKillAction, ContainerFreezeAction, ContainerCrashLoopAction:
 		if len(in.ContainerSelector.ContainerNames) == 0 {
 			err := errors.Wrapf(errInvalidValue, "the name of container is required on %s action", in.Action)
 			allErrs = append(allErrs, field.Invalid(path.Child("containerNames"), in.ContainerNames, err.Error()))
 		}
 	}
+	if in.Action == ContainerCrashLoopAction {
+		if in.CrashLoopInterval == nil {
+			err := errors.Wrapf(errInvalidValue, "the crash loop interval is required on %s action", in.Action)
+			allErrs = append(allErrs, field.Invalid(path.Child("crashLoopInterval"), in.CrashLoopInterval, err.Error()))
+		} else if interval, err := time.ParseDuration(*in.CrashLoopInterval); err == nil && interval <= 0 {
+			err := errors.Wrapf(errInvalidValue, "the crash loop interval should be positive")
+			allErrs = append(allErrs, field.Invalid(path.Child("crashLoopInterval"), in.CrashLoopInterval, err.Error()))
+		}
+	}
 	return allErrs
 }
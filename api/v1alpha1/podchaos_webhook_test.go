@@ -42,6 +42,9 @@ var _ = Describe("podchaos_webhook", func() {
 				execute func(chaos *PodChaos) error
 				expect  string
 			}
+			zeroInterval := "0s"
+			invalidInterval := "30"
+			interval := "30s"
 			tcs := []TestCase{
 				{
 					name: "simple ValidateCreate for ContainerKillAction",
@@ -91,6 +94,142 @@ var _ = Describe("podchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate the ContainerNames of ContainerFreezeAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: PodChaosSpec{
+							Action: ContainerFreezeAction,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate ContainerFreezeAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: PodChaosSpec{
+							ContainerSelector: ContainerSelector{
+								PodSelector: PodSelector{
+									Mode: OneMode,
+								},
+								ContainerNames: []string{"app"},
+							},
+							Action:       ContainerFreezeAction,
+							FreezeMethod: SigstopFreezeMethod,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate the CrashLoopInterval is required",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: PodChaosSpec{
+							ContainerSelector: ContainerSelector{
+								PodSelector: PodSelector{
+									Mode: OneMode,
+								},
+								ContainerNames: []string{"app"},
+							},
+							Action: ContainerCrashLoopAction,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the CrashLoopInterval is positive",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: PodChaosSpec{
+							ContainerSelector: ContainerSelector{
+								PodSelector: PodSelector{
+									Mode: OneMode,
+								},
+								ContainerNames: []string{"app"},
+							},
+							Action:            ContainerCrashLoopAction,
+							CrashLoopInterval: &zeroInterval,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the CrashLoopInterval is a duration",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo12",
+						},
+						Spec: PodChaosSpec{
+							ContainerSelector: ContainerSelector{
+								PodSelector: PodSelector{
+									Mode: OneMode,
+								},
+								ContainerNames: []string{"app"},
+							},
+							Action:            ContainerCrashLoopAction,
+							CrashLoopInterval: &invalidInterval,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate ContainerCrashLoopAction",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: PodChaosSpec{
+							ContainerSelector: ContainerSelector{
+								PodSelector: PodSelector{
+									Mode: OneMode,
+								},
+								ContainerNames: []string{"app"},
+							},
+							Action:            ContainerCrashLoopAction,
+							CrashLoopInterval: &interval,
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -56,6 +56,10 @@ type IOChaosAction struct {
 	// +optional
 	*MistakeSpec `json:"mistake,omitempty"`
 
+	// ShortRWSpec represents how the results of read or write are shortened
+	// +optional
+	*ShortRWSpec `json:"shortRW,omitempty"`
+
 	// Source represents the source of current rules
 	Source string `json:"source,omitempty"`
 }
@@ -75,6 +79,13 @@ const (
 
 	// IoMistake represents injecting incorrect read or write for io operation
 	IoMistake IOChaosType = "mistake"
+
+	// IoShortRW represents returning fewer bytes than requested for read or write operation
+	IoShortRW IOChaosType = "shortRW"
+
+	// IoDiskFill represents allocating files to fill the volume.
+	// It's handled by chaos-daemon directly, instead of toda.
+	IoDiskFill IOChaosType = "diskFill"
 )
 
 // Filter represents a filter of IOChaos action, which will define the
@@ -143,6 +154,21 @@ type MistakeSpec struct {
 	MaxLength int64 `json:"maxLength,omitempty"`
 }
 
+// ShortRWSpec represents how the length of a read or write is truncated.
+// Exactly one of Ratio and Size should be set.
+type ShortRWSpec struct {
+	// Ratio is the fraction of the requested length which is actually
+	// read or written, e.g. "0.5". It must be in the range (0, 1).
+	// +optional
+	Ratio string `json:"ratio,omitempty"`
+
+	// Size is the max number of bytes which is actually read or written
+	// by one operation.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Size int64 `json:"size,omitempty"`
+}
+
 // FillingType represents type of data is filled for incorrectness
 type FillingType string
 
@@ -17,6 +17,8 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -92,6 +94,9 @@ type StressInstance struct {
 	// StartTime specifies when the stress-ng starts
 	// +optional
 	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Stressors are the names of typed stressors running in this instance
+	// +optional
+	Stressors []string `json:"stressors,omitempty"`
 	// MemoryStartTime specifies when the memStress starts
 	// +optional
 	MemoryStartTime *metav1.Time `json:"memoryStartTime,omitempty"`
@@ -106,9 +111,82 @@ type Stressors struct {
 	// CPUStressor stresses CPU out
 	// +optional
 	CPUStressor *CPUStressor `json:"cpu,omitempty"`
+	// HDDStressor stresses disk I/O out by writing, reading and removing temporary files
+	// +optional
+	HDDStressor *HDDStressor `json:"hdd,omitempty"`
+	// IOMixStressor stresses disk I/O out by a mix of sequential, random and memory mapped read/write operations
+	// +optional
+	IOMixStressor *IOMixStressor `json:"iomix,omitempty"`
+	// SocketStressor stresses network stack out by sending and receiving data through sockets
+	// +optional
+	SocketStressor *SocketStressor `json:"socket,omitempty"`
+	// ForkStressor stresses process creation out by forking and exiting children continually
+	// +optional
+	ForkStressor *ForkStressor `json:"fork,omitempty"`
+	// PipeStressor stresses pipe I/O and context switching out
+	// +optional
+	PipeStressor *PipeStressor `json:"pipe,omitempty"`
+	// CacheStressor stresses CPU cache out by thrashing it with reads and writes
+	// +optional
+	CacheStressor *CacheStressor `json:"cache,omitempty"`
+	// VMStressor stresses virtual memory out with stress-ng vm stressors
+	// +optional
+	VMStressor *VMStressor `json:"vm,omitempty"`
+}
+
+// stressngStressor is implemented by typed stressors which are executed by stress-ng
+type stressngStressor interface {
+	// name returns the stressor name in stress-ng
+	name() string
+	// args returns the stress-ng arguments of the stressor
+	args() []string
+}
+
+// stressngStressors returns the typed stressors executed by stress-ng in a stable order
+func (in *Stressors) stressngStressors() []stressngStressor {
+	stressors := []stressngStressor{}
+	if in.CPUStressor != nil && in.CPUStressor.Workers != 0 {
+		stressors = append(stressors, in.CPUStressor)
+	}
+	if in.HDDStressor != nil && in.HDDStressor.Workers != 0 {
+		stressors = append(stressors, in.HDDStressor)
+	}
+	if in.IOMixStressor != nil && in.IOMixStressor.Workers != 0 {
+		stressors = append(stressors, in.IOMixStressor)
+	}
+	if in.SocketStressor != nil && in.SocketStressor.Workers != 0 {
+		stressors = append(stressors, in.SocketStressor)
+	}
+	if in.ForkStressor != nil && in.ForkStressor.Workers != 0 {
+		stressors = append(stressors, in.ForkStressor)
+	}
+	if in.PipeStressor != nil && in.PipeStressor.Workers != 0 {
+		stressors = append(stressors, in.PipeStressor)
+	}
+	if in.CacheStressor != nil && in.CacheStressor.Workers != 0 {
+		stressors = append(stressors, in.CacheStressor)
+	}
+	if in.VMStressor != nil && in.VMStressor.Workers != 0 {
+		stressors = append(stressors, in.VMStressor)
+	}
+	return stressors
+}
+
+// Names returns the names of stressors which will be executed
+func (in *Stressors) Names() []string {
+	names := []string{}
+	for _, stressor := range in.stressngStressors() {
+		names = append(names, stressor.name())
+	}
+	if in.MemoryStressor != nil && in.MemoryStressor.Workers != 0 {
+		names = append(names, "memory")
+	}
+	return names
 }
 
-// Normalize the stressors to comply with stress-ng
+// Normalize the stressors to comply with stress-ng. The cpuStressors contains
+// the arguments of all typed stressors executed by stress-ng, and the
+// memoryStressors contains the arguments of memStress.
 func (in *Stressors) Normalize() (cpuStressors string, memoryStressors string, err error) {
 	cpuStressors = ""
 	memoryStressors = ""
@@ -127,23 +205,9 @@ func (in *Stressors) Normalize() (cpuStressors string, memoryStressors string, e
 			}
 		}
 	}
-	if in.CPUStressor != nil && in.CPUStressor.Workers != 0 {
-		// Without these two args, we may not reach the resource limit of pod,
-		// especially when we set cpu workers > 1
-		// More details see: https://github.com/chaos-mesh/chaos-mesh/issues/3100
-		cpuStressors += " --cpu-load-slice 10 --cpu-method sqrt"
-		cpuStressors += fmt.Sprintf(" --cpu %d", in.CPUStressor.Workers)
-
-		if in.CPUStressor.Load != nil {
-			cpuStressors += fmt.Sprintf(" --cpu-load %d",
-				*in.CPUStressor.Load)
-		}
 
-		if in.CPUStressor.Options != nil {
-			for _, v := range in.CPUStressor.Options {
-				cpuStressors += fmt.Sprintf(" %v ", v)
-			}
-		}
+	for _, stressor := range in.stressngStressors() {
+		cpuStressors += " " + strings.Join(stressor.args(), " ")
 	}
 
 	return
@@ -167,6 +231,22 @@ type MemoryStressor struct {
 	// +optional
 	Size string `json:"size,omitempty" webhook:"Bytes"`
 
+	// LimitPercent specifies the bytes consumed by all workers as a percentage of the memory
+	// limit of the target container, which is memory.max in cgroup v2 or memory.limit_in_bytes
+	// in cgroup v1. It cannot be used together with Size, and the target container must have a
+	// memory limit.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	LimitPercent int `json:"limitPercent,omitempty"`
+
+	// TriggerOOM pushes the target container to be OOM-killed. The stress process allocates
+	// the whole memory limit of the container unless LimitPercent is specified, and its
+	// oom_score_adj is lowered so that the OOM killer picks the application process instead
+	// of the stressor. It cannot be used together with OOMScoreAdj.
+	// +optional
+	TriggerOOM bool `json:"triggerOOM,omitempty"`
+
 	// OOMScoreAdj sets the oom_score_adj of the stress process. See `man 5 proc` to know more
 	// about this option.
 	// +kubebuilder:validation:Minimum=-1000
@@ -190,11 +270,280 @@ type CPUStressor struct {
 	// +optional
 	Load *int `json:"load,omitempty"`
 
+	// Method specifies the cpu stress method of stress-ng, default is sqrt.
+	// See `--cpu-method` in `man stress-ng` for the supported methods.
+	// +optional
+	Method string `json:"method,omitempty" webhook:"CPUStressMethod"`
+
 	// extend stress-ng options
 	// +optional
 	Options []string `json:"options,omitempty"`
 }
 
+func (in *CPUStressor) name() string {
+	return "cpu"
+}
+
+func (in *CPUStressor) args() []string {
+	method := in.Method
+	if len(method) == 0 {
+		method = "sqrt"
+	}
+
+	// Without `--cpu-load-slice`, we may not reach the resource limit of pod,
+	// especially when we set cpu workers > 1
+	// More details see: https://github.com/chaos-mesh/chaos-mesh/issues/3100
+	args := []string{"--cpu-load-slice", "10", "--cpu-method", method, "--cpu", strconv.Itoa(in.Workers)}
+	if in.Load != nil {
+		args = append(args, "--cpu-load", strconv.Itoa(*in.Load))
+	}
+	return append(args, in.Options...)
+}
+
+// HDDStressor defines how to stress disk I/O out with stress-ng hdd stressors
+type HDDStressor struct {
+	Stressor `json:",inline"`
+
+	// Bytes specifies N bytes written per hdd worker, default is 1GB.
+	// One can specify the size as % of free space on the file system or in units of B, KB/KiB,
+	// MB/MiB, GB/GiB, TB/TiB.
+	// +optional
+	Bytes string `json:"bytes,omitempty" webhook:"Bytes"`
+
+	// WriteSize specifies the size of each write in bytes, default is 64KB.
+	// The size should be in units of B, KB/KiB, MB/MiB.
+	// +optional
+	WriteSize string `json:"writeSize,omitempty" webhook:"Bytes"`
+
+	// Path specifies the directory inside the container to write the temporary files,
+	// default is the working directory of stress-ng.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+func (in *HDDStressor) name() string {
+	return "hdd"
+}
+
+func (in *HDDStressor) args() []string {
+	args := []string{"--hdd", strconv.Itoa(in.Workers)}
+	if len(in.Bytes) != 0 {
+		args = append(args, "--hdd-bytes", in.Bytes)
+	}
+	if len(in.WriteSize) != 0 {
+		args = append(args, "--hdd-write-size", in.WriteSize)
+	}
+	if len(in.Path) != 0 {
+		args = append(args, "--temp-path", in.Path)
+	}
+	return args
+}
+
+// IOMixStressor defines how to stress disk I/O out with stress-ng iomix stressors
+type IOMixStressor struct {
+	Stressor `json:",inline"`
+
+	// Bytes specifies N bytes written per iomix worker, default is 1GB.
+	// One can specify the size as % of free space on the file system or in units of B, KB/KiB,
+	// MB/MiB, GB/GiB, TB/TiB.
+	// +optional
+	Bytes string `json:"bytes,omitempty" webhook:"Bytes"`
+
+	// Path specifies the directory inside the container to write the temporary files,
+	// default is the working directory of stress-ng.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+func (in *IOMixStressor) name() string {
+	return "iomix"
+}
+
+func (in *IOMixStressor) args() []string {
+	args := []string{"--iomix", strconv.Itoa(in.Workers)}
+	if len(in.Bytes) != 0 {
+		args = append(args, "--iomix-bytes", in.Bytes)
+	}
+	if len(in.Path) != 0 {
+		args = append(args, "--temp-path", in.Path)
+	}
+	return args
+}
+
+// SocketDomain is the domain of sockets used by SocketStressor
+type SocketDomain string
+
+const (
+	SocketDomainIPv4 SocketDomain = "ipv4"
+	SocketDomainIPv6 SocketDomain = "ipv6"
+	SocketDomainUnix SocketDomain = "unix"
+)
+
+// SocketType is the type of sockets used by SocketStressor
+type SocketType string
+
+const (
+	SocketTypeStream    SocketType = "stream"
+	SocketTypeSeqpacket SocketType = "seqpacket"
+)
+
+// SocketStressor defines how to stress network stack out with stress-ng sock stressors
+type SocketStressor struct {
+	Stressor `json:",inline"`
+
+	// Domain specifies the domain of sockets, default is ipv4.
+	// +kubebuilder:validation:Enum=ipv4;ipv6;unix
+	// +optional
+	Domain SocketDomain `json:"domain,omitempty"`
+
+	// Type specifies the type of sockets, default is stream.
+	// +kubebuilder:validation:Enum=stream;seqpacket
+	// +optional
+	Type SocketType `json:"type,omitempty"`
+
+	// Port specifies the start port of sockets, default is 5000. Each worker uses
+	// its own port, from Port to Port + Workers - 1.
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int `json:"port,omitempty"`
+}
+
+func (in *SocketStressor) name() string {
+	return "sock"
+}
+
+func (in *SocketStressor) args() []string {
+	args := []string{"--sock", strconv.Itoa(in.Workers)}
+	if len(in.Domain) != 0 {
+		args = append(args, "--sock-domain", string(in.Domain))
+	}
+	if len(in.Type) != 0 {
+		args = append(args, "--sock-type", string(in.Type))
+	}
+	if in.Port != 0 {
+		args = append(args, "--sock-port", strconv.Itoa(in.Port))
+	}
+	return args
+}
+
+// ForkStressor defines how to stress process creation out with stress-ng fork stressors
+type ForkStressor struct {
+	Stressor `json:",inline"`
+
+	// Max specifies the number of child processes created by each worker at once, default is 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16000
+	// +optional
+	Max int `json:"max,omitempty"`
+}
+
+func (in *ForkStressor) name() string {
+	return "fork"
+}
+
+func (in *ForkStressor) args() []string {
+	args := []string{"--fork", strconv.Itoa(in.Workers)}
+	if in.Max != 0 {
+		args = append(args, "--fork-max", strconv.Itoa(in.Max))
+	}
+	return args
+}
+
+// PipeStressor defines how to stress pipe I/O out with stress-ng pipe stressors
+type PipeStressor struct {
+	Stressor `json:",inline"`
+
+	// DataSize specifies the size in bytes of each write to the pipe, default is 512.
+	// +kubebuilder:validation:Minimum=4
+	// +kubebuilder:validation:Maximum=4096
+	// +optional
+	DataSize int `json:"dataSize,omitempty"`
+
+	// Size specifies the size of the pipe buffer, default is decided by the kernel.
+	// The size should be in units of B, KB/KiB, MB/MiB.
+	// +optional
+	Size string `json:"size,omitempty" webhook:"Bytes"`
+}
+
+func (in *PipeStressor) name() string {
+	return "pipe"
+}
+
+func (in *PipeStressor) args() []string {
+	args := []string{"--pipe", strconv.Itoa(in.Workers)}
+	if in.DataSize != 0 {
+		args = append(args, "--pipe-data-size", strconv.Itoa(in.DataSize))
+	}
+	if len(in.Size) != 0 {
+		args = append(args, "--pipe-size", in.Size)
+	}
+	return args
+}
+
+// CacheStressor defines how to stress CPU cache out with stress-ng cache stressors
+type CacheStressor struct {
+	Stressor `json:",inline"`
+
+	// Level specifies the level of CPU cache to thrash, default is 3.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3
+	// +optional
+	Level int `json:"level,omitempty"`
+}
+
+func (in *CacheStressor) name() string {
+	return "cache"
+}
+
+func (in *CacheStressor) args() []string {
+	args := []string{"--cache", strconv.Itoa(in.Workers)}
+	if in.Level != 0 {
+		args = append(args, "--cache-level", strconv.Itoa(in.Level))
+	}
+	return args
+}
+
+// VMStressor defines how to stress virtual memory out with stress-ng vm stressors.
+// Different from MemoryStressor, it keeps reading and writing the allocated memory.
+type VMStressor struct {
+	Stressor `json:",inline"`
+
+	// Bytes specifies N bytes allocated per vm worker, default is 256MB.
+	// One can specify the size as % of total available memory or in units of B, KB/KiB,
+	// MB/MiB, GB/GiB, TB/TiB.
+	// +optional
+	Bytes string `json:"bytes,omitempty" webhook:"Bytes"`
+
+	// Method specifies the vm stress method of stress-ng, default is all.
+	// See `--vm-method` in `man stress-ng` for the supported methods.
+	// +optional
+	Method string `json:"method,omitempty" webhook:"VMStressMethod"`
+
+	// Keep specifies whether to keep the memory mapped instead of unmapping and
+	// mapping it again in every iteration.
+	// +optional
+	Keep bool `json:"keep,omitempty"`
+}
+
+func (in *VMStressor) name() string {
+	return "vm"
+}
+
+func (in *VMStressor) args() []string {
+	args := []string{"--vm", strconv.Itoa(in.Workers)}
+	if len(in.Bytes) != 0 {
+		args = append(args, "--vm-bytes", in.Bytes)
+	}
+	if len(in.Method) != 0 {
+		args = append(args, "--vm-method", in.Method)
+	}
+	if in.Keep {
+		args = append(args, "--vm-keep")
+	}
+	return args
+}
+
 func (obj *StressChaos) GetSelectorSpecs() map[string]interface{} {
 	return map[string]interface{}{
 		".": &obj.Spec.ContainerSelector,
@@ -43,7 +43,9 @@ func (in *Stressors) Validate(root interface{}, path *field.Path) field.ErrorLis
 		return nil
 	}
 
-	if in.MemoryStressor == nil && in.CPUStressor == nil {
+	if in.MemoryStressor == nil && in.CPUStressor == nil && in.HDDStressor == nil &&
+		in.IOMixStressor == nil && in.SocketStressor == nil && in.ForkStressor == nil &&
+		in.PipeStressor == nil && in.CacheStressor == nil && in.VMStressor == nil {
 		return field.ErrorList{
 			field.Invalid(path, in, "missing stressors"),
 		}
@@ -51,6 +53,21 @@ func (in *Stressors) Validate(root interface{}, path *field.Path) field.ErrorLis
 	return nil
 }
 
+// Validate validates whether the MemoryStressor is well defined
+func (in *MemoryStressor) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if in.LimitPercent != 0 && len(in.Size) != 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("limitPercent"), in.LimitPercent, "limitPercent cannot be used together with size"))
+	}
+	if in.TriggerOOM && len(in.Size) != 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("triggerOOM"), in.TriggerOOM, "triggerOOM cannot be used together with size"))
+	}
+	if in.TriggerOOM && in.OOMScoreAdj != 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("triggerOOM"), in.TriggerOOM, "triggerOOM cannot be used together with oomScoreAdj"))
+	}
+	return allErrs
+}
+
 type Bytes string
 
 func (in *Bytes) Validate(root interface{}, path *field.Path) field.ErrorList {
@@ -88,6 +105,60 @@ func (in *Bytes) Validate(root interface{}, path *field.Path) field.ErrorList {
 	return nil
 }
 
+// cpuStressMethods are the methods supported by `stress-ng --cpu-method`
+var cpuStressMethods = []string{
+	"all", "ackermann", "apery", "bitops", "callfunc", "cdouble", "cfloat",
+	"clongdouble", "collatz", "correlate", "crc16", "decimal32", "decimal64",
+	"decimal128", "dither", "div8", "div16", "div32", "div64", "div128",
+	"double", "euler", "explog", "factorial", "fibonacci", "fft", "fletcher16",
+	"float", "float32", "float64", "float80", "float128", "floatconversion",
+	"gamma", "gcd", "gray", "hamming", "hanoi", "hyperbolic", "idct",
+	"int128", "int64", "int32", "int16", "int8", "intconversion",
+	"int128float", "int128double", "int128longdouble", "int64float",
+	"int64double", "int64longdouble", "int32float", "int32double",
+	"int32longdouble", "ipv4checksum", "jmp", "lfsr32", "ln2", "logmap",
+	"longdouble", "loop", "matrixprod", "nsqrt", "omega", "parity", "phi",
+	"pi", "prime", "psi", "queens", "rand", "rand48", "rgb", "sieve", "stats",
+	"sqrt", "trig", "union", "zeta",
+}
+
+// vmStressMethods are the methods supported by `stress-ng --vm-method`
+var vmStressMethods = []string{
+	"all", "cache-lines", "cache-stripe", "checkerboard", "flip", "fwdrev",
+	"galpat-0", "galpat-1", "gray", "grayflip", "incdec", "inc-nybble",
+	"lfsr32", "modulo-x", "move-inv", "mscan", "prime-0", "prime-1",
+	"prime-gray-0", "prime-gray-1", "prime-incdec", "rand-set", "rand-sum",
+	"read64", "ror", "swap", "walk-0a", "walk-0d", "walk-1a", "walk-1d",
+	"write64", "write64nt", "write1024v", "zero-one",
+}
+
+func validateStressMethod(path *field.Path, method string, methods []string) field.ErrorList {
+	// the default method of stress-ng is used if it's omitted
+	if len(method) == 0 {
+		return nil
+	}
+	for _, m := range methods {
+		if method == m {
+			return nil
+		}
+	}
+	return field.ErrorList{
+		field.NotSupported(path, method, methods),
+	}
+}
+
+type CPUStressMethod string
+
+func (in *CPUStressMethod) Validate(root interface{}, path *field.Path) field.ErrorList {
+	return validateStressMethod(path, string(*in), cpuStressMethods)
+}
+
+type VMStressMethod string
+
+func (in *VMStressMethod) Validate(root interface{}, path *field.Path) field.ErrorList {
+	return validateStressMethod(path, string(*in), vmStressMethods)
+}
+
 // Validate validates whether the Stressor is well defined
 func (in *Stressor) Validate(parent *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
@@ -99,4 +170,6 @@ func (in *Stressor) Validate(parent *field.Path) field.ErrorList {
 
 func init() {
 	genericwebhook.Register("Bytes", reflect.PtrTo(reflect.TypeOf(Bytes(""))))
+	genericwebhook.Register("CPUStressMethod", reflect.PtrTo(reflect.TypeOf(CPUStressMethod(""))))
+	genericwebhook.Register("VMStressMethod", reflect.PtrTo(reflect.TypeOf(VMStressMethod(""))))
 }
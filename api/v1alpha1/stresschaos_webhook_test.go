@@ -113,6 +113,196 @@ var _ = Describe("stresschaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "typed stressors",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								HDDStressor: &HDDStressor{
+									Stressor:  Stressor{Workers: 1},
+									Bytes:     "10%",
+									WriteSize: "64KB",
+								},
+								SocketStressor: &SocketStressor{
+									Stressor: Stressor{Workers: 1},
+									Domain:   SocketDomainIPv4,
+								},
+								VMStressor: &VMStressor{
+									Stressor: Stressor{Workers: 1},
+									Method:   "flip",
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "invalid hdd bytes",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								HDDStressor: &HDDStressor{
+									Stressor: Stressor{Workers: 1},
+									Bytes:    "101%",
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid cpu method",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								CPUStressor: &CPUStressor{
+									Stressor: Stressor{Workers: 1},
+									Method:   "foo",
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid vm method",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								VMStressor: &VMStressor{
+									Stressor: Stressor{Workers: 1},
+									Method:   "foo",
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "cpu and vm stressors without method",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9-default-method",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								CPUStressor: &CPUStressor{
+									Stressor: Stressor{Workers: 1},
+								},
+								VMStressor: &VMStressor{
+									Stressor: Stressor{Workers: 1},
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "limitPercent with size",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								MemoryStressor: &MemoryStressor{
+									Stressor:     Stressor{Workers: 1},
+									Size:         "100MB",
+									LimitPercent: 50,
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "triggerOOM with oomScoreAdj",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								MemoryStressor: &MemoryStressor{
+									Stressor:    Stressor{Workers: 1},
+									TriggerOOM:  true,
+									OOMScoreAdj: 100,
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "triggerOOM with limitPercent",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo12",
+						},
+						Spec: StressChaosSpec{
+							Stressors: &Stressors{
+								MemoryStressor: &MemoryStressor{
+									Stressor:     Stressor{Workers: 1},
+									LimitPercent: 90,
+									TriggerOOM:   true,
+								},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -125,6 +315,43 @@ var _ = Describe("stresschaos_webhook", func() {
 			}
 		})
 
+		It("Normalize Stressors", func() {
+			load := 50
+			stressors := &Stressors{
+				CPUStressor: &CPUStressor{
+					Stressor: Stressor{Workers: 2},
+					Load:     &load,
+				},
+				HDDStressor: &HDDStressor{
+					Stressor: Stressor{Workers: 1},
+					Bytes:    "1GB",
+				},
+				SocketStressor: &SocketStressor{
+					Stressor: Stressor{Workers: 1},
+					Domain:   SocketDomainUnix,
+				},
+				CacheStressor: &CacheStressor{
+					Stressor: Stressor{Workers: 1},
+					Level:    2,
+				},
+				MemoryStressor: &MemoryStressor{
+					Stressor: Stressor{Workers: 1},
+					Size:     "100MB",
+				},
+			}
+			cpuStressors, memoryStressors, err := stressors.Normalize()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cpuStressors).To(Equal(" --cpu-load-slice 10 --cpu-method sqrt --cpu 2 --cpu-load 50" +
+				" --hdd 1 --hdd-bytes 1GB --sock 1 --sock-domain unix --cache 1 --cache-level 2"))
+			Expect(memoryStressors).To(Equal(" --workers 1 --size 100MB"))
+			Expect(stressors.Names()).To(Equal([]string{"cpu", "hdd", "sock", "cache", "memory"}))
+
+			stressors.CPUStressor.Method = "matrixprod"
+			cpuStressors, _, err = stressors.Normalize()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cpuStressors).To(HavePrefix(" --cpu-load-slice 10 --cpu-method matrixprod --cpu 2"))
+		})
+
 		//		It("Validate Stressors", func() {
 		//type TestCase struct {
 		//name     string
@@ -16,6 +16,8 @@
 package v1alpha1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -46,7 +48,18 @@ type TimeChaosSpec struct {
 
 	// TimeOffset defines the delta time of injected program. It's a possibly signed sequence of decimal numbers, such as
 	// "300ms", "-1.5h" or "2h45m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
-	TimeOffset string `json:"timeOffset" webhook:"TimeOffset"`
+	// At least one of TimeOffset and DriftRate should be specified.
+	// +optional
+	TimeOffset string `json:"timeOffset,omitempty" webhook:"TimeOffset"`
+
+	// DriftRate defines how fast the clock of injected program runs away from the real one, in parts per million.
+	// For example, 500 makes the clock gain 500us every second, and -500 makes it lose 500us every second.
+	// The drift starts from TimeOffset if it's specified. The clock is stepped every second to approximate the drift.
+	// The effective skew of each container is reported in the status, and the drift is resumed if chaos-daemon restarts.
+	// +kubebuilder:validation:Minimum=-1000000
+	// +kubebuilder:validation:Maximum=1000000
+	// +optional
+	DriftRate int64 `json:"driftRate,omitempty"`
 
 	// ClockIds defines all affected clock id
 	// All available options are ["CLOCK_REALTIME","CLOCK_MONOTONIC","CLOCK_PROCESS_CPUTIME_ID","CLOCK_THREAD_CPUTIME_ID",
@@ -66,6 +79,61 @@ type TimeChaosSpec struct {
 // TimeChaosStatus defines the observed state of TimeChaos
 type TimeChaosStatus struct {
 	ChaosStatus `json:",inline"`
+
+	// Instances records the time skew injected into each container
+	// +optional
+	Instances map[string]TimeSkewInstance `json:"instances,omitempty"`
+}
+
+// TimeSkewInstance is the time skew injected into a container
+type TimeSkewInstance struct {
+	// StartTime specifies when the time skew starts, the drift is accumulated since then
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EffectiveSkew is the skew of the clock in the container when it's observed at ObservedTime.
+	// It's refreshed periodically while the clock is drifting.
+	// +optional
+	EffectiveSkew string `json:"effectiveSkew,omitempty"`
+
+	// ObservedTime is the time when the EffectiveSkew is observed
+	// +optional
+	ObservedTime *metav1.Time `json:"observedTime,omitempty"`
+}
+
+// EffectiveSkew returns the skew of the clock at the given moment, which is
+// the TimeOffset plus the drift accumulated since startTime
+func (in *TimeChaosSpec) EffectiveSkew(startTime time.Time, now time.Time) (time.Duration, error) {
+	var offset time.Duration
+	if len(in.TimeOffset) != 0 {
+		var err error
+		offset, err = time.ParseDuration(in.TimeOffset)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	elapsed := now.Sub(startTime)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	drift := time.Duration(float64(elapsed) * float64(in.DriftRate) / 1e6)
+	return offset + drift, nil
+}
+
+// EffectiveSkew returns the skew of the clock in the container of the record
+// at the given moment. It returns false if the record has not been injected.
+func (in *TimeChaos) EffectiveSkew(id string, now time.Time) (time.Duration, bool, error) {
+	instance, ok := in.Status.Instances[id]
+	if !ok || instance.StartTime == nil {
+		return 0, false, nil
+	}
+
+	skew, err := in.Spec.EffectiveSkew(instance.StartTime.Time, now)
+	if err != nil {
+		return 0, false, err
+	}
+	return skew, true, nil
 }
 
 func (in *TimeChaos) GetSelectorSpecs() map[string]interface{} {
@@ -73,3 +141,7 @@ func (in *TimeChaos) GetSelectorSpecs() map[string]interface{} {
 		".": &in.Spec.ContainerSelector,
 	}
 }
+
+func (in *TimeChaos) GetCustomStatus() interface{} {
+	return &in.Status.Instances
+}
@@ -25,6 +25,16 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/api/genericwebhook"
 )
 
+// Validate validates whether the time skew is well defined
+func (in *TimeChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if len(in.TimeOffset) == 0 && in.DriftRate == 0 {
+		return field.ErrorList{
+			field.Invalid(path.Child("timeOffset"), in.TimeOffset, "either timeOffset or driftRate should be specified"),
+		}
+	}
+	return nil
+}
+
 type ClockIds []string
 
 // DefaultClockIds will set default value for empty ClockIds fields
@@ -39,6 +49,11 @@ type TimeOffset string
 func (in *TimeOffset) Validate(root interface{}, path *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	// the timeOffset could be omitted if driftRate is specified
+	if len(*in) == 0 {
+		return allErrs
+	}
+
 	_, err := time.ParseDuration(string(*in))
 	if err != nil {
 		allErrs = append(allErrs, field.Invalid(path,
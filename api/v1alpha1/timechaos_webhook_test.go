@@ -17,6 +17,7 @@ package v1alpha1
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -106,6 +107,37 @@ var _ = Describe("timechaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "missing timeOffset and driftRate",
+					chaos: TimeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+					},
+					execute: func(chaos *TimeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate driftRate",
+					chaos: TimeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: TimeChaosSpec{
+							DriftRate: 500,
+						},
+					},
+					execute: func(chaos *TimeChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -118,4 +150,21 @@ var _ = Describe("timechaos_webhook", func() {
 			}
 		})
 	})
+	Context("EffectiveSkew", func() {
+		It("adds the drift to the offset", func() {
+			spec := TimeChaosSpec{
+				TimeOffset: "-1s",
+				DriftRate:  500,
+			}
+			start := time.Now()
+			skew, err := spec.EffectiveSkew(start, start.Add(100*time.Second))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(skew).To(Equal(-time.Second + 50*time.Millisecond))
+
+			spec.DriftRate = -500
+			skew, err = spec.EffectiveSkew(start, start.Add(100*time.Second))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(skew).To(Equal(-time.Second - 50*time.Millisecond))
+		})
+	})
 })
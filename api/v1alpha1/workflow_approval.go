@@ -0,0 +1,97 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ApprovalAction is the decision made on the approval node.
+type ApprovalAction string
+
+const (
+	ApprovalActionApprove ApprovalAction = "Approve"
+	ApprovalActionReject  ApprovalAction = "Reject"
+)
+
+// ApprovalSpec describes the approval node, which blocks until it's approved or rejected by a human.
+// The decision is made by setting the annotation workflow.chaos-mesh.org/approval of the workflow node
+// to Approve or Reject, and the user who made the decision is recorded by the webhook. Chaos Dashboard only
+// accepts the decision in the security mode, as the user is unknown otherwise.
+type ApprovalSpec struct {
+	// Message is shown to the approvers.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Timeout is the duration to wait for the decision, such as 30m. It waits until the decision is made if it's omitted.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+
+	// DefaultAction is taken when the timeout exceeded.
+	// +optional
+	// +kubebuilder:validation:Enum=Approve;Reject
+	// +kubebuilder:default=Reject
+	DefaultAction ApprovalAction `json:"defaultAction,omitempty"`
+}
+
+// TimeoutAction returns the action taken when the timeout exceeded, it's Reject if DefaultAction is omitted.
+func (in *ApprovalSpec) TimeoutAction() ApprovalAction {
+	if in.DefaultAction == ApprovalActionApprove {
+		return ApprovalActionApprove
+	}
+	return ApprovalActionReject
+}
+
+// ApprovalStatus records the decision made on the approval node.
+type ApprovalStatus struct {
+	Action ApprovalAction `json:"action"`
+
+	// Approver is the user who made the decision, it's empty if the default action is taken after the timeout.
+	// +optional
+	Approver string `json:"approver,omitempty"`
+
+	// TimedOut is true if the default action is taken after the timeout.
+	// +optional
+	TimedOut bool `json:"timedOut,omitempty"`
+
+	DecisionTime metav1.Time `json:"decisionTime"`
+}
+
+func validApprovalAction(action ApprovalAction) bool {
+	return action == ApprovalActionApprove || action == ApprovalActionReject
+}
+
+func validateApproval(path *field.Path, approval *ApprovalSpec) field.ErrorList {
+	if approval == nil {
+		return field.ErrorList{field.Required(path, "the approval of template with type Approval is required")}
+	}
+
+	var result field.ErrorList
+	if approval.Timeout != nil {
+		if _, err := time.ParseDuration(*approval.Timeout); err != nil {
+			result = append(result, field.Invalid(path.Child("timeout"), *approval.Timeout, fmt.Sprintf("invalid duration: %s", err)))
+		}
+	}
+	if len(approval.DefaultAction) > 0 && !validApprovalAction(approval.DefaultAction) {
+		result = append(result, field.NotSupported(path.Child("defaultAction"), approval.DefaultAction,
+			[]string{string(ApprovalActionApprove), string(ApprovalActionReject)}))
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func Test_validateApproval(t *testing.T) {
+	approvalPath := field.NewPath("spec", "templates").Index(0).Child("approval")
+	timeout := "30m"
+	invalid := "half an hour"
+	tests := []struct {
+		name     string
+		approval *ApprovalSpec
+		wantErr  bool
+	}{
+		{name: "without timeout", approval: &ApprovalSpec{Message: "continue?"}, wantErr: false},
+		{name: "with timeout", approval: &ApprovalSpec{Timeout: &timeout, DefaultAction: ApprovalActionApprove}, wantErr: false},
+		{name: "missing approval", approval: nil, wantErr: true},
+		{name: "invalid timeout", approval: &ApprovalSpec{Timeout: &invalid}, wantErr: true},
+		{name: "invalid default action", approval: &ApprovalSpec{Timeout: &timeout, DefaultAction: "Skip"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateApproval(approvalPath, tt.approval); (len(got) > 0) != tt.wantErr {
+				t.Errorf("validateApproval() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateApprovalDecision(t *testing.T) {
+	newNode := func(templateType TemplateType, decision string) *WorkflowNode {
+		node := &WorkflowNode{Spec: WorkflowNodeSpec{Type: templateType}}
+		if len(decision) > 0 {
+			node.Annotations = map[string]string{WorkflowAnnotationApproval: decision}
+		}
+		return node
+	}
+	tests := []struct {
+		name    string
+		node    *WorkflowNode
+		oldNode *WorkflowNode
+		wantErr bool
+	}{
+		{name: "no decision", node: newNode(TypeApproval, ""), wantErr: false},
+		{name: "approve", node: newNode(TypeApproval, "Approve"), oldNode: newNode(TypeApproval, ""), wantErr: false},
+		{name: "unchanged decision", node: newNode(TypeApproval, "Reject"), oldNode: newNode(TypeApproval, "Reject"), wantErr: false},
+		{name: "unknown decision", node: newNode(TypeApproval, "Skip"), wantErr: true},
+		{name: "not an approval node", node: newNode(TypeSuspend, "Approve"), wantErr: true},
+		{name: "change the decision", node: newNode(TypeApproval, "Approve"), oldNode: newNode(TypeApproval, "Reject"), wantErr: true},
+		{name: "remove the decision", node: newNode(TypeApproval, ""), oldNode: newNode(TypeApproval, "Reject"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateApprovalDecision(tt.node, tt.oldNode); (len(got) > 0) != tt.wantErr {
+				t.Errorf("validateApprovalDecision() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWorkflowNodeDefaultApprover(t *testing.T) {
+	newNode := func(annotations map[string]string) *WorkflowNode {
+		return &WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "approval-0", Annotations: annotations},
+			Spec:       WorkflowNodeSpec{Type: TypeApproval},
+		}
+	}
+	newContext := func(g *WithT, username string, oldNode *WorkflowNode) context.Context {
+		request := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: username},
+		}}
+		if oldNode != nil {
+			raw, err := json.Marshal(oldNode)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			request.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		return admission.NewContextWithRequest(context.Background(), request)
+	}
+
+	t.Run("record the approver with the decision", func(t *testing.T) {
+		g := NewWithT(t)
+		node := newNode(map[string]string{WorkflowAnnotationApproval: "Approve", WorkflowAnnotationApprover: "someone-else"})
+		g.Expect(node.Default(newContext(g, "alice", newNode(nil)), node)).To(Succeed())
+		g.Expect(node.Annotations[WorkflowAnnotationApprover]).Should(Equal("alice"))
+	})
+
+	t.Run("keep the approver when the decision is unchanged", func(t *testing.T) {
+		g := NewWithT(t)
+		decided := map[string]string{WorkflowAnnotationApproval: "Approve", WorkflowAnnotationApprover: "alice"}
+		node := newNode(map[string]string{WorkflowAnnotationApproval: "Approve", WorkflowAnnotationApprover: "bob"})
+		g.Expect(node.Default(newContext(g, "bob", newNode(decided)), node)).To(Succeed())
+		g.Expect(node.Annotations[WorkflowAnnotationApprover]).Should(Equal("alice"))
+	})
+
+	t.Run("approver could not be set without decision", func(t *testing.T) {
+		g := NewWithT(t)
+		node := newNode(map[string]string{WorkflowAnnotationApprover: "bob"})
+		g.Expect(node.Default(newContext(g, "bob", nil), node)).To(Succeed())
+		g.Expect(node.Annotations).ShouldNot(HaveKey(WorkflowAnnotationApprover))
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// DAGSpec describes the tasks of DAG node. Each task starts once all of its dependencies finished
+// with the required outcomes, and it's skipped if any of them finished with another outcome.
+type DAGSpec struct {
+	Tasks []DAGTask `json:"tasks"`
+}
+
+type DAGTask struct {
+	// Name is the name of the task, it should be unique in the DAG.
+	Name string `json:"name"`
+
+	// Template is the name of the template to run.
+	Template string `json:"template"`
+
+	// Dependencies are the tasks which should finish before this task starts.
+	// +optional
+	Dependencies []DAGDependency `json:"dependencies,omitempty"`
+}
+
+type DAGDependency struct {
+	// Task is the name of the task depended on.
+	Task string `json:"task"`
+
+	// Outcome is the required outcome of the task depended on.
+	// +optional
+	// +kubebuilder:validation:Enum=Succeeded;Failed;Aborted
+	// +kubebuilder:default=Succeeded
+	Outcome DAGTaskPhase `json:"outcome,omitempty"`
+}
+
+// RequiredOutcome returns the required outcome of the dependency, it's Succeeded if Outcome is omitted.
+func (in *DAGDependency) RequiredOutcome() DAGTaskPhase {
+	if len(in.Outcome) == 0 {
+		return DAGTaskSucceeded
+	}
+	return in.Outcome
+}
+
+// DAGTaskPhase is the phase of the task in DAG, Succeeded, Failed and Aborted are also the outcomes
+// of the finished task.
+type DAGTaskPhase string
+
+const (
+	DAGTaskWaiting   DAGTaskPhase = "Waiting"
+	DAGTaskRunning   DAGTaskPhase = "Running"
+	DAGTaskSucceeded DAGTaskPhase = "Succeeded"
+	DAGTaskFailed    DAGTaskPhase = "Failed"
+	DAGTaskAborted   DAGTaskPhase = "Aborted"
+	DAGTaskSkipped   DAGTaskPhase = "Skipped"
+)
+
+type DAGTaskStatus struct {
+	Task string `json:"task"`
+
+	// Node is the name of the workflow node spawned for the task.
+	// +optional
+	Node string `json:"node,omitempty"`
+
+	Phase DAGTaskPhase `json:"phase"`
+}
+
+func validateDAG(path *field.Path, dag *DAGSpec, allTemplates []Template) field.ErrorList {
+	if dag == nil || len(dag.Tasks) == 0 {
+		return field.ErrorList{field.Required(path.Child("tasks"), "the tasks of template with type DAG are required")}
+	}
+
+	var result field.ErrorList
+	tasksPath := path.Child("tasks")
+	taskNames := make(map[string]struct{})
+	for i, task := range dag.Tasks {
+		taskPath := tasksPath.Index(i)
+		if errs := validation.IsDNS1123Label(task.Name); len(errs) > 0 {
+			result = append(result, field.Invalid(taskPath.Child("name"), task.Name, fmt.Sprintf("name of task must be DNS-1123 label, %s", errs)))
+		}
+		if _, ok := taskNames[task.Name]; ok {
+			result = append(result, field.Duplicate(taskPath.Child("name"), task.Name))
+		}
+		taskNames[task.Name] = struct{}{}
+		result = append(result, templateMustExists(task.Template, taskPath.Child("template"), allTemplates)...)
+	}
+
+	outcomes := []string{string(DAGTaskSucceeded), string(DAGTaskFailed), string(DAGTaskAborted)}
+	for i, task := range dag.Tasks {
+		dependenciesPath := tasksPath.Index(i).Child("dependencies")
+		dependencies := make(map[string]struct{})
+		for j, dependency := range task.Dependencies {
+			dependencyPath := dependenciesPath.Index(j)
+			if _, ok := taskNames[dependency.Task]; !ok {
+				result = append(result, field.NotFound(dependencyPath.Child("task"), dependency.Task))
+			}
+			if _, ok := dependencies[dependency.Task]; ok {
+				result = append(result, field.Duplicate(dependencyPath.Child("task"), dependency.Task))
+			}
+			dependencies[dependency.Task] = struct{}{}
+			switch dependency.RequiredOutcome() {
+			case DAGTaskSucceeded, DAGTaskFailed, DAGTaskAborted:
+			default:
+				result = append(result, field.NotSupported(dependencyPath.Child("outcome"), dependency.Outcome, outcomes))
+			}
+		}
+	}
+
+	if cycle := findDAGCycle(dag.Tasks); len(cycle) > 0 {
+		result = append(result, field.Invalid(tasksPath, strings.Join(cycle, " -> "), "the dependencies of tasks should not contain cycle"))
+	}
+	return result
+}
+
+// findDAGCycle returns the tasks on a cycle of dependencies, such as [a b a] which means a depends on b and b depends on a.
+// It returns nil if there is no cycle.
+func findDAGCycle(tasks []DAGTask) []string {
+	dependencies := make(map[string][]string)
+	for _, task := range tasks {
+		for _, dependency := range task.Dependencies {
+			dependencies[task.Name] = append(dependencies[task.Name], dependency.Task)
+		}
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	states := make(map[string]int)
+	var stack []string
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch states[name] {
+		case visiting:
+			for i, item := range stack {
+				if item == name {
+					cycle := append([]string{}, stack[i:]...)
+					return append(cycle, name)
+				}
+			}
+		case visited:
+			return nil
+		}
+
+		states[name] = visiting
+		stack = append(stack, name)
+		for _, dependency := range dependencies[name] {
+			if cycle := visit(dependency); cycle != nil {
+				return cycle
+			}
+		}
+		stack = stack[:len(stack)-1]
+		states[name] = visited
+		return nil
+	}
+
+	for _, task := range tasks {
+		if cycle := visit(task.Name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
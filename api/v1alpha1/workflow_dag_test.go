@@ -0,0 +1,125 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func dependsOn(tasks ...string) []DAGDependency {
+	var result []DAGDependency
+	for _, task := range tasks {
+		result = append(result, DAGDependency{Task: task})
+	}
+	return result
+}
+
+func Test_findDAGCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks []DAGTask
+		want  []string
+	}{
+		{
+			name: "diamond",
+			tasks: []DAGTask{
+				{Name: "a"},
+				{Name: "b", Dependencies: dependsOn("a")},
+				{Name: "c", Dependencies: dependsOn("a")},
+				{Name: "d", Dependencies: dependsOn("b", "c")},
+				{Name: "e", Dependencies: dependsOn("a")},
+			},
+			want: nil,
+		},
+		{
+			name:  "depends on itself",
+			tasks: []DAGTask{{Name: "a", Dependencies: dependsOn("a")}},
+			want:  []string{"a", "a"},
+		},
+		{
+			name: "cycle after a branch",
+			tasks: []DAGTask{
+				{Name: "a"},
+				{Name: "b", Dependencies: dependsOn("a", "d")},
+				{Name: "c", Dependencies: dependsOn("b")},
+				{Name: "d", Dependencies: dependsOn("c")},
+			},
+			want: []string{"b", "d", "c", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(findDAGCycle(tt.tasks)).Should(Equal(tt.want))
+		})
+	}
+}
+
+func Test_validateDAG(t *testing.T) {
+	dagPath := field.NewPath("spec", "templates").Index(0).Child("dag")
+	templates := []Template{{Name: "pod-kill"}, {Name: "check"}}
+	tests := []struct {
+		name    string
+		dag     *DAGSpec
+		wantErr int
+	}{
+		{
+			name: "valid",
+			dag: &DAGSpec{Tasks: []DAGTask{
+				{Name: "kill", Template: "pod-kill"},
+				{Name: "check", Template: "check", Dependencies: dependsOn("kill")},
+				{Name: "recover", Template: "pod-kill", Dependencies: []DAGDependency{{Task: "check", Outcome: DAGTaskFailed}}},
+			}},
+			wantErr: 0,
+		},
+		{name: "missing dag", dag: nil, wantErr: 1},
+		{name: "empty tasks", dag: &DAGSpec{}, wantErr: 1},
+		{
+			name: "duplicated task and missing template",
+			dag: &DAGSpec{Tasks: []DAGTask{
+				{Name: "kill", Template: "pod-kill"},
+				{Name: "kill", Template: "not-exist"},
+			}},
+			wantErr: 2,
+		},
+		{
+			name: "unknown dependency and outcome",
+			dag: &DAGSpec{Tasks: []DAGTask{
+				{Name: "kill", Template: "pod-kill", Dependencies: []DAGDependency{{Task: "not-exist"}}},
+				{Name: "check", Template: "check", Dependencies: []DAGDependency{{Task: "kill", Outcome: DAGTaskSkipped}}},
+			}},
+			wantErr: 2,
+		},
+		{
+			name: "cycle",
+			dag: &DAGSpec{Tasks: []DAGTask{
+				{Name: "kill", Template: "pod-kill", Dependencies: dependsOn("check")},
+				{Name: "check", Template: "check", Dependencies: dependsOn("kill")},
+			}},
+			wantErr: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateDAG(dagPath, tt.dag, templates); len(got) != tt.wantErr {
+				t.Errorf("validateDAG() = %v, want %d errors", got, tt.wantErr)
+			}
+		})
+	}
+}
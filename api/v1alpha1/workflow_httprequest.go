@@ -0,0 +1,130 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// DefaultHTTPRequestTimeout is the timeout of HTTPRequest if it's omitted.
+const DefaultHTTPRequestTimeout = 30 * time.Second
+
+// MaxHTTPRequestTimeout is the max timeout of HTTPRequest.
+const MaxHTTPRequestTimeout = time.Minute
+
+// HTTPRequestNodeSpec describes the HTTP request sent by the controller directly, without spawning any pod.
+// The response is exposed to the ConditionalBranches with the variables statusCode, headers and body,
+// the body is decoded as JSON if possible, otherwise it's a string.
+// The request is sent from the network of chaos-controller-manager, so it could reach the addresses which are not
+// reachable by the user, the hosts could be restricted with the configuration of chaos-controller-manager.
+type HTTPRequestNodeSpec struct {
+	URL string `json:"url"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=GET;HEAD;POST;PUT;PATCH;DELETE;OPTIONS
+	// +kubebuilder:default=GET
+	Method string `json:"method,omitempty"`
+
+	// +optional
+	Headers http.Header `json:"headers,omitempty"`
+
+	// +optional
+	Body string `json:"body,omitempty"`
+
+	// Timeout of the request, such as 10s, the default value is 30s, and the max value is 1m.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+
+	// FollowLocation follows the redirections of the response.
+	// +optional
+	FollowLocation bool `json:"followLocation,omitempty"`
+
+	// +optional
+	TLS *HTTPRequestTLS `json:"tls,omitempty"`
+
+	// Criteria defines the expected status code of the response, the node is failed if it's not satisfied.
+	// Any response is accepted if it's omitted. The node is always failed if the request could not be sent.
+	// +optional
+	Criteria *HTTPCriteria `json:"criteria,omitempty"`
+}
+
+// HTTPRequestTLS describes the TLS config of HTTPRequest.
+type HTTPRequestTLS struct {
+	// SecretName is the name of the secret in the namespace of the workflow, the CA is read from the key ca.crt,
+	// and the client certificate is read from the keys tls.crt and tls.key. The creator of the workflow should
+	// be able to get the secret.
+	// +optional
+	SecretName *string `json:"secretName,omitempty"`
+
+	// ServerName overrides the server name used to verify the certificate of the server.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// HTTPResponseStatus records the response of HTTPRequest, the body is kept in the context of ConditionalBranchesStatus.
+type HTTPResponseStatus struct {
+	// +optional
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// +optional
+	Headers http.Header `json:"headers,omitempty"`
+
+	// Error is the reason why the request could not be sent, or the response is not expected.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// GetTimeout returns the timeout of the request.
+func (in *HTTPRequestNodeSpec) GetTimeout() (time.Duration, error) {
+	if in.Timeout == nil {
+		return DefaultHTTPRequestTimeout, nil
+	}
+	return time.ParseDuration(*in.Timeout)
+}
+
+func validateHTTPRequest(path *field.Path, request *HTTPRequestNodeSpec) field.ErrorList {
+	if request == nil {
+		return field.ErrorList{field.Required(path, "the httpRequest of template with type HTTPRequest is required")}
+	}
+
+	var result field.ErrorList
+	if parsed, err := url.Parse(request.URL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || len(parsed.Host) == 0 {
+		result = append(result, field.Invalid(path.Child("url"), request.URL, "url should be an absolute http or https url"))
+	}
+	if timeout, err := request.GetTimeout(); err != nil {
+		result = append(result, field.Invalid(path.Child("timeout"), *request.Timeout, fmt.Sprintf("invalid duration: %s", err)))
+	} else if timeout <= 0 {
+		result = append(result, field.Invalid(path.Child("timeout"), *request.Timeout, "timeout should be positive"))
+	} else if timeout > MaxHTTPRequestTimeout {
+		result = append(result, field.Invalid(path.Child("timeout"), *request.Timeout, fmt.Sprintf("timeout should not be longer than %s", MaxHTTPRequestTimeout)))
+	}
+	if request.Criteria != nil {
+		statusCode := StatusCode(request.Criteria.StatusCode)
+		result = append(result, statusCode.Validate(nil, path.Child("criteria", "statusCode"))...)
+	}
+	if request.TLS != nil && request.TLS.SecretName != nil && len(*request.TLS.SecretName) == 0 {
+		result = append(result, field.Invalid(path.Child("tls", "secretName"), "", "secretName could not be empty"))
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func Test_validateHTTPRequest(t *testing.T) {
+	requestPath := field.NewPath("spec", "templates").Index(0).Child("httpRequest")
+	timeout := "10s"
+	invalidTimeout := "ten seconds"
+	negativeTimeout := "-1s"
+	longTimeout := "5m"
+	emptySecret := ""
+	tests := []struct {
+		name    string
+		request *HTTPRequestNodeSpec
+		wantErr bool
+	}{
+		{name: "simple request", request: &HTTPRequestNodeSpec{URL: "http://web-show.default.svc:8081"}, wantErr: false},
+		{
+			name: "request with timeout and criteria",
+			request: &HTTPRequestNodeSpec{
+				URL:      "https://example.com/api",
+				Method:   "POST",
+				Body:     `{"hello": "world"}`,
+				Timeout:  &timeout,
+				Criteria: &HTTPCriteria{StatusCode: "200-299"},
+			},
+			wantErr: false,
+		},
+		{name: "missing request", request: nil, wantErr: true},
+		{name: "relative url", request: &HTTPRequestNodeSpec{URL: "/api"}, wantErr: true},
+		{name: "unsupported scheme", request: &HTTPRequestNodeSpec{URL: "ftp://example.com"}, wantErr: true},
+		{name: "invalid timeout", request: &HTTPRequestNodeSpec{URL: "http://example.com", Timeout: &invalidTimeout}, wantErr: true},
+		{name: "negative timeout", request: &HTTPRequestNodeSpec{URL: "http://example.com", Timeout: &negativeTimeout}, wantErr: true},
+		{name: "too long timeout", request: &HTTPRequestNodeSpec{URL: "http://example.com", Timeout: &longTimeout}, wantErr: true},
+		{name: "invalid criteria", request: &HTTPRequestNodeSpec{URL: "http://example.com", Criteria: &HTTPCriteria{StatusCode: "2xx"}}, wantErr: true},
+		{name: "empty secret name", request: &HTTPRequestNodeSpec{URL: "https://example.com", TLS: &HTTPRequestTLS{SecretName: &emptySecret}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateHTTPRequest(requestPath, tt.request); (len(got) > 0) != tt.wantErr {
+				t.Errorf("validateHTTPRequest() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// DefaultK8sWaitInterval is the interval of polling the resource of K8sWait if it's omitted.
+const DefaultK8sWaitInterval = 5 * time.Second
+
+// K8sRevertFinalizer is added to the K8sApply node with revert enabled, the resource is reverted before the node is
+// deleted, for example, when the workflow is deleted before it's accomplished.
+const K8sRevertFinalizer = "workflow.chaos-mesh.org/k8s-revert"
+
+// K8sPatchType is the type of patch applied by K8sApply.
+type K8sPatchType string
+
+const (
+	K8sPatchTypeMerge          K8sPatchType = "Merge"
+	K8sPatchTypeStrategicMerge K8sPatchType = "StrategicMerge"
+	K8sPatchTypeJSON           K8sPatchType = "JSON"
+)
+
+// K8sResourceReference refers to a Kubernetes resource.
+type K8sResourceReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+
+	// Namespace of the resource, the namespace of the workflow is used if it's omitted for a namespaced resource.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	Name string `json:"name"`
+}
+
+// K8sApplySpec describes the resource applied by K8sApply node. Exactly one of Manifest and Patch should be set.
+//
+// The resource is written with the identity of chaos-controller-manager, not the creator of the workflow. The
+// creator is only checked to be allowed to do the same when the workflow is admitted, so K8sApply node is refused
+// if the security mode is disabled, and chaos-controller-manager is only able to write the resources granted by
+// the controllerManager.workflowResourceRules of helm chart besides its own ones.
+type K8sApplySpec struct {
+	// Manifest is the YAML or JSON manifest of the resource, it's applied with server-side apply, so the resource is
+	// created if it does not exist.
+	// +optional
+	Manifest string `json:"manifest,omitempty"`
+
+	// Patch is applied to an existing resource.
+	// +optional
+	Patch *K8sPatch `json:"patch,omitempty"`
+
+	// Revert restores the resource to the state before applying when the workflow is accomplished or deleted, the
+	// resource is deleted if it's created by this node. The resource is not reverted if it has been deleted or
+	// recreated by others, otherwise the changes made by others after applying are overridden.
+	// +optional
+	Revert bool `json:"revert,omitempty"`
+}
+
+// K8sPatch describes a patch to an existing resource.
+type K8sPatch struct {
+	Target K8sResourceReference `json:"target"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=Merge;StrategicMerge;JSON
+	// +kubebuilder:default=StrategicMerge
+	Type K8sPatchType `json:"type,omitempty"`
+
+	// Data is the YAML or JSON content of the patch.
+	Data string `json:"data"`
+}
+
+// K8sWaitSpec describes the condition waited by K8sWait node, the node is accomplished once the condition is met,
+// and it's failed if the condition is not met before the deadline.
+type K8sWaitSpec struct {
+	Target K8sResourceReference `json:"target"`
+
+	// JSONPath is evaluated against the resource, such as {.status.succeeded}, the braces could be omitted.
+	JSONPath string `json:"jsonPath"`
+
+	// Value is the expected result of JSONPath. If it's omitted, the condition is met when the result is not empty.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Interval of polling the resource, such as 10s, the default value is 5s.
+	// +optional
+	Interval *string `json:"interval,omitempty"`
+}
+
+// K8sApplyStatus records the resource applied by K8sApply node.
+type K8sApplyStatus struct {
+	Target K8sResourceReference `json:"target"`
+
+	// Created is true if the resource is created by this node.
+	// +optional
+	Created bool `json:"created,omitempty"`
+
+	// UID is the uid of the resource created by this node, only the resource with the same uid is deleted when
+	// reverting.
+	// +optional
+	UID types.UID `json:"uid,omitempty"`
+
+	// Original is the JSON of the resource before applying, it's used for reverting.
+	// +optional
+	Original string `json:"original,omitempty"`
+
+	// Reverted is true if the resource has been reverted.
+	// +optional
+	Reverted bool `json:"reverted,omitempty"`
+
+	// Error is the reason why the resource could not be applied or reverted.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// K8sWaitStatus records the latest observation of K8sWait node.
+type K8sWaitStatus struct {
+	// Value is the latest result of JSONPath.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Error is the reason why the resource could not be observed.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// GetPatchType returns the type of the patch.
+func (in *K8sPatch) GetPatchType() K8sPatchType {
+	if len(in.Type) == 0 {
+		return K8sPatchTypeStrategicMerge
+	}
+	return in.Type
+}
+
+// ParseManifest parses the manifest into an unstructured object.
+func (in *K8sApplySpec) ParseManifest() (*unstructured.Unstructured, error) {
+	manifest, err := utilyaml.ToJSON([]byte(in.Manifest))
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(manifest, &obj.Object); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// GetJSONPath returns the JSONPath template wrapped in braces.
+func (in *K8sWaitSpec) GetJSONPath() string {
+	path := strings.TrimSpace(in.JSONPath)
+	if !strings.HasPrefix(path, "{") {
+		path = fmt.Sprintf("{%s}", path)
+	}
+	return path
+}
+
+// GetInterval returns the interval of polling the resource.
+func (in *K8sWaitSpec) GetInterval() (time.Duration, error) {
+	if in.Interval == nil {
+		return DefaultK8sWaitInterval, nil
+	}
+	return time.ParseDuration(*in.Interval)
+}
+
+func validateK8sApply(path *field.Path, apply *K8sApplySpec) field.ErrorList {
+	if apply == nil {
+		return field.ErrorList{field.Required(path, "the k8sApply of template with type K8sApply is required")}
+	}
+
+	var result field.ErrorList
+	switch {
+	case len(apply.Manifest) > 0 && apply.Patch != nil:
+		result = append(result, field.Invalid(path, "", "manifest and patch could not be set at the same time"))
+	case len(apply.Manifest) > 0:
+		obj, err := apply.ParseManifest()
+		if err != nil {
+			result = append(result, field.Invalid(path.Child("manifest"), apply.Manifest, fmt.Sprintf("invalid manifest: %s", err)))
+		} else if len(obj.GetAPIVersion()) == 0 || len(obj.GetKind()) == 0 || len(obj.GetName()) == 0 {
+			result = append(result, field.Invalid(path.Child("manifest"), apply.Manifest, "apiVersion, kind and metadata.name of the manifest are required"))
+		}
+	case apply.Patch != nil:
+		result = append(result, validateK8sResourceReference(path.Child("patch", "target"), apply.Patch.Target)...)
+		if _, err := utilyaml.ToJSON([]byte(apply.Patch.Data)); err != nil || len(strings.TrimSpace(apply.Patch.Data)) == 0 {
+			result = append(result, field.Invalid(path.Child("patch", "data"), apply.Patch.Data, "data should be a YAML or JSON document"))
+		}
+	default:
+		result = append(result, field.Required(path, "either manifest or patch is required"))
+	}
+	return result
+}
+
+func validateK8sWait(path *field.Path, wait *K8sWaitSpec) field.ErrorList {
+	if wait == nil {
+		return field.ErrorList{field.Required(path, "the k8sWait of template with type K8sWait is required")}
+	}
+
+	var result field.ErrorList
+	result = append(result, validateK8sResourceReference(path.Child("target"), wait.Target)...)
+	if len(strings.TrimSpace(wait.JSONPath)) == 0 {
+		result = append(result, field.Required(path.Child("jsonPath"), "jsonPath is required"))
+	} else if err := jsonpath.New("").Parse(wait.GetJSONPath()); err != nil {
+		result = append(result, field.Invalid(path.Child("jsonPath"), wait.JSONPath, fmt.Sprintf("invalid jsonPath: %s", err)))
+	}
+	if interval, err := wait.GetInterval(); err != nil {
+		result = append(result, field.Invalid(path.Child("interval"), *wait.Interval, fmt.Sprintf("invalid duration: %s", err)))
+	} else if interval <= 0 {
+		result = append(result, field.Invalid(path.Child("interval"), *wait.Interval, "interval should be positive"))
+	}
+	return result
+}
+
+func validateK8sResourceReference(path *field.Path, ref K8sResourceReference) field.ErrorList {
+	var result field.ErrorList
+	if len(ref.APIVersion) == 0 {
+		result = append(result, field.Required(path.Child("apiVersion"), "apiVersion is required"))
+	}
+	if len(ref.Kind) == 0 {
+		result = append(result, field.Required(path.Child("kind"), "kind is required"))
+	}
+	if len(ref.Name) == 0 {
+		result = append(result, field.Required(path.Child("name"), "name is required"))
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func Test_validateK8sApply(t *testing.T) {
+	applyPath := field.NewPath("spec", "templates").Index(0).Child("k8sApply")
+	deployment := K8sResourceReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "web-show"}
+	manifest := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: web-show-config
+data:
+  mode: degraded
+`
+	tests := []struct {
+		name    string
+		apply   *K8sApplySpec
+		wantErr bool
+	}{
+		{name: "manifest", apply: &K8sApplySpec{Manifest: manifest, Revert: true}, wantErr: false},
+		{name: "json manifest", apply: &K8sApplySpec{Manifest: `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "web-show-config"}}`}, wantErr: false},
+		{name: "patch", apply: &K8sApplySpec{Patch: &K8sPatch{Target: deployment, Data: "spec:\n  replicas: 0\n"}}, wantErr: false},
+		{name: "missing k8sApply", apply: nil, wantErr: true},
+		{name: "neither manifest nor patch", apply: &K8sApplySpec{}, wantErr: true},
+		{name: "both manifest and patch", apply: &K8sApplySpec{Manifest: manifest, Patch: &K8sPatch{Target: deployment, Data: "{}"}}, wantErr: true},
+		{name: "invalid manifest", apply: &K8sApplySpec{Manifest: "apiVersion: [v1"}, wantErr: true},
+		{name: "manifest without name", apply: &K8sApplySpec{Manifest: "apiVersion: v1\nkind: ConfigMap\n"}, wantErr: true},
+		{name: "patch without target", apply: &K8sApplySpec{Patch: &K8sPatch{Data: "{}"}}, wantErr: true},
+		{name: "patch without data", apply: &K8sApplySpec{Patch: &K8sPatch{Target: deployment}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateK8sApply(applyPath, tt.apply); (len(got) > 0) != tt.wantErr {
+				t.Errorf("validateK8sApply() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateK8sWait(t *testing.T) {
+	waitPath := field.NewPath("spec", "templates").Index(0).Child("k8sWait")
+	job := K8sResourceReference{APIVersion: "batch/v1", Kind: "Job", Name: "load-test"}
+	interval := "10s"
+	invalidInterval := "ten seconds"
+	tests := []struct {
+		name    string
+		wait    *K8sWaitSpec
+		wantErr bool
+	}{
+		{name: "wait for field", wait: &K8sWaitSpec{Target: job, JSONPath: "{.status.succeeded}", Value: "1"}, wantErr: false},
+		{name: "relaxed json path", wait: &K8sWaitSpec{Target: job, JSONPath: ".status.succeeded", Interval: &interval}, wantErr: false},
+		{name: "filter", wait: &K8sWaitSpec{Target: job, JSONPath: `{.status.conditions[?(@.type=="Complete")].status}`, Value: "True"}, wantErr: false},
+		{name: "missing k8sWait", wait: nil, wantErr: true},
+		{name: "missing json path", wait: &K8sWaitSpec{Target: job}, wantErr: true},
+		{name: "invalid json path", wait: &K8sWaitSpec{Target: job, JSONPath: "{.status[}"}, wantErr: true},
+		{name: "missing target", wait: &K8sWaitSpec{JSONPath: "{.status.succeeded}"}, wantErr: true},
+		{name: "invalid interval", wait: &K8sWaitSpec{Target: job, JSONPath: "{.status.succeeded}", Interval: &invalidInterval}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateK8sWait(waitPath, tt.wait); (len(got) > 0) != tt.wantErr {
+				t.Errorf("validateK8sWait() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,410 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// outputReference matches {{outputs.<template>.<output>}}, the name of template may contain '.', but output may not
+var outputReference = regexp.MustCompile(`\{\{\s*outputs\.([a-z0-9.-]+)\.([A-Za-z0-9_-]+)\s*\}\}`)
+
+// authorizedFields are the fields which decide the permissions required to create the workflow, they are authorized
+// at admission, so the outputs, which are resolved after admission, could not be referred in them. The names of pods
+// in the selector are the only exception, as the namespaces of them are still authorized.
+var authorizedFields = map[string]struct{}{
+	"selector":      {},
+	"target":        {},
+	"namespace":     {},
+	"namespaces":    {},
+	"remoteCluster": {},
+}
+
+// OutputKey returns the key of the output in the values passed to ResolveOutputs
+func OutputKey(templateName string, outputName string) string {
+	return fmt.Sprintf("%s.%s", templateName, outputName)
+}
+
+// ResolveOutputs returns a copy of the template, with the references of outputs replaced by the values.
+// The key of values is built with OutputKey.
+func (in *Template) ResolveOutputs(values map[string]string) (*Template, error) {
+	origin, inline := in.withoutInlineSubWorkflow()
+	result := &Template{}
+	if err := substituteReferences(origin, result, outputReference, values); err != nil {
+		return nil, errors.Wrap(err, "resolve outputs")
+	}
+	// the values are escaped in the JSON of template, but not in the YAML of manifest
+	if err := manifestIdentityUnchanged(origin.K8sApply, result.K8sApply); err != nil {
+		return nil, errors.Wrap(err, "resolve outputs")
+	}
+	if result.SubWorkflow != nil {
+		result.SubWorkflow.Workflow = inline
+	}
+	return result, nil
+}
+
+// withoutInlineSubWorkflow returns a copy of the template without the inline spec of the child workflow, and the
+// inline spec. The outputs referred in the child workflow belong to the child, so they are left to the child workflow.
+func (in *Template) withoutInlineSubWorkflow() (*Template, json.RawMessage) {
+	result := in.DeepCopy()
+	if result.SubWorkflow == nil {
+		return result, nil
+	}
+	inline := result.SubWorkflow.Workflow
+	result.SubWorkflow.Workflow = nil
+	return result, inline
+}
+
+func validateOutputs(path *field.Path, template Template) field.ErrorList {
+	var result field.ErrorList
+
+	names := make(map[string]struct{})
+	files := 0
+	for i, output := range template.Outputs {
+		outputPath := path.Child("outputs").Index(i)
+		if !parameterNamePattern.MatchString(output.Name) {
+			result = append(result, field.Invalid(outputPath.Child("name"), output.Name, "name of output could only contain letters, digits, '-' and '_'"))
+		}
+		if _, ok := names[output.Name]; ok {
+			result = append(result, field.Duplicate(outputPath.Child("name"), output.Name))
+		}
+		names[output.Name] = struct{}{}
+
+		sources := 0
+		if output.StdoutField != nil {
+			sources++
+			if template.Type != TypeTask {
+				result = append(result, field.Invalid(outputPath.Child("stdoutField"), *output.StdoutField, "stdoutField could only be used in template with type Task"))
+			}
+		}
+		if output.File != nil {
+			sources++
+			files++
+			if template.Type != TypeTask {
+				result = append(result, field.Invalid(outputPath.Child("file"), *output.File, "file could only be used in template with type Task"))
+			}
+			if !filepath.IsAbs(*output.File) {
+				result = append(result, field.Invalid(outputPath.Child("file"), *output.File, "file should be an absolute path"))
+			}
+		}
+		if output.StatusCheckResult {
+			sources++
+			if template.Type != TypeStatusCheck {
+				result = append(result, field.Invalid(outputPath.Child("statusCheckResult"), output.StatusCheckResult, "statusCheckResult could only be used in template with type StatusCheck"))
+			}
+		}
+		if sources != 1 {
+			result = append(result, field.Invalid(outputPath, output.Name, "exactly one source of output should be set"))
+		}
+	}
+	if files > 1 {
+		result = append(result, field.Invalid(path.Child("outputs"), files, "at most one output could come from file"))
+	}
+
+	return result
+}
+
+// outputReferencesMustExist checks that all the referred outputs are declared in the templates
+func outputReferencesMustExist(path *field.Path, templates []Template) field.ErrorList {
+	var result field.ErrorList
+
+	declared := make(map[string]struct{})
+	for _, template := range templates {
+		for _, output := range template.Outputs {
+			declared[OutputKey(template.Name, output.Name)] = struct{}{}
+		}
+	}
+
+	for i, template := range templates {
+		origin, _ := template.withoutInlineSubWorkflow()
+		raw, err := json.Marshal(origin)
+		if err != nil {
+			result = append(result, field.Invalid(path.Index(i), template.Name, err.Error()))
+			continue
+		}
+		for _, match := range outputReference.FindAllSubmatch(raw, -1) {
+			key := OutputKey(string(match[1]), string(match[2]))
+			if _, ok := declared[key]; !ok {
+				result = append(result, field.Invalid(path.Index(i), key, fmt.Sprintf("output %s is referred but not declared", key)))
+			}
+		}
+	}
+
+	return result
+}
+
+// outputReferencesMustRunBefore checks that the referred outputs come from the templates which could finish before the
+// referring template is rendered. The template itself, the templates inside it and the templates running in parallel
+// with it, such as the siblings in a Parallel template, could never provide the outputs in time.
+func outputReferencesMustRunBefore(path *field.Path, templates []Template) field.ErrorList {
+	var result field.ErrorList
+
+	byName := make(map[string]Template, len(templates))
+	for _, template := range templates {
+		byName[template.Name] = template
+	}
+	concurrent := concurrentTemplates(byName)
+
+	for i, template := range templates {
+		origin, _ := template.withoutInlineSubWorkflow()
+		raw, err := json.Marshal(origin)
+		if err != nil {
+			result = append(result, field.Invalid(path.Index(i), template.Name, err.Error()))
+			continue
+		}
+		for _, match := range outputReference.FindAllSubmatch(raw, -1) {
+			referred := string(match[1])
+			key := OutputKey(referred, string(match[2]))
+			switch _, inside := descendantTemplates(byName, template.Name)[referred]; {
+			case referred == template.Name:
+				result = append(result, field.Invalid(path.Index(i), key, fmt.Sprintf("output %s could not be referred by the template producing it", key)))
+			case inside:
+				result = append(result, field.Invalid(path.Index(i), key, fmt.Sprintf("output %s is produced inside template %s, which could not be referred by it", key, template.Name)))
+			default:
+				if _, ok := concurrent[template.Name][referred]; ok {
+					result = append(result, field.Invalid(path.Index(i), key, fmt.Sprintf("output %s is produced by a template running in parallel with template %s", key, template.Name)))
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// childTemplates returns the names of the templates spawned by the template directly
+func childTemplates(template Template) []string {
+	result := append([]string{}, template.Children...)
+	for _, branch := range template.ConditionalBranches {
+		result = append(result, branch.Target)
+	}
+	if template.DAG != nil {
+		for _, task := range template.DAG.Tasks {
+			result = append(result, task.Template)
+		}
+	}
+	return result
+}
+
+// descendantTemplates returns the names of the templates spawned by the template directly or indirectly
+func descendantTemplates(byName map[string]Template, name string) map[string]struct{} {
+	result := make(map[string]struct{})
+	var visit func(name string)
+	visit = func(name string) {
+		for _, child := range childTemplates(byName[name]) {
+			if _, ok := result[child]; ok {
+				continue
+			}
+			result[child] = struct{}{}
+			visit(child)
+		}
+	}
+	visit(name)
+	return result
+}
+
+// concurrentTemplates returns the templates which could run in parallel with each template, they are the children of
+// Parallel templates, the selected conditional branches and the tasks of DAG not depending on each other, together
+// with their descendants.
+func concurrentTemplates(byName map[string]Template) map[string]map[string]struct{} {
+	result := make(map[string]map[string]struct{})
+	subtree := func(name string) map[string]struct{} {
+		tree := descendantTemplates(byName, name)
+		tree[name] = struct{}{}
+		return tree
+	}
+	markConcurrent := func(a string, b string) {
+		if a == b {
+			return
+		}
+		treeA, treeB := subtree(a), subtree(b)
+		for x := range treeA {
+			for y := range treeB {
+				if result[x] == nil {
+					result[x] = make(map[string]struct{})
+				}
+				if result[y] == nil {
+					result[y] = make(map[string]struct{})
+				}
+				result[x][y] = struct{}{}
+				result[y][x] = struct{}{}
+			}
+		}
+	}
+
+	for _, template := range byName {
+		var siblings []string
+		switch {
+		case template.Type == TypeParallel:
+			siblings = template.Children
+		case len(template.ConditionalBranches) > 0:
+			for _, branch := range template.ConditionalBranches {
+				siblings = append(siblings, branch.Target)
+			}
+		case template.Type == TypeDAG && template.DAG != nil:
+			tasks := template.DAG.Tasks
+			for i := range tasks {
+				for j := i + 1; j < len(tasks); j++ {
+					if !dagTaskDependsOn(tasks, tasks[i].Name, tasks[j].Name) && !dagTaskDependsOn(tasks, tasks[j].Name, tasks[i].Name) {
+						markConcurrent(tasks[i].Template, tasks[j].Template)
+					}
+				}
+			}
+		}
+		for i := range siblings {
+			for j := i + 1; j < len(siblings); j++ {
+				markConcurrent(siblings[i], siblings[j])
+			}
+		}
+	}
+	return result
+}
+
+// dagTaskDependsOn returns true if the task depends on the other task directly or indirectly
+func dagTaskDependsOn(tasks []DAGTask, task string, other string) bool {
+	dependencies := make(map[string][]string, len(tasks))
+	for _, item := range tasks {
+		for _, dependency := range item.Dependencies {
+			dependencies[item.Name] = append(dependencies[item.Name], dependency.Task)
+		}
+	}
+
+	visited := make(map[string]struct{})
+	pending := []string{task}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		for _, dependency := range dependencies[current] {
+			if dependency == other {
+				return true
+			}
+			if _, ok := visited[dependency]; !ok {
+				visited[dependency] = struct{}{}
+				pending = append(pending, dependency)
+			}
+		}
+	}
+	return false
+}
+
+// outputReferencesMustNotBeAuthorized checks that the outputs are not referred in the authorized fields, and the
+// apiVersion, kind and metadata of the manifest of K8sApply
+func outputReferencesMustNotBeAuthorized(path *field.Path, template Template) field.ErrorList {
+	origin, _ := template.withoutInlineSubWorkflow()
+	raw, err := json.Marshal(origin)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, template.Name, err.Error())}
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return field.ErrorList{field.Invalid(path, template.Name, err.Error())}
+	}
+
+	result := outputReferencesInAuthorizedFields(path, value)
+	if template.K8sApply != nil && len(template.K8sApply.Manifest) > 0 {
+		// the invalid manifest is reported by validateK8sApply
+		if manifest, err := template.K8sApply.ParseManifest(); err == nil {
+			manifestPath := path.Child("k8sApply", "manifest")
+			for _, key := range []string{"apiVersion", "kind", "metadata"} {
+				raw, _ := json.Marshal(manifest.Object[key])
+				if outputReference.Match(raw) {
+					result = append(result, field.Invalid(manifestPath, key, fmt.Sprintf("output could not be referred in %s of manifest", key)))
+				}
+			}
+		}
+	}
+	return result
+}
+
+func outputReferencesInAuthorizedFields(path *field.Path, value interface{}) field.ErrorList {
+	var result field.ErrorList
+	switch value := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			item := value[key]
+			if _, ok := authorizedFields[key]; ok {
+				result = append(result, outputReferencesInAuthorizedField(path.Child(key), key, item)...)
+				continue
+			}
+			result = append(result, outputReferencesInAuthorizedFields(path.Child(key), item)...)
+		}
+	case []interface{}:
+		for i, item := range value {
+			result = append(result, outputReferencesInAuthorizedFields(path.Index(i), item)...)
+		}
+	}
+	return result
+}
+
+func outputReferencesInAuthorizedField(path *field.Path, key string, value interface{}) field.ErrorList {
+	if selector, ok := value.(map[string]interface{}); ok && key == "selector" {
+		if pods, ok := selector["pods"].(map[string]interface{}); ok {
+			var result field.ErrorList
+			for namespace := range pods {
+				if outputReference.MatchString(namespace) {
+					result = append(result, field.Invalid(path.Child("pods"), namespace, "output could not be referred in the namespace of pods, which is authorized at admission"))
+				}
+			}
+			others := make(map[string]interface{}, len(selector))
+			for name, item := range selector {
+				if name != "pods" {
+					others[name] = item
+				}
+			}
+			return append(result, outputReferencesInAuthorizedField(path, key, others)...)
+		}
+	}
+
+	raw, _ := json.Marshal(value)
+	if outputReference.Match(raw) {
+		return field.ErrorList{field.Invalid(path, string(raw), fmt.Sprintf("output could not be referred in %s, which is authorized at admission", key))}
+	}
+	return nil
+}
+
+// manifestIdentityUnchanged checks that the apiVersion, kind and metadata of the manifest are not changed by resolving
+// the outputs, as the values are not escaped in the YAML of manifest
+func manifestIdentityUnchanged(origin *K8sApplySpec, resolved *K8sApplySpec) error {
+	if origin == nil || resolved == nil || origin.Manifest == resolved.Manifest {
+		return nil
+	}
+	originManifest, err := origin.ParseManifest()
+	if err != nil {
+		return err
+	}
+	resolvedManifest, err := resolved.ParseManifest()
+	if err != nil {
+		return err
+	}
+	for _, key := range []string{"apiVersion", "kind", "metadata"} {
+		if !reflect.DeepEqual(originManifest.Object[key], resolvedManifest.Object[key]) {
+			return errors.Errorf("%s of manifest is changed by outputs", key)
+		}
+	}
+	return nil
+}
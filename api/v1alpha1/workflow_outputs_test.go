@@ -0,0 +1,342 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestTemplateResolveOutputs(t *testing.T) {
+	g := NewWithT(t)
+
+	template := Template{
+		Name: "check-latency",
+		Type: TypeTask,
+		Task: &Task{
+			Container: &corev1.Container{
+				Name:  "main",
+				Image: "busybox",
+				Args:  []string{"--threshold", "{{outputs.measure.v1.latency}}", "--pod", "{{ outputs.discover.pod }}"},
+			},
+		},
+	}
+
+	got, err := template.ResolveOutputs(map[string]string{
+		OutputKey("measure.v1", "latency"): "120ms",
+		OutputKey("discover", "pod"):       "web-0",
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(got.Task.Container.Args).Should(Equal([]string{"--threshold", "120ms", "--pod", "web-0"}))
+	g.Expect(template.Task.Container.Args[1]).Should(Equal("{{outputs.measure.v1.latency}}"))
+
+	_, err = template.ResolveOutputs(map[string]string{OutputKey("discover", "pod"): "web-0"})
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestTemplateResolveIteration(t *testing.T) {
+	g := NewWithT(t)
+
+	template := Template{
+		Name: "kill",
+		Type: TypeTask,
+		Task: &Task{
+			Container: &corev1.Container{
+				Name:  "main",
+				Image: "busybox",
+				Args:  []string{"--pod", "{{item}}", "--round", "{{ index }}"},
+			},
+		},
+	}
+
+	got, err := template.ResolveIteration(&LoopIteration{Index: 2, Item: `web-"0"`})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(got.Task.Container.Args).Should(Equal([]string{"--pod", `web-"0"`, "--round", "2"}))
+	g.Expect(template.Task.Container.Args[1]).Should(Equal("{{item}}"))
+
+	got, err = template.ResolveIteration(nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(got.Task.Container.Args[1]).Should(Equal("{{item}}"))
+}
+
+func Test_validateOutputs(t *testing.T) {
+	path := field.NewPath("spec", "templates").Index(0)
+	stdoutField := "pod.name"
+	file := "/tmp/latency"
+	relative := "latency"
+	tests := []struct {
+		name     string
+		template Template
+		wantErr  bool
+	}{
+		{
+			name: "outputs of task",
+			template: Template{Type: TypeTask, Outputs: []NodeOutput{
+				{Name: "pod", StdoutField: &stdoutField},
+				{Name: "latency", File: &file},
+			}},
+		}, {
+			name: "output of status check",
+			template: Template{Type: TypeStatusCheck, Outputs: []NodeOutput{
+				{Name: "result", StatusCheckResult: true},
+			}},
+		}, {
+			name: "no source",
+			template: Template{Type: TypeTask, Outputs: []NodeOutput{
+				{Name: "pod"},
+			}},
+			wantErr: true,
+		}, {
+			name: "more than one source",
+			template: Template{Type: TypeTask, Outputs: []NodeOutput{
+				{Name: "pod", StdoutField: &stdoutField, File: &file},
+			}},
+			wantErr: true,
+		}, {
+			name: "duplicated names",
+			template: Template{Type: TypeTask, Outputs: []NodeOutput{
+				{Name: "pod", StdoutField: &stdoutField},
+				{Name: "pod", File: &file},
+			}},
+			wantErr: true,
+		}, {
+			name: "more than one file",
+			template: Template{Type: TypeTask, Outputs: []NodeOutput{
+				{Name: "latency", File: &file},
+				{Name: "another-latency", File: &file},
+			}},
+			wantErr: true,
+		}, {
+			name: "relative file",
+			template: Template{Type: TypeTask, Outputs: []NodeOutput{
+				{Name: "latency", File: &relative},
+			}},
+			wantErr: true,
+		}, {
+			name: "status check result of task",
+			template: Template{Type: TypeTask, Outputs: []NodeOutput{
+				{Name: "result", StatusCheckResult: true},
+			}},
+			wantErr: true,
+		}, {
+			name: "stdout of status check",
+			template: Template{Type: TypeStatusCheck, Outputs: []NodeOutput{
+				{Name: "pod", StdoutField: &stdoutField},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateOutputs(path, tt.template); (len(got) > 0) != tt.wantErr {
+				t.Errorf("validateOutputs() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_outputReferencesMustExist(t *testing.T) {
+	g := NewWithT(t)
+
+	path := field.NewPath("spec", "templates")
+	stdoutField := "pod.name"
+	deadline := "{{outputs.discover.duration}}"
+	templates := []Template{
+		{Name: "discover", Type: TypeTask, Outputs: []NodeOutput{
+			{Name: "pod", StdoutField: &stdoutField},
+		}},
+		{Name: "suspend", Type: TypeSuspend, Deadline: &deadline},
+	}
+	g.Expect(outputReferencesMustExist(path, templates)).Should(HaveLen(1))
+
+	templates[0].Outputs = append(templates[0].Outputs, NodeOutput{Name: "duration", StdoutField: &stdoutField})
+	g.Expect(outputReferencesMustExist(path, templates)).Should(BeEmpty())
+}
+
+func Test_outputReferencesMustRunBefore(t *testing.T) {
+	path := field.NewPath("spec", "templates")
+	stdoutField := "pod.name"
+	deadline := "{{outputs.discover.pod}}"
+	discover := Template{Name: "discover", Type: TypeTask, Outputs: []NodeOutput{{Name: "pod", StdoutField: &stdoutField}}}
+	suspend := Template{Name: "suspend", Type: TypeSuspend, Deadline: &deadline}
+	tests := []struct {
+		name      string
+		templates []Template
+		wantErr   bool
+	}{
+		{
+			name: "serial",
+			templates: []Template{
+				{Name: "entry", Type: TypeSerial, Children: []string{"discover", "suspend"}},
+				discover, suspend,
+			},
+		}, {
+			name: "parallel siblings",
+			templates: []Template{
+				{Name: "entry", Type: TypeParallel, Children: []string{"discover", "suspend"}},
+				discover, suspend,
+			},
+			wantErr: true,
+		}, {
+			name: "nested in parallel siblings",
+			templates: []Template{
+				{Name: "entry", Type: TypeParallel, Children: []string{"discover", "wait"}},
+				{Name: "wait", Type: TypeSerial, Children: []string{"suspend"}},
+				discover, suspend,
+			},
+			wantErr: true,
+		}, {
+			name: "itself",
+			templates: []Template{
+				{Name: "discover", Type: TypeTask, Outputs: discover.Outputs, Deadline: &deadline},
+			},
+			wantErr: true,
+		}, {
+			name: "inside the referring template",
+			templates: []Template{
+				{Name: "entry", Type: TypeSerial, Children: []string{"discover"}, Deadline: &deadline},
+				discover,
+			},
+			wantErr: true,
+		}, {
+			name: "conditional branches of the producer",
+			templates: []Template{
+				{Name: "discover", Type: TypeTask, Outputs: discover.Outputs, ConditionalBranches: []ConditionalBranch{{Target: "suspend"}}},
+				suspend,
+			},
+		}, {
+			name: "dependent DAG tasks",
+			templates: []Template{
+				{Name: "entry", Type: TypeDAG, DAG: &DAGSpec{Tasks: []DAGTask{
+					{Name: "a", Template: "discover"},
+					{Name: "b", Template: "suspend", Dependencies: []DAGDependency{{Task: "a"}}},
+				}}},
+				discover, suspend,
+			},
+		}, {
+			name: "independent DAG tasks",
+			templates: []Template{
+				{Name: "entry", Type: TypeDAG, DAG: &DAGSpec{Tasks: []DAGTask{
+					{Name: "a", Template: "discover"},
+					{Name: "b", Template: "suspend"},
+				}}},
+				discover, suspend,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := outputReferencesMustRunBefore(path, tt.templates); (len(got) > 0) != tt.wantErr {
+				t.Errorf("outputReferencesMustRunBefore() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_outputReferencesMustNotBeAuthorized(t *testing.T) {
+	path := field.NewPath("spec", "templates").Index(0)
+	tests := []struct {
+		name     string
+		template Template
+		wantErr  bool
+	}{
+		{
+			name: "output in args of task",
+			template: Template{Type: TypeTask, Task: &Task{Container: &corev1.Container{
+				Name: "main", Image: "busybox", Args: []string{"{{outputs.discover.pod}}"},
+			}}},
+		}, {
+			name: "output in namespaces of selector",
+			template: Template{Type: TypePodChaos, EmbedChaos: &EmbedChaos{PodChaos: &PodChaosSpec{
+				Action: PodKillAction,
+				ContainerSelector: ContainerSelector{PodSelector: PodSelector{
+					Selector: PodSelectorSpec{GenericSelectorSpec: GenericSelectorSpec{
+						Namespaces: []string{"{{outputs.discover.namespace}}"},
+					}},
+					Mode: OneMode,
+				}},
+			}}},
+			wantErr: true,
+		}, {
+			name: "output in names of pods",
+			template: Template{Type: TypePodChaos, EmbedChaos: &EmbedChaos{PodChaos: &PodChaosSpec{
+				Action: PodKillAction,
+				ContainerSelector: ContainerSelector{PodSelector: PodSelector{
+					Selector: PodSelectorSpec{Pods: map[string][]string{"default": {"{{outputs.discover.pod}}"}}},
+					Mode:     OneMode,
+				}},
+			}}},
+		}, {
+			name: "output in namespace of pods",
+			template: Template{Type: TypePodChaos, EmbedChaos: &EmbedChaos{PodChaos: &PodChaosSpec{
+				Action: PodKillAction,
+				ContainerSelector: ContainerSelector{PodSelector: PodSelector{
+					Selector: PodSelectorSpec{Pods: map[string][]string{"{{outputs.discover.namespace}}": {"web-0"}}},
+					Mode:     OneMode,
+				}},
+			}}},
+			wantErr: true,
+		}, {
+			name: "output in target of k8sWait",
+			template: Template{Type: TypeK8sWait, K8sWait: &K8sWaitSpec{
+				Target:   K8sResourceReference{APIVersion: "v1", Kind: "Pod", Name: "{{outputs.discover.pod}}"},
+				JSONPath: ".status.phase",
+			}},
+			wantErr: true,
+		}, {
+			name: "output in spec of manifest",
+			template: Template{Type: TypeK8sApply, K8sApply: &K8sApplySpec{
+				Manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\ndata:\n  pod: '{{outputs.discover.pod}}'\n",
+			}},
+		}, {
+			name: "output in metadata of manifest",
+			template: Template{Type: TypeK8sApply, K8sApply: &K8sApplySpec{
+				Manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n  namespace: '{{outputs.discover.namespace}}'\n",
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := outputReferencesMustNotBeAuthorized(path, tt.template); (len(got) > 0) != tt.wantErr {
+				t.Errorf("outputReferencesMustNotBeAuthorized() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTemplateResolveOutputsInManifest(t *testing.T) {
+	g := NewWithT(t)
+
+	template := Template{
+		Name: "apply",
+		Type: TypeK8sApply,
+		K8sApply: &K8sApplySpec{
+			Manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\ndata:\n  pod: |\n    {{outputs.discover.pod}}\n",
+		},
+	}
+
+	got, err := template.ResolveOutputs(map[string]string{OutputKey("discover", "pod"): "web-0"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(got.K8sApply.Manifest).Should(ContainSubstring("    web-0\n"))
+
+	_, err = template.ResolveOutputs(map[string]string{OutputKey("discover", "pod"): "web-0\nmetadata:\n  name: config\n  namespace: kube-system"})
+	g.Expect(err).Should(HaveOccurred())
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MaxSubWorkflowDepth is the max depth of nested sub workflows, it stops the workflows or workflow templates
+// which refer to each other from being resolved endlessly.
+const MaxSubWorkflowDepth = 5
+
+// SubWorkflowSpec describes the child workflow instantiated by SubWorkflow node.
+// One of Workflow, WorkflowRef and WorkflowTemplateRef should be set, the referred Workflow or WorkflowTemplate
+// is resolved into Workflow when the parent workflow is created.
+type SubWorkflowSpec struct {
+	// Workflow is the inline spec of the child workflow, which has the same schema as the spec of Workflow.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	Workflow json.RawMessage `json:"workflow,omitempty"`
+
+	// WorkflowRef refers to an existing Workflow in the same namespace, the spec of which is copied to the child workflow.
+	// +optional
+	WorkflowRef *SubWorkflowReference `json:"workflowRef,omitempty"`
+
+	// WorkflowTemplateRef refers to the WorkflowTemplate in the same namespace, which the child workflow is instantiated from.
+	// +optional
+	WorkflowTemplateRef *WorkflowTemplateRef `json:"workflowTemplateRef,omitempty"`
+
+	// Arguments are the values of the parameters declared in the referred WorkflowTemplate.
+	// +optional
+	Arguments []WorkflowArgument `json:"arguments,omitempty"`
+}
+
+// SubWorkflowReference refers to a Workflow in the same namespace
+type SubWorkflowReference struct {
+	Name string `json:"name"`
+}
+
+// SubWorkflowStatus records the child workflow of SubWorkflow node.
+type SubWorkflowStatus struct {
+	// Name is the name of the child workflow in the namespace of the node.
+	Name string `json:"name"`
+}
+
+// GetWorkflowSpec decodes the inline spec of the child workflow, it returns nil if the sub workflow is not resolved.
+func (in *SubWorkflowSpec) GetWorkflowSpec() (*WorkflowSpec, error) {
+	if len(in.Workflow) == 0 {
+		return nil, nil
+	}
+	result := WorkflowSpec{}
+	if err := json.Unmarshal(in.Workflow, &result); err != nil {
+		return nil, errors.Wrap(err, "decode the spec of sub workflow")
+	}
+	return &result, nil
+}
+
+// SetWorkflowSpec encodes the spec as the inline spec of the child workflow.
+func (in *SubWorkflowSpec) SetWorkflowSpec(spec WorkflowSpec) error {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return errors.Wrap(err, "encode the spec of sub workflow")
+	}
+	in.Workflow = raw
+	return nil
+}
+
+// ResolveSubWorkflows fills the inline spec of the SubWorkflow templates with the referred Workflow or WorkflowTemplate,
+// the sub workflows nested in them are resolved recursively.
+func ResolveSubWorkflows(ctx context.Context, reader client.Reader, namespace string, path *field.Path, templates []Template) field.ErrorList {
+	return resolveSubWorkflows(ctx, reader, namespace, path, templates, 1)
+}
+
+func resolveSubWorkflows(ctx context.Context, reader client.Reader, namespace string, path *field.Path, templates []Template, depth int) field.ErrorList {
+	var result field.ErrorList
+	for i := range templates {
+		if templates[i].Type != TypeSubWorkflow || templates[i].SubWorkflow == nil {
+			continue
+		}
+		subPath := path.Index(i).Child("subWorkflow")
+		if depth > MaxSubWorkflowDepth {
+			result = append(result, field.Invalid(subPath, depth, fmt.Sprintf("sub workflows could not be nested deeper than %d", MaxSubWorkflowDepth)))
+			continue
+		}
+
+		subWorkflow := templates[i].SubWorkflow
+		spec, errs := subWorkflow.resolve(ctx, reader, namespace, subPath)
+		if len(errs) > 0 {
+			result = append(result, errs...)
+			continue
+		}
+
+		errs = resolveSubWorkflows(ctx, reader, namespace, subPath.Child("workflow", "templates"), spec.Templates, depth+1)
+		if len(errs) > 0 {
+			result = append(result, errs...)
+			continue
+		}
+
+		if err := subWorkflow.SetWorkflowSpec(*spec); err != nil {
+			result = append(result, field.Invalid(subPath.Child("workflow"), "", err.Error()))
+		}
+	}
+	return result
+}
+
+// resolve returns the spec of the child workflow, the inline spec takes precedence over the references.
+func (in *SubWorkflowSpec) resolve(ctx context.Context, reader client.Reader, namespace string, path *field.Path) (*WorkflowSpec, field.ErrorList) {
+	spec, err := in.GetWorkflowSpec()
+	if err != nil {
+		return nil, field.ErrorList{field.Invalid(path.Child("workflow"), "", err.Error())}
+	}
+	if spec != nil {
+		return spec, nil
+	}
+
+	switch {
+	case in.WorkflowRef != nil:
+		workflow := Workflow{}
+		err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: in.WorkflowRef.Name}, &workflow)
+		if err != nil {
+			return nil, field.ErrorList{
+				field.Invalid(path.Child("workflowRef", "name"), in.WorkflowRef.Name, fmt.Sprintf("failed to get workflow: %s", err)),
+			}
+		}
+		return workflow.Spec.DeepCopy(), nil
+	case in.WorkflowTemplateRef != nil:
+		template := WorkflowTemplate{}
+		err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: in.WorkflowTemplateRef.Name}, &template)
+		if err != nil {
+			return nil, field.ErrorList{
+				field.Invalid(path.Child("workflowTemplateRef", "name"), in.WorkflowTemplateRef.Name, fmt.Sprintf("failed to get workflow template: %s", err)),
+			}
+		}
+		entry, templates, errs := template.Instantiate(path.Child("arguments"), in.Arguments)
+		if len(errs) > 0 {
+			return nil, errs
+		}
+		return &WorkflowSpec{
+			Entry:               entry,
+			Templates:           templates,
+			WorkflowTemplateRef: in.WorkflowTemplateRef.DeepCopy(),
+			Arguments:           append([]WorkflowArgument{}, in.Arguments...),
+		}, nil
+	}
+	return nil, field.ErrorList{field.Required(path, "one of workflow, workflowRef and workflowTemplateRef is required")}
+}
+
+func validateSubWorkflow(path *field.Path, subWorkflow *SubWorkflowSpec) field.ErrorList {
+	var result field.ErrorList
+	if subWorkflow == nil {
+		return append(result, field.Required(path, "subWorkflow is required in template with type SubWorkflow"))
+	}
+
+	if subWorkflow.WorkflowRef != nil && subWorkflow.WorkflowTemplateRef != nil {
+		result = append(result, field.Invalid(path, "", "workflowRef and workflowTemplateRef could not be set at the same time"))
+	}
+	if len(subWorkflow.Arguments) > 0 && subWorkflow.WorkflowTemplateRef == nil {
+		result = append(result, field.Invalid(path.Child("arguments"), "", "arguments could only be set with workflowTemplateRef"))
+	}
+
+	spec, err := subWorkflow.GetWorkflowSpec()
+	if err != nil {
+		return append(result, field.Invalid(path.Child("workflow"), "", err.Error()))
+	}
+	if spec == nil {
+		if subWorkflow.WorkflowRef == nil && subWorkflow.WorkflowTemplateRef == nil {
+			result = append(result, field.Required(path, "one of workflow, workflowRef and workflowTemplateRef is required"))
+		} else {
+			result = append(result, field.Invalid(path, "", "the referred workflow is not resolved"))
+		}
+		return result
+	}
+
+	specPath := path.Child("workflow")
+	result = append(result, entryMustExists(specPath.Child("entry"), spec.Entry, spec.Templates)...)
+	result = append(result, exitHandlersMustExist(specPath, *spec)...)
+	result = append(result, validateTemplates(specPath.Child("templates"), spec.Templates)...)
+	return result
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWorkflowWebhookResolveSubWorkflows(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(AddToScheme(scheme)).To(Succeed())
+
+	deadline := "{{parameters.duration}}"
+	teamA := &WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "team-a"},
+		Spec: WorkflowTemplateSpec{
+			Parameters: []WorkflowParameter{{Name: "duration", Type: ParameterTypeDuration}},
+			Entry:      "suspend",
+			Templates:  []Template{{Name: "suspend", Type: TypeSuspend, Deadline: &deadline}},
+		},
+	}
+	teamBDeadline := "1m"
+	teamB := &Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "team-b"},
+		Spec: WorkflowSpec{
+			Entry:     "suspend",
+			Templates: []Template{{Name: "suspend", Type: TypeSuspend, Deadline: &teamBDeadline}},
+		},
+	}
+	recursive := &WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "recursive"},
+		Spec: WorkflowTemplateSpec{
+			Entry: "again",
+			Templates: []Template{{
+				Name:        "again",
+				Type:        TypeSubWorkflow,
+				SubWorkflow: &SubWorkflowSpec{WorkflowTemplateRef: &WorkflowTemplateRef{Name: "recursive"}},
+			}},
+		},
+	}
+	hook := &WorkflowWebhook{Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(teamA, teamB, recursive).Build()}
+	ctx := context.Background()
+
+	workflow := &Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "game-day"},
+		Spec: WorkflowSpec{
+			Entry: "entry",
+			Templates: []Template{
+				{Name: "entry", Type: TypeParallel, Children: []string{"team-a", "team-b"}},
+				{
+					Name: "team-a",
+					Type: TypeSubWorkflow,
+					SubWorkflow: &SubWorkflowSpec{
+						WorkflowTemplateRef: &WorkflowTemplateRef{Name: "team-a"},
+						Arguments:           []WorkflowArgument{{Name: "duration", Value: "30s"}},
+					},
+				},
+				{
+					Name:        "team-b",
+					Type:        TypeSubWorkflow,
+					SubWorkflow: &SubWorkflowSpec{WorkflowRef: &SubWorkflowReference{Name: "team-b"}},
+				},
+			},
+		},
+	}
+	g.Expect(hook.Default(ctx, workflow)).To(Succeed())
+	_, err := hook.ValidateCreate(ctx, workflow)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	teamASpec, err := workflow.Spec.Templates[1].SubWorkflow.GetWorkflowSpec()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(teamASpec.Entry).To(Equal("suspend"))
+	g.Expect(*teamASpec.Templates[0].Deadline).To(Equal("30s"))
+	g.Expect(teamASpec.WorkflowTemplateRef).To(Equal(&WorkflowTemplateRef{Name: "team-a"}))
+
+	teamBSpec, err := workflow.Spec.Templates[2].SubWorkflow.GetWorkflowSpec()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*teamBSpec).To(Equal(teamB.Spec))
+
+	endless := &Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "endless"},
+		Spec: WorkflowSpec{
+			Entry: "entry",
+			Templates: []Template{{
+				Name:        "entry",
+				Type:        TypeSubWorkflow,
+				SubWorkflow: &SubWorkflowSpec{WorkflowTemplateRef: &WorkflowTemplateRef{Name: "recursive"}},
+			}},
+		},
+	}
+	g.Expect(hook.Default(ctx, endless)).To(Succeed())
+	g.Expect(endless.Spec.Templates[0].SubWorkflow.Workflow).To(BeEmpty())
+	_, err = hook.ValidateCreate(ctx, endless)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(err.Error()).Should(ContainSubstring("could not be nested deeper than"))
+
+	missing := &Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "missing"},
+		Spec: WorkflowSpec{
+			Entry: "entry",
+			Templates: []Template{{
+				Name:        "entry",
+				Type:        TypeSubWorkflow,
+				SubWorkflow: &SubWorkflowSpec{WorkflowRef: &SubWorkflowReference{Name: "not-exist"}},
+			}},
+		},
+	}
+	g.Expect(hook.Default(ctx, missing)).To(Succeed())
+	_, err = hook.ValidateCreate(ctx, missing)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(err.Error()).Should(ContainSubstring("failed to get workflow"))
+
+	// the sub workflows of an instantiated WorkflowTemplate are resolved as well, and the inline specs are not
+	// rejected as user-set templates after being re-encoded by the API server
+	gameDayTemplate := &WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "game-day"},
+		Spec: WorkflowTemplateSpec{
+			Entry: "team-b",
+			Templates: []Template{{
+				Name:        "team-b",
+				Type:        TypeSubWorkflow,
+				SubWorkflow: &SubWorkflowSpec{WorkflowRef: &SubWorkflowReference{Name: "team-b"}},
+			}},
+		},
+	}
+	hook = &WorkflowWebhook{Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(teamB, gameDayTemplate).Build()}
+	instantiated := &Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "instantiated"},
+		Spec: WorkflowSpec{
+			WorkflowTemplateRef: &WorkflowTemplateRef{Name: "game-day"},
+		},
+	}
+	g.Expect(hook.Default(ctx, instantiated)).To(Succeed())
+	g.Expect(instantiated.Spec.Templates[0].SubWorkflow.Workflow).ShouldNot(BeEmpty())
+	raw, err := json.MarshalIndent(instantiated, "", "  ")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	reencoded := &Workflow{}
+	g.Expect(json.Unmarshal(raw, reencoded)).To(Succeed())
+	_, err = hook.ValidateCreate(ctx, reencoded)
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func Test_validateSubWorkflow(t *testing.T) {
+	path := field.NewPath("subWorkflow")
+	deadline := "1m"
+	inline := func(spec WorkflowSpec) json.RawMessage {
+		subWorkflow := SubWorkflowSpec{}
+		if err := subWorkflow.SetWorkflowSpec(spec); err != nil {
+			t.Fatal(err)
+		}
+		return subWorkflow.Workflow
+	}
+	tests := []struct {
+		name        string
+		subWorkflow *SubWorkflowSpec
+		wantErr     bool
+	}{
+		{
+			name: "inline spec",
+			subWorkflow: &SubWorkflowSpec{Workflow: inline(WorkflowSpec{
+				Entry:     "suspend",
+				Templates: []Template{{Name: "suspend", Type: TypeSuspend, Deadline: &deadline}},
+			})},
+		}, {
+			name: "resolved reference",
+			subWorkflow: &SubWorkflowSpec{
+				WorkflowRef: &SubWorkflowReference{Name: "team-b"},
+				Workflow: inline(WorkflowSpec{
+					Entry:     "suspend",
+					Templates: []Template{{Name: "suspend", Type: TypeSuspend, Deadline: &deadline}},
+				}),
+			},
+		}, {
+			name:        "missing",
+			subWorkflow: nil,
+			wantErr:     true,
+		}, {
+			name:        "no source",
+			subWorkflow: &SubWorkflowSpec{},
+			wantErr:     true,
+		}, {
+			name:        "unresolved reference",
+			subWorkflow: &SubWorkflowSpec{WorkflowRef: &SubWorkflowReference{Name: "team-b"}},
+			wantErr:     true,
+		}, {
+			name: "both references",
+			subWorkflow: &SubWorkflowSpec{
+				WorkflowRef:         &SubWorkflowReference{Name: "team-b"},
+				WorkflowTemplateRef: &WorkflowTemplateRef{Name: "team-a"},
+			},
+			wantErr: true,
+		}, {
+			name: "arguments without workflow template",
+			subWorkflow: &SubWorkflowSpec{
+				Arguments: []WorkflowArgument{{Name: "duration", Value: "30s"}},
+				Workflow: inline(WorkflowSpec{
+					Entry:     "suspend",
+					Templates: []Template{{Name: "suspend", Type: TypeSuspend, Deadline: &deadline}},
+				}),
+			},
+			wantErr: true,
+		}, {
+			name: "invalid child workflow",
+			subWorkflow: &SubWorkflowSpec{Workflow: inline(WorkflowSpec{
+				Entry:     "not-exist",
+				Templates: []Template{{Name: "suspend", Type: TypeSuspend}},
+			})},
+			wantErr: true,
+		}, {
+			name:        "malformed child workflow",
+			subWorkflow: &SubWorkflowSpec{Workflow: json.RawMessage(`{"templates": "suspend"}`)},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateSubWorkflow(path, tt.subWorkflow)
+			if tt.wantErr {
+				g.Expect(errs).ShouldNot(BeEmpty())
+			} else {
+				g.Expect(errs).Should(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestTemplateResolveOutputsSkipsSubWorkflow(t *testing.T) {
+	g := NewWithT(t)
+
+	script := "echo {{outputs.prepare.token}}"
+	subWorkflow := SubWorkflowSpec{}
+	g.Expect(subWorkflow.SetWorkflowSpec(WorkflowSpec{
+		Entry: "use",
+		Templates: []Template{
+			{Name: "use", Type: TypeTask, Task: &Task{Container: &corev1.Container{Name: "use", Command: []string{"sh", "-c", script}}}},
+		},
+	})).To(Succeed())
+	template := Template{Name: "child", Type: TypeSubWorkflow, SubWorkflow: &subWorkflow}
+
+	// the outputs referred in the child workflow are not declared in the parent
+	g.Expect(outputReferencesMustExist(field.NewPath("spec", "templates"), []Template{template})).To(BeEmpty())
+
+	resolved, err := template.ResolveOutputs(map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resolved.SubWorkflow.Workflow).To(Equal(subWorkflow.Workflow))
+}
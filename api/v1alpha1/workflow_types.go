@@ -16,6 +16,9 @@
 package v1alpha1
 
 import (
+	"regexp"
+	"strconv"
+
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,8 +48,30 @@ func (in *Workflow) GetObjectMeta() *metav1.ObjectMeta {
 const KindWorkflow = "Workflow"
 
 type WorkflowSpec struct {
-	Entry     string     `json:"entry"`
+	// Entry and Templates are instantiated from the WorkflowTemplate when WorkflowTemplateRef is set.
+	// +optional
+	Entry string `json:"entry"`
+	// +optional
 	Templates []Template `json:"templates"`
+
+	// WorkflowTemplateRef refers to the WorkflowTemplate in the same namespace, which the workflow is instantiated from.
+	// The entry and templates must be left empty, as they are filled from the WorkflowTemplate.
+	// +optional
+	WorkflowTemplateRef *WorkflowTemplateRef `json:"workflowTemplateRef,omitempty"`
+	// Arguments are the values of the parameters declared in the referred WorkflowTemplate.
+	// +optional
+	Arguments []WorkflowArgument `json:"arguments,omitempty"`
+
+	// OnExit is the name of the template which runs after the entry node finished, regardless of its result,
+	// even if the workflow is aborted or the deadline of the entry node exceeded.
+	// +optional
+	OnExit string `json:"onExit,omitempty"`
+	// OnFailure is the name of the template which runs after the entry node failed, aborted or timed out.
+	// +optional
+	OnFailure string `json:"onFailure,omitempty"`
+	// OnSuccess is the name of the template which runs after the entry node succeeded.
+	// +optional
+	OnSuccess string `json:"onSuccess,omitempty"`
 }
 
 type WorkflowStatus struct {
@@ -56,6 +81,9 @@ type WorkflowStatus struct {
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 	// +optional
 	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// ExitHandlerNodes are the nodes spawned with OnExit, OnFailure or OnSuccess.
+	// +optional
+	ExitHandlerNodes []string `json:"exitHandlerNodes,omitempty"`
 	// Represents the latest available observations of a workflow's current state.
 	// +optional
 	// +patchMergeKey=type
@@ -68,6 +96,14 @@ type WorkflowConditionType string
 const (
 	WorkflowConditionAccomplished WorkflowConditionType = "Accomplished"
 	WorkflowConditionScheduled    WorkflowConditionType = "Scheduled"
+	WorkflowConditionFailed       WorkflowConditionType = "Failed"
+	WorkflowConditionPaused       WorkflowConditionType = "Paused"
+)
+
+// Reasons of WorkflowConditionFailed
+const (
+	EntryNodeFailed   string = "EntryNodeFailed"
+	ExitHandlerFailed string = "ExitHandlerFailed"
 )
 
 type WorkflowCondition struct {
@@ -101,6 +137,7 @@ type Template struct {
 	// +optional
 	Task *Task `json:"task,omitempty"`
 	// Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial or TypeParallel.
+	// Loop and retry node have exactly one child, which is the template to repeat.
 	// +optional
 	Children []string `json:"children,omitempty"`
 	// ConditionalBranches describes the conditional branches of custom tasks. Only used when Type is TypeTask.
@@ -119,6 +156,121 @@ type Template struct {
 	// Only used when Type is TypeStatusCheck.
 	// +optional
 	AbortWithStatusCheck bool `json:"abortWithStatusCheck,omitempty"`
+	// Loop describes the iterations of loop node. Only used when Type is TypeLoop.
+	// +optional
+	Loop *LoopSpec `json:"loop,omitempty"`
+	// Retry describes the retry policy of retry node. Only used when Type is TypeRetry.
+	// +optional
+	Retry *RetrySpec `json:"retry,omitempty"`
+	// DAG describes the tasks and their dependencies of DAG node. Only used when Type is TypeDAG.
+	// +optional
+	DAG *DAGSpec `json:"dag,omitempty"`
+	// Approval describes the approval node. Only used when Type is TypeApproval.
+	// +optional
+	Approval *ApprovalSpec `json:"approval,omitempty"`
+	// HTTPRequest describes the HTTP request sent by HTTPRequest node. Only used when Type is TypeHTTPRequest.
+	// +optional
+	HTTPRequest *HTTPRequestNodeSpec `json:"httpRequest,omitempty"`
+	// K8sApply describes the resource applied by K8sApply node. Only used when Type is TypeK8sApply.
+	// +optional
+	K8sApply *K8sApplySpec `json:"k8sApply,omitempty"`
+	// K8sWait describes the condition waited by K8sWait node. Only used when Type is TypeK8sWait.
+	// +optional
+	K8sWait *K8sWaitSpec `json:"k8sWait,omitempty"`
+	// SubWorkflow describes the child workflow instantiated by SubWorkflow node. Only used when Type is TypeSubWorkflow.
+	// +optional
+	SubWorkflow *SubWorkflowSpec `json:"subWorkflow,omitempty"`
+	// Outputs declares the values produced by Task or StatusCheck node. They could be referred with
+	// {{outputs.<template>.<output>}} in the string fields of the templates, which are created after the node finished.
+	// They could not be referred in the fields authorized at admission, which are the selectors except the names of
+	// pods, the namespaces, the targets of K8sApply and K8sWait, and the apiVersion, kind and metadata of manifest.
+	// The template itself, the templates inside it and the templates running in parallel with it could not be referred.
+	// If the referred output has no value when the template is created, the node creating it fails.
+	// +optional
+	Outputs []NodeOutput `json:"outputs,omitempty"`
+}
+
+// NodeOutput declares a named value produced by the node, exactly one of the sources should be set.
+type NodeOutput struct {
+	Name string `json:"name"`
+
+	// StdoutField is the dot-separated path of the field in the stdout of Task, the stdout should be a JSON object.
+	// +optional
+	StdoutField *string `json:"stdoutField,omitempty"`
+
+	// File is the absolute path of the file written by the container of Task, the content of the file is the value.
+	// The file is collected as the termination message of the container, so at most one output of a Task
+	// could come from file, and the content is limited to 4096 bytes.
+	// +optional
+	File *string `json:"file,omitempty"`
+
+	// StatusCheckResult takes the result of StatusCheck as the value, which is Success or Failure.
+	// +optional
+	StatusCheckResult bool `json:"statusCheckResult,omitempty"`
+}
+
+// LoopSpec describes how many times the child of loop node is repeated, either Count or WithItems should be set.
+// Each iteration is a distinct workflow node, and the iterations are performed one by one.
+type LoopSpec struct {
+	// Count is the number of iterations.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Count *int `json:"count,omitempty"`
+
+	// WithItems is the list of items to iterate over, each iteration takes one of them.
+	// The references {{item}} and {{index}} in the templates under the loop node are replaced with the item
+	// and the index of the iteration, they are also passed to the Task nodes with the environment variables
+	// CHAOS_MESH_LOOP_ITEM and CHAOS_MESH_LOOP_INDEX.
+	// +optional
+	WithItems []string `json:"withItems,omitempty"`
+}
+
+// Iterations returns the number of iterations of the loop
+func (in *LoopSpec) Iterations() int {
+	if in.Count != nil {
+		return *in.Count
+	}
+	return len(in.WithItems)
+}
+
+// iterationReference matches {{item}} and {{index}}
+var iterationReference = regexp.MustCompile(`\{\{\s*(item|index)\s*\}\}`)
+
+// ResolveIteration returns a copy of the template, with the references of the item and the index replaced by the
+// iteration. The template is copied as it is if the iteration is nil.
+func (in *Template) ResolveIteration(iteration *LoopIteration) (*Template, error) {
+	if iteration == nil {
+		return in.DeepCopy(), nil
+	}
+	origin, inline := in.withoutInlineSubWorkflow()
+	result := &Template{}
+	if err := substituteReferences(origin, result, iterationReference, map[string]string{
+		"item":  iteration.Item,
+		"index": strconv.Itoa(iteration.Index),
+	}); err != nil {
+		return nil, errors.Wrap(err, "resolve iteration")
+	}
+	if result.SubWorkflow != nil {
+		result.SubWorkflow.Workflow = inline
+	}
+	return result, nil
+}
+
+// RetrySpec describes how to retry the child of retry node when it fails.
+// Each attempt is a distinct workflow node.
+type RetrySpec struct {
+	// Limit is the max number of retries after the first attempt.
+	// +kubebuilder:validation:Minimum=1
+	Limit int `json:"limit"`
+
+	// Backoff is the duration to wait before the first retry, such as 10s.
+	// It's doubled for each of the following retries. No waiting if it's omitted.
+	// +optional
+	Backoff *string `json:"backoff,omitempty"`
+
+	// MaxBackoff is the max duration to wait before a retry.
+	// +optional
+	MaxBackoff *string `json:"maxBackoff,omitempty"`
 }
 
 // ChaosOnlyScheduleSpec is very similar with ScheduleSpec, but it could not schedule Workflow
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -47,6 +48,7 @@ func (in *Workflow) ValidateCreate(_ context.Context, obj runtime.Object) (admis
 	var allErrs field.ErrorList
 	specPath := field.NewPath("spec")
 	allErrs = append(allErrs, entryMustExists(specPath.Child("entry"), typedObj.Spec.Entry, typedObj.Spec.Templates)...)
+	allErrs = append(allErrs, exitHandlersMustExist(specPath, typedObj.Spec)...)
 	allErrs = append(allErrs, validateTemplates(specPath.Child("templates"), typedObj.Spec.Templates)...)
 	if len(allErrs) > 0 {
 		return nil, errors.New(allErrs.ToAggregate().Error())
@@ -98,6 +100,35 @@ func entryMustExists(path *field.Path, entry string, templates []Template) field
 	return result
 }
 
+// exitHandlersMustExist checks the templates referred by onExit, onFailure and onSuccess
+func exitHandlersMustExist(path *field.Path, spec WorkflowSpec) field.ErrorList {
+	var result field.ErrorList
+	handlers := []struct {
+		field    string
+		template string
+	}{
+		{"onExit", spec.OnExit},
+		{"onFailure", spec.OnFailure},
+		{"onSuccess", spec.OnSuccess},
+	}
+	for _, handler := range handlers {
+		if len(handler.template) == 0 {
+			continue
+		}
+		founded := false
+		for _, item := range spec.Templates {
+			if item.Name == handler.template {
+				founded = true
+				break
+			}
+		}
+		if !founded {
+			result = append(result, field.Invalid(path.Child(handler.field), handler.template, fmt.Sprintf("can not find a template with name %s", handler.template)))
+		}
+	}
+	return result
+}
+
 func validateTemplates(path *field.Path, templates []Template) field.ErrorList {
 	var result field.ErrorList
 	if len(templates) == 0 {
@@ -113,7 +144,11 @@ func validateTemplates(path *field.Path, templates []Template) field.ErrorList {
 	for i, item := range templates {
 		itemPath := path.Index(i)
 		result = append(result, validateTemplate(itemPath, item, templates)...)
+		result = append(result, validateOutputs(itemPath, item)...)
+		result = append(result, outputReferencesMustNotBeAuthorized(itemPath, item)...)
 	}
+	result = append(result, outputReferencesMustExist(path, templates)...)
+	result = append(result, outputReferencesMustRunBefore(path, templates)...)
 	return result
 }
 
@@ -168,6 +203,55 @@ func validateTemplate(path *field.Path, template Template, allTemplates []Templa
 		result = append(result, shouldBeNoSchedule(path, template)...)
 
 		result = append(result, template.EmbedChaos.Validate(path, string(templateType))...)
+	case templateType == TypeLoop, templateType == TypeRetry:
+		result = append(result, shouldBeOnlyOneChild(path, template, allTemplates)...)
+		result = append(result, shouldBeNoTask(path, template)...)
+		result = append(result, shouldBeNoConditionalBranches(path, template)...)
+		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, shouldBeNoSchedule(path, template)...)
+		if templateType == TypeLoop {
+			result = append(result, validateLoop(path.Child("loop"), template.Loop)...)
+		} else {
+			result = append(result, validateRetry(path.Child("retry"), template.Retry)...)
+		}
+	case templateType == TypeDAG:
+		result = append(result, shouldBeNoTask(path, template)...)
+		result = append(result, shouldBeNoChildren(path, template)...)
+		result = append(result, shouldBeNoConditionalBranches(path, template)...)
+		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, validateDAG(path.Child("dag"), template.DAG, allTemplates)...)
+	case templateType == TypeApproval:
+		result = append(result, shouldBeNoTask(path, template)...)
+		result = append(result, shouldBeNoChildren(path, template)...)
+		result = append(result, shouldBeNoConditionalBranches(path, template)...)
+		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, validateApproval(path.Child("approval"), template.Approval)...)
+	case templateType == TypeHTTPRequest:
+		result = append(result, shouldBeNoTask(path, template)...)
+		result = append(result, shouldBeNoChildren(path, template)...)
+		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, validateHTTPRequest(path.Child("httpRequest"), template.HTTPRequest)...)
+	case templateType == TypeK8sApply, templateType == TypeK8sWait:
+		result = append(result, shouldBeNoTask(path, template)...)
+		result = append(result, shouldBeNoChildren(path, template)...)
+		result = append(result, shouldBeNoConditionalBranches(path, template)...)
+		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, shouldBeNoSchedule(path, template)...)
+		if templateType == TypeK8sApply {
+			result = append(result, validateK8sApply(path.Child("k8sApply"), template.K8sApply)...)
+		} else {
+			result = append(result, validateK8sWait(path.Child("k8sWait"), template.K8sWait)...)
+		}
+	case templateType == TypeSubWorkflow:
+		result = append(result, shouldBeNoTask(path, template)...)
+		result = append(result, shouldBeNoChildren(path, template)...)
+		result = append(result, shouldBeNoConditionalBranches(path, template)...)
+		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, validateSubWorkflow(path.Child("subWorkflow"), template.SubWorkflow)...)
 	case templateType == TypeStatusCheck:
 		result = append(result, shouldBeNoTask(path, template)...)
 		result = append(result, shouldBeNoChildren(path, template)...)
@@ -299,6 +383,55 @@ func shouldBeNoSchedule(path *field.Path, template Template) field.ErrorList {
 	return nil
 }
 
+func shouldBeOnlyOneChild(path *field.Path, template Template, allTemplates []Template) field.ErrorList {
+	if len(template.Children) != 1 {
+		return field.ErrorList{
+			field.Invalid(path.Child("children"), template.Children, fmt.Sprintf("template with type %s should contain exactly one child", template.Type)),
+		}
+	}
+	return templateMustExists(template.Children[0], path.Child("children").Index(0), allTemplates)
+}
+
+func validateLoop(path *field.Path, loop *LoopSpec) field.ErrorList {
+	var result field.ErrorList
+	if loop == nil {
+		result = append(result, field.Required(path, "loop is required in template with type Loop"))
+		return result
+	}
+	if loop.Count == nil && len(loop.WithItems) == 0 {
+		result = append(result, field.Invalid(path, loop, "one of count and withItems should be set"))
+	}
+	if loop.Count != nil && len(loop.WithItems) > 0 {
+		result = append(result, field.Invalid(path, loop, "count and withItems could not be set at the same time"))
+	}
+	if loop.Count != nil && *loop.Count <= 0 {
+		result = append(result, field.Invalid(path.Child("count"), *loop.Count, "count should be greater than 0"))
+	}
+	return result
+}
+
+func validateRetry(path *field.Path, retry *RetrySpec) field.ErrorList {
+	var result field.ErrorList
+	if retry == nil {
+		result = append(result, field.Required(path, "retry is required in template with type Retry"))
+		return result
+	}
+	if retry.Limit <= 0 {
+		result = append(result, field.Invalid(path.Child("limit"), retry.Limit, "limit should be greater than 0"))
+	}
+	if retry.Backoff != nil {
+		if _, err := time.ParseDuration(*retry.Backoff); err != nil {
+			result = append(result, field.Invalid(path.Child("backoff"), *retry.Backoff, fmt.Sprintf("parse backoff field error: %s", err)))
+		}
+	}
+	if retry.MaxBackoff != nil {
+		if _, err := time.ParseDuration(*retry.MaxBackoff); err != nil {
+			result = append(result, field.Invalid(path.Child("maxBackoff"), *retry.MaxBackoff, fmt.Sprintf("parse maxBackoff field error: %s", err)))
+		}
+	}
+	return result
+}
+
 var _ webhook.CustomDefaulter = &Workflow{}
 
 func (in *Workflow) Default(_ context.Context, obj runtime.Object) error {
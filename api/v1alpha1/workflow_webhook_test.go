@@ -480,3 +480,116 @@ func Test_shouldNotSetupDurationInTheChaos(t *testing.T) {
 		})
 	}
 }
+
+func Test_shouldBeOnlyOneChild(t *testing.T) {
+	templatePath := field.NewPath("spec", "templates").Index(0)
+	allTemplates := []Template{{Name: "child-a"}, {Name: "child-b"}}
+	tests := []struct {
+		name     string
+		template Template
+		want     field.ErrorList
+	}{
+		{
+			name:     "exactly one child",
+			template: Template{Type: TypeLoop, Children: []string{"child-a"}},
+			want:     nil,
+		}, {
+			name:     "no child",
+			template: Template{Type: TypeLoop},
+			want: field.ErrorList{
+				field.Invalid(templatePath.Child("children"), []string(nil), "template with type Loop should contain exactly one child"),
+			},
+		}, {
+			name:     "more than one child",
+			template: Template{Type: TypeRetry, Children: []string{"child-a", "child-b"}},
+			want: field.ErrorList{
+				field.Invalid(templatePath.Child("children"), []string{"child-a", "child-b"}, "template with type Retry should contain exactly one child"),
+			},
+		}, {
+			name:     "child does not exist",
+			template: Template{Type: TypeRetry, Children: []string{"child-c"}},
+			want: field.ErrorList{
+				field.Invalid(templatePath.Child("children").Index(0), "child-c", "can not find a template with name child-c"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shouldBeOnlyOneChild(templatePath, tt.template, allTemplates); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("shouldBeOnlyOneChild() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validateLoop(t *testing.T) {
+	loopPath := field.NewPath("spec", "templates").Index(0).Child("loop")
+	count := 3
+	zero := 0
+	tests := []struct {
+		name    string
+		loop    *LoopSpec
+		wantErr bool
+	}{
+		{name: "with count", loop: &LoopSpec{Count: &count}, wantErr: false},
+		{name: "with items", loop: &LoopSpec{WithItems: []string{"a", "b"}}, wantErr: false},
+		{name: "missing loop", loop: nil, wantErr: true},
+		{name: "neither count nor items", loop: &LoopSpec{}, wantErr: true},
+		{name: "both count and items", loop: &LoopSpec{Count: &count, WithItems: []string{"a"}}, wantErr: true},
+		{name: "zero count", loop: &LoopSpec{Count: &zero}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateLoop(loopPath, tt.loop); (len(got) > 0) != tt.wantErr {
+				t.Errorf("validateLoop() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateRetry(t *testing.T) {
+	retryPath := field.NewPath("spec", "templates").Index(0).Child("retry")
+	backoff := "10s"
+	invalid := "ten seconds"
+	tests := []struct {
+		name    string
+		retry   *RetrySpec
+		wantErr bool
+	}{
+		{name: "only limit", retry: &RetrySpec{Limit: 3}, wantErr: false},
+		{name: "with backoff", retry: &RetrySpec{Limit: 3, Backoff: &backoff, MaxBackoff: &backoff}, wantErr: false},
+		{name: "missing retry", retry: nil, wantErr: true},
+		{name: "zero limit", retry: &RetrySpec{}, wantErr: true},
+		{name: "invalid backoff", retry: &RetrySpec{Limit: 1, Backoff: &invalid}, wantErr: true},
+		{name: "invalid max backoff", retry: &RetrySpec{Limit: 1, MaxBackoff: &invalid}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateRetry(retryPath, tt.retry); (len(got) > 0) != tt.wantErr {
+				t.Errorf("validateRetry() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_exitHandlersMustExist(t *testing.T) {
+	specPath := field.NewPath("spec")
+	templates := []Template{{Name: "entry", Type: TypeSuspend}, {Name: "cleanup", Type: TypeTask}}
+	tests := []struct {
+		name    string
+		spec    WorkflowSpec
+		wantErr int
+	}{
+		{name: "no exit handlers", spec: WorkflowSpec{Templates: templates}, wantErr: 0},
+		{name: "all handlers exist", spec: WorkflowSpec{Templates: templates, OnExit: "cleanup", OnFailure: "cleanup", OnSuccess: "entry"}, wantErr: 0},
+		{name: "onExit does not exist", spec: WorkflowSpec{Templates: templates, OnExit: "not-exist"}, wantErr: 1},
+		{name: "onFailure and onSuccess do not exist", spec: WorkflowSpec{Templates: templates, OnFailure: "a", OnSuccess: "b"}, wantErr: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitHandlersMustExist(specPath, tt.spec); len(got) != tt.wantErr {
+				t.Errorf("exitHandlersMustExist() = %v, want %d errors", got, tt.wantErr)
+			}
+		})
+	}
+}
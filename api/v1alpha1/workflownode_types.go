@@ -24,7 +24,26 @@ import (
 const (
 	LabelControlledBy       = "chaos-mesh.org/controlled-by"
 	LabelWorkflow           = "chaos-mesh.org/workflow"
+	LabelExitHandler        = "chaos-mesh.org/exit-handler"
+	LabelDAGTask            = "chaos-mesh.org/dag-task"
+	LabelRetryAttempt       = "chaos-mesh.org/retry-attempt"
 	WorkflowAnnotationAbort = "workflow.chaos-mesh.org/abort"
+	// WorkflowAnnotationPause pauses the workflow when it's "true".
+	WorkflowAnnotationPause = "workflow.chaos-mesh.org/pause"
+	// WorkflowAnnotationPausedAt is the time when the node was paused in RFC3339, it's maintained by the controller.
+	WorkflowAnnotationPausedAt = "workflow.chaos-mesh.org/paused-at"
+	// WorkflowAnnotationPausedDuration is the total duration the node has been paused, the timers of the node are
+	// postponed by it. It's maintained by the controller.
+	WorkflowAnnotationPausedDuration = "workflow.chaos-mesh.org/paused-duration"
+	// WorkflowAnnotationApproval is the decision on the approval node, which is Approve or Reject.
+	WorkflowAnnotationApproval = "workflow.chaos-mesh.org/approval"
+	// WorkflowAnnotationApprover is the user who set WorkflowAnnotationApproval, it's maintained by the webhook.
+	WorkflowAnnotationApprover = "workflow.chaos-mesh.org/approver"
+	// WorkflowAnnotationRerunOf is the uid of the workflow which the workflow is rerun from.
+	WorkflowAnnotationRerunOf = "workflow.chaos-mesh.org/rerun-of"
+	// WorkflowAnnotationTemplateVersion is the resourceVersion of the WorkflowTemplate which the workflow is
+	// instantiated from, it's maintained by the webhook.
+	WorkflowAnnotationTemplateVersion = "workflow.chaos-mesh.org/template-version"
 )
 
 const KindWorkflowNode = "WorkflowNode"
@@ -68,6 +87,36 @@ type WorkflowNodeSpec struct {
 	// Only used when Type is TypeStatusCheck.
 	// +optional
 	AbortWithStatusCheck bool `json:"abortWithStatusCheck,omitempty"`
+	// +optional
+	Loop *LoopSpec `json:"loop,omitempty"`
+	// +optional
+	Retry *RetrySpec `json:"retry,omitempty"`
+	// +optional
+	DAG *DAGSpec `json:"dag,omitempty"`
+	// +optional
+	Approval *ApprovalSpec `json:"approval,omitempty"`
+	// +optional
+	HTTPRequest *HTTPRequestNodeSpec `json:"httpRequest,omitempty"`
+	// +optional
+	K8sApply *K8sApplySpec `json:"k8sApply,omitempty"`
+	// +optional
+	K8sWait *K8sWaitSpec `json:"k8sWait,omitempty"`
+	// +optional
+	SubWorkflow *SubWorkflowSpec `json:"subWorkflow,omitempty"`
+	// +optional
+	Outputs []NodeOutput `json:"outputs,omitempty"`
+	// Iteration is the iteration of the nearest loop node in the ancestors, it's inherited by all the descendants.
+	// +optional
+	Iteration *LoopIteration `json:"iteration,omitempty"`
+}
+
+// LoopIteration describes one iteration of loop node
+type LoopIteration struct {
+	// Index is the index of the iteration, starting from 0.
+	Index int `json:"index"`
+	// Item is the item of the iteration if the loop iterates over WithItems.
+	// +optional
+	Item string `json:"item,omitempty"`
 }
 
 type WorkflowNodeStatus struct {
@@ -88,6 +137,48 @@ type WorkflowNodeStatus struct {
 	// +optional
 	FinishedChildren []corev1.LocalObjectReference `json:"finishedChildren,omitempty"`
 
+	// Outputs are the values of the outputs declared in spec, they are set when the node finished.
+	// +optional
+	Outputs map[string]string `json:"outputs,omitempty"`
+
+	// Attempts is the number of the attempts spawned by retry node, starting from 1. Each attempt is labeled
+	// with its number by LabelRetryAttempt, so the latest attempt is the child node labeled with Attempts.
+	// +optional
+	Attempts int `json:"attempts,omitempty"`
+
+	// NextRetryTime is the time to create the next attempt of retry node.
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+
+	// PostponedDuration is the paused duration by which the timers in the status, such as NextRetryTime, have
+	// been postponed.
+	// +optional
+	PostponedDuration *metav1.Duration `json:"postponedDuration,omitempty"`
+
+	// DAGTasks records the phase of each task of DAG node.
+	// +optional
+	DAGTasks []DAGTaskStatus `json:"dagTasks,omitempty"`
+
+	// Approval records the decision made on the approval node.
+	// +optional
+	Approval *ApprovalStatus `json:"approval,omitempty"`
+
+	// HTTPResponse records the response of HTTPRequest node.
+	// +optional
+	HTTPResponse *HTTPResponseStatus `json:"httpResponse,omitempty"`
+
+	// K8sApply records the resource applied by K8sApply node.
+	// +optional
+	K8sApply *K8sApplyStatus `json:"k8sApply,omitempty"`
+
+	// K8sWait records the latest observation of K8sWait node.
+	// +optional
+	K8sWait *K8sWaitStatus `json:"k8sWait,omitempty"`
+
+	// SubWorkflow records the child workflow of SubWorkflow node.
+	// +optional
+	SubWorkflow *SubWorkflowStatus `json:"subWorkflow,omitempty"`
+
 	// Represents the latest available observations of a workflow node's current state.
 	// +optional
 	// +patchMergeKey=type
@@ -122,6 +213,7 @@ const (
 	ConditionDeadlineExceed WorkflowNodeConditionType = "DeadlineExceed"
 	ConditionChaosInjected  WorkflowNodeConditionType = "ChaosInjected"
 	ConditionAborted        WorkflowNodeConditionType = "Aborted"
+	ConditionFailed         WorkflowNodeConditionType = "Failed"
 )
 
 type WorkflowNodeCondition struct {
@@ -170,6 +262,30 @@ const (
 	StatusCheckNotExceedSuccessThreshold string = "StatusCheckNotExceedSuccessThreshold"
 	ParentNodeAborted                    string = "ParentNodeAborted"
 	WorkflowAborted                      string = "WorkflowAborted"
+	TaskPodFailed                        string = "TaskPodFailed"
+	StatusCheckFailed                    string = "StatusCheckFailed"
+	ChildNodeFailed                      string = "ChildNodeFailed"
+	RetryScheduled                       string = "RetryScheduled"
+	OutputsCollectFailed                 string = "OutputsCollectFailed"
+	OutputsResolveFailed                 string = "OutputsResolveFailed"
+	ExitHandlersCreated                  string = "ExitHandlersCreated"
+	ApprovalDecided                      string = "ApprovalDecided"
+	ApprovalRejected                     string = "ApprovalRejected"
+	WorkflowPaused                       string = "WorkflowPaused"
+	WorkflowResumed                      string = "WorkflowResumed"
+	HTTPRequestSent                      string = "HTTPRequestSent"
+	HTTPRequestFailed                    string = "HTTPRequestFailed"
+	K8sResourceApplied                   string = "K8sResourceApplied"
+	K8sResourceApplyFailed               string = "K8sResourceApplyFailed"
+	K8sResourceReverted                  string = "K8sResourceReverted"
+	K8sResourceRevertFailed              string = "K8sResourceRevertFailed"
+	K8sWaitConditionMet                  string = "K8sWaitConditionMet"
+	K8sWaitTimeout                       string = "K8sWaitTimeout"
+	SubWorkflowCreated                   string = "SubWorkflowCreated"
+	SubWorkflowCreateFailed              string = "SubWorkflowCreateFailed"
+	SubWorkflowSucceed                   string = "SubWorkflowSucceed"
+	SubWorkflowFailed                    string = "SubWorkflowFailed"
+	SubWorkflowAborted                   string = "SubWorkflowAborted"
 )
 
 // GenericChaosList only use to list GenericChaos by certain EmbedChaos
@@ -0,0 +1,143 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var workflownodelog = logf.Log.WithName("workflownode-resource")
+
+var _ webhook.CustomDefaulter = &WorkflowNode{}
+
+// Default records the user who made the decision on the approval node with the annotation
+// WorkflowAnnotationApprover, the user is taken from the admission request.
+func (in *WorkflowNode) Default(ctx context.Context, obj runtime.Object) error {
+	typedObj, ok := obj.(*WorkflowNode)
+	if !ok {
+		return errors.Errorf("expected type *WorkflowNode, got %T", obj)
+	}
+
+	request, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var oldAnnotations map[string]string
+	if len(request.OldObject.Raw) > 0 {
+		oldObj := WorkflowNode{}
+		if err := json.Unmarshal(request.OldObject.Raw, &oldObj); err != nil {
+			return errors.Wrap(err, "decode the old workflow node")
+		}
+		oldAnnotations = oldObj.Annotations
+	}
+
+	setApprover(typedObj, oldAnnotations, request.UserInfo.Username)
+	return nil
+}
+
+// setApprover sets the approver to the user if the decision is changed, otherwise keeps the approver unchanged,
+// so the approver could not be modified by the users directly.
+func setApprover(node *WorkflowNode, oldAnnotations map[string]string, username string) {
+	decision := node.Annotations[WorkflowAnnotationApproval]
+	if decision == oldAnnotations[WorkflowAnnotationApproval] {
+		if approver, ok := oldAnnotations[WorkflowAnnotationApprover]; ok {
+			if node.Annotations == nil {
+				node.Annotations = make(map[string]string)
+			}
+			node.Annotations[WorkflowAnnotationApprover] = approver
+		} else {
+			delete(node.Annotations, WorkflowAnnotationApprover)
+		}
+		return
+	}
+
+	if len(decision) == 0 {
+		delete(node.Annotations, WorkflowAnnotationApprover)
+		return
+	}
+	workflownodelog.Info("approval decided", "name", node.GetName(), "decision", decision, "approver", username)
+	node.Annotations[WorkflowAnnotationApprover] = username
+}
+
+var _ webhook.CustomValidator = &WorkflowNode{}
+
+func (in *WorkflowNode) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	typedObj, ok := obj.(*WorkflowNode)
+	if !ok {
+		return nil, errors.Errorf("expected type *WorkflowNode, got %T", obj)
+	}
+
+	allErrs := validateApprovalDecision(typedObj, nil)
+	if len(allErrs) > 0 {
+		return nil, errors.New(allErrs.ToAggregate().Error())
+	}
+	return nil, nil
+}
+
+func (in *WorkflowNode) ValidateUpdate(_ context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	typedOldObj, ok := oldObj.(*WorkflowNode)
+	if !ok {
+		return nil, errors.Errorf("expected type *WorkflowNode, got %T", oldObj)
+	}
+	typedNewObj, ok := newObj.(*WorkflowNode)
+	if !ok {
+		return nil, errors.Errorf("expected type *WorkflowNode, got %T", newObj)
+	}
+
+	allErrs := validateApprovalDecision(typedNewObj, typedOldObj)
+	if len(allErrs) > 0 {
+		return nil, errors.New(allErrs.ToAggregate().Error())
+	}
+	return nil, nil
+}
+
+func (in *WorkflowNode) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateApprovalDecision checks the decision on the approval node, it could only be made once.
+func validateApprovalDecision(node *WorkflowNode, oldNode *WorkflowNode) field.ErrorList {
+	path := field.NewPath("metadata", "annotations").Key(WorkflowAnnotationApproval)
+	decision, ok := node.Annotations[WorkflowAnnotationApproval]
+
+	var result field.ErrorList
+	if oldNode != nil {
+		if oldDecision, decided := oldNode.Annotations[WorkflowAnnotationApproval]; decided && oldDecision != decision {
+			result = append(result, field.Forbidden(path, "the decision could not be changed once it's made"))
+		}
+	}
+	if !ok {
+		return result
+	}
+
+	if node.Spec.Type != TypeApproval {
+		result = append(result, field.Forbidden(path, fmt.Sprintf("the decision could only be made on the node with type %s", TypeApproval)))
+	}
+	if !validApprovalAction(ApprovalAction(decision)) {
+		result = append(result, field.NotSupported(path, decision, []string{string(ApprovalActionApprove), string(ApprovalActionReject)}))
+	}
+	return result
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	parameterNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	parameterReference   = regexp.MustCompile(`\{\{\s*parameters\.([A-Za-z0-9_-]+)\s*\}\}`)
+)
+
+// Instantiate returns the entry and the templates of the workflow, with all the parameters substituted by the
+// arguments or the default values.
+func (in *WorkflowTemplate) Instantiate(path *field.Path, arguments []WorkflowArgument) (string, []Template, field.ErrorList) {
+	values, errs := bindParameters(path, in.Spec.Parameters, arguments)
+	if len(errs) > 0 {
+		return "", nil, errs
+	}
+
+	templates, err := substituteParameters(in.Spec.Templates, values)
+	if err != nil {
+		return "", nil, field.ErrorList{field.Invalid(path, in.Name, err.Error())}
+	}
+	return in.Spec.Entry, templates, nil
+}
+
+// bindParameters returns the values of the parameters, every parameter should be bound to an argument,
+// or have a default value.
+func bindParameters(path *field.Path, parameters []WorkflowParameter, arguments []WorkflowArgument) (map[string]string, field.ErrorList) {
+	var result field.ErrorList
+
+	declared := make(map[string]WorkflowParameter)
+	for _, parameter := range parameters {
+		declared[parameter.Name] = parameter
+	}
+
+	values := make(map[string]string)
+	for i, argument := range arguments {
+		parameter, ok := declared[argument.Name]
+		if !ok {
+			result = append(result, field.Invalid(path.Index(i).Child("name"), argument.Name, fmt.Sprintf("parameter %s is not declared in the workflow template", argument.Name)))
+			continue
+		}
+		if _, ok := values[argument.Name]; ok {
+			result = append(result, field.Duplicate(path.Index(i).Child("name"), argument.Name))
+			continue
+		}
+		if err := parameter.validateValue(argument.Value); err != nil {
+			result = append(result, field.Invalid(path.Index(i).Child("value"), argument.Value, err.Error()))
+			continue
+		}
+		values[argument.Name] = argument.Value
+	}
+
+	for _, parameter := range parameters {
+		if _, ok := values[parameter.Name]; ok {
+			continue
+		}
+		if parameter.Default == nil {
+			result = append(result, field.Required(path, fmt.Sprintf("parameter %s is not bound", parameter.Name)))
+			continue
+		}
+		values[parameter.Name] = *parameter.Default
+	}
+
+	return values, result
+}
+
+// validateValue checks whether the value is valid for the type of the parameter
+func (in *WorkflowParameter) validateValue(value string) error {
+	var err error
+	switch in.Type {
+	case ParameterTypeString, "":
+	case ParameterTypeInt:
+		_, err = strconv.Atoi(value)
+	case ParameterTypeBool:
+		_, err = strconv.ParseBool(value)
+	case ParameterTypeDuration:
+		_, err = time.ParseDuration(value)
+	default:
+		return errors.Errorf("unknown type %s of parameter %s", in.Type, in.Name)
+	}
+	if err != nil {
+		return errors.Errorf("value of parameter %s should be a valid %s", in.Name, in.Type)
+	}
+	return nil
+}
+
+// substituteParameters replaces all the references of parameters in the string fields of the templates
+func substituteParameters(templates []Template, values map[string]string) ([]Template, error) {
+	var result []Template
+	if err := substituteReferences(templates, &result, parameterReference, values); err != nil {
+		return nil, errors.Wrap(err, "substitute parameters")
+	}
+	return result, nil
+}
+
+// substituteReferences replaces the references matched by the pattern in the string fields of in, then stores the
+// result into out. The key of a reference in values is its submatches joined with '.'.
+func substituteReferences(in interface{}, out interface{}, pattern *regexp.Regexp, values map[string]string) error {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	var undeclared []string
+	substituted := pattern.ReplaceAllFunc(raw, func(reference []byte) []byte {
+		var keys []string
+		for _, submatch := range pattern.FindSubmatch(reference)[1:] {
+			keys = append(keys, string(submatch))
+		}
+		key := strings.Join(keys, ".")
+		value, ok := values[key]
+		if !ok {
+			undeclared = append(undeclared, key)
+			return reference
+		}
+		// the value is placed in a JSON string, so it should be escaped
+		escaped, _ := json.Marshal(value)
+		return escaped[1 : len(escaped)-1]
+	})
+	if len(undeclared) > 0 {
+		return errors.Errorf("%s are not found", strings.Join(undeclared, ","))
+	}
+
+	return json.Unmarshal(substituted, out)
+}
+
+// referredParameters returns the names of the parameters referred in the templates
+func referredParameters(templates []Template) ([]string, error) {
+	raw, err := json.Marshal(templates)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]struct{})
+	for _, match := range parameterReference.FindAllSubmatch(raw, -1) {
+		names[string(match[1])] = struct{}{}
+	}
+	var result []string
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result, nil
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_bindParameters(t *testing.T) {
+	path := field.NewPath("spec", "arguments")
+	defaultNamespace := "default"
+	parameters := []WorkflowParameter{
+		{Name: "namespace", Type: ParameterTypeString, Default: &defaultNamespace},
+		{Name: "duration", Type: ParameterTypeDuration},
+		{Name: "workers", Type: ParameterTypeInt},
+	}
+	tests := []struct {
+		name      string
+		arguments []WorkflowArgument
+		want      map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "bound with arguments and default values",
+			arguments: []WorkflowArgument{
+				{Name: "duration", Value: "30s"},
+				{Name: "workers", Value: "2"},
+			},
+			want: map[string]string{"namespace": "default", "duration": "30s", "workers": "2"},
+		}, {
+			name: "argument overrides the default value",
+			arguments: []WorkflowArgument{
+				{Name: "namespace", Value: "app"},
+				{Name: "duration", Value: "30s"},
+				{Name: "workers", Value: "2"},
+			},
+			want: map[string]string{"namespace": "app", "duration": "30s", "workers": "2"},
+		}, {
+			name:      "parameter not bound",
+			arguments: []WorkflowArgument{{Name: "duration", Value: "30s"}},
+			wantErr:   true,
+		}, {
+			name: "argument not declared",
+			arguments: []WorkflowArgument{
+				{Name: "duration", Value: "30s"},
+				{Name: "workers", Value: "2"},
+				{Name: "unknown", Value: "1"},
+			},
+			wantErr: true,
+		}, {
+			name: "invalid value",
+			arguments: []WorkflowArgument{
+				{Name: "duration", Value: "30s"},
+				{Name: "workers", Value: "two"},
+			},
+			wantErr: true,
+		}, {
+			name: "duplicated argument",
+			arguments: []WorkflowArgument{
+				{Name: "duration", Value: "30s"},
+				{Name: "duration", Value: "60s"},
+				{Name: "workers", Value: "2"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, errs := bindParameters(path, parameters, tt.arguments)
+			if tt.wantErr {
+				g.Expect(errs).ShouldNot(BeEmpty())
+				return
+			}
+			g.Expect(errs).Should(BeEmpty())
+			g.Expect(got).Should(Equal(tt.want))
+		})
+	}
+}
+
+func Test_substituteParameters(t *testing.T) {
+	g := NewWithT(t)
+
+	deadline := "{{parameters.duration}}"
+	templates := []Template{
+		{
+			Name:     "pod-kill",
+			Type:     TypePodChaos,
+			Deadline: &deadline,
+			EmbedChaos: &EmbedChaos{
+				PodChaos: &PodChaosSpec{
+					ContainerSelector: ContainerSelector{
+						PodSelector: PodSelector{
+							Selector: PodSelectorSpec{
+								GenericSelectorSpec: GenericSelectorSpec{
+									Namespaces: []string{"{{ parameters.namespace }}"},
+								},
+							},
+							Mode: OneMode,
+						},
+					},
+					Action: PodKillAction,
+				},
+			},
+		},
+	}
+
+	got, err := substituteParameters(templates, map[string]string{"duration": "30s", "namespace": `app"1`})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(*got[0].Deadline).Should(Equal("30s"))
+	g.Expect(got[0].EmbedChaos.PodChaos.Selector.Namespaces).Should(Equal([]string{`app"1`}))
+	// the original templates should not be changed
+	g.Expect(*templates[0].Deadline).Should(Equal("{{parameters.duration}}"))
+
+	_, err = substituteParameters(templates, map[string]string{"duration": "30s"})
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestWorkflowTemplateSpecValidate(t *testing.T) {
+	g := NewWithT(t)
+
+	deadline := "{{parameters.duration}}"
+	invalidDefault := "thirty seconds"
+	spec := WorkflowTemplateSpec{
+		Parameters: []WorkflowParameter{
+			{Name: "duration", Type: ParameterTypeDuration},
+		},
+		Entry: "suspend",
+		Templates: []Template{
+			{Name: "suspend", Type: TypeSuspend, Deadline: &deadline},
+		},
+	}
+	g.Expect(spec.Validate(field.NewPath("spec"))).Should(BeEmpty())
+
+	undeclared := *spec.DeepCopy()
+	undeclared.Parameters = nil
+	g.Expect(undeclared.Validate(field.NewPath("spec"))).ShouldNot(BeEmpty())
+
+	invalid := *spec.DeepCopy()
+	invalid.Parameters[0].Default = &invalidDefault
+	g.Expect(invalid.Validate(field.NewPath("spec"))).ShouldNot(BeEmpty())
+
+	duplicated := *spec.DeepCopy()
+	duplicated.Parameters = append(duplicated.Parameters, WorkflowParameter{Name: "duration"})
+	g.Expect(duplicated.Validate(field.NewPath("spec"))).ShouldNot(BeEmpty())
+}
+
+func TestWorkflowWebhookInstantiate(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(AddToScheme(scheme)).To(Succeed())
+
+	deadline := "{{parameters.duration}}"
+	template := &WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "suspend-template"},
+		Spec: WorkflowTemplateSpec{
+			Parameters: []WorkflowParameter{
+				{Name: "duration", Type: ParameterTypeDuration},
+			},
+			Entry: "suspend",
+			Templates: []Template{
+				{Name: "suspend", Type: TypeSuspend, Deadline: &deadline},
+			},
+		},
+	}
+	hook := &WorkflowWebhook{Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(template).Build()}
+	ctx := context.Background()
+
+	workflow := &Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "suspend"},
+		Spec: WorkflowSpec{
+			WorkflowTemplateRef: &WorkflowTemplateRef{Name: "suspend-template"},
+			Arguments:           []WorkflowArgument{{Name: "duration", Value: "10s"}},
+		},
+	}
+	g.Expect(hook.Default(ctx, workflow)).To(Succeed())
+	g.Expect(workflow.Spec.Entry).Should(Equal("suspend"))
+	g.Expect(workflow.Spec.Templates).Should(HaveLen(1))
+	g.Expect(*workflow.Spec.Templates[0].Deadline).Should(Equal("10s"))
+	g.Expect(template.ResourceVersion).ShouldNot(BeEmpty())
+	g.Expect(workflow.Annotations).Should(HaveKeyWithValue(WorkflowAnnotationTemplateVersion, template.ResourceVersion))
+	_, err := hook.ValidateCreate(ctx, workflow)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	unbound := &Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "unbound"},
+		Spec: WorkflowSpec{
+			WorkflowTemplateRef: &WorkflowTemplateRef{Name: "suspend-template"},
+		},
+	}
+	g.Expect(hook.Default(ctx, unbound)).To(Succeed())
+	g.Expect(unbound.Spec.Templates).Should(BeEmpty())
+	_, err = hook.ValidateCreate(ctx, unbound)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(err.Error()).Should(ContainSubstring("parameter duration is not bound"))
+
+	missing := &Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "missing"},
+		Spec: WorkflowSpec{
+			WorkflowTemplateRef: &WorkflowTemplateRef{Name: "not-exist"},
+		},
+	}
+	_, err = hook.ValidateCreate(ctx, missing)
+	g.Expect(err).Should(HaveOccurred())
+
+	inline := &Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "inline"},
+		Spec: WorkflowSpec{
+			WorkflowTemplateRef: &WorkflowTemplateRef{Name: "suspend-template"},
+			Arguments:           []WorkflowArgument{{Name: "duration", Value: "10s"}},
+			Entry:               "other",
+			Templates: []Template{
+				{Name: "other", Type: TypeSuspend, Deadline: &deadline},
+			},
+		},
+	}
+	g.Expect(hook.Default(ctx, inline)).To(Succeed())
+	g.Expect(inline.Spec.Entry).Should(Equal("other"))
+	_, err = hook.ValidateCreate(ctx, inline)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(err.Error()).Should(ContainSubstring("could not be set together with entry or templates"))
+
+	entryOnly := &Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "entry-only"},
+		Spec: WorkflowSpec{
+			WorkflowTemplateRef: &WorkflowTemplateRef{Name: "suspend-template"},
+			Arguments:           []WorkflowArgument{{Name: "duration", Value: "10s"}},
+			Entry:               "suspend",
+		},
+	}
+	g.Expect(hook.Default(ctx, entryOnly)).To(Succeed())
+	g.Expect(entryOnly.Spec.Templates).Should(BeEmpty())
+	_, err = hook.ValidateCreate(ctx, entryOnly)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(err.Error()).Should(ContainSubstring("could not be set together with entry or templates"))
+
+	// the rerun keeps the templates instantiated from the earlier version of the WorkflowTemplate
+	rerun := workflow.DeepCopy()
+	rerun.Name = "rerun"
+	changedDeadline := "{{parameters.duration}}0"
+	template.Spec.Templates[0].Deadline = &changedDeadline
+	g.Expect(hook.Reader.(client.Client).Update(ctx, template)).To(Succeed())
+	g.Expect(hook.Default(ctx, rerun)).To(Succeed())
+	g.Expect(*rerun.Spec.Templates[0].Deadline).Should(Equal("10s"))
+	_, err = hook.ValidateCreate(ctx, rerun)
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
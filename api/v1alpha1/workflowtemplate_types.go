@@ -0,0 +1,98 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=wft
+
+// WorkflowTemplate is a reusable definition of workflow with input parameters.
+// Workflows refer to it with WorkflowTemplateRef, and provide the values of the parameters with Arguments.
+type WorkflowTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the parameters and the templates of the workflow
+	Spec WorkflowTemplateSpec `json:"spec"`
+}
+
+const KindWorkflowTemplate = "WorkflowTemplate"
+
+type WorkflowTemplateSpec struct {
+	// Parameters are the input parameters of the workflow. A parameter is referred with {{parameters.<name>}}
+	// in any string field of the templates, such as the embedded chaos specs, the deadlines and the task containers.
+	// +optional
+	Parameters []WorkflowParameter `json:"parameters,omitempty"`
+
+	Entry     string     `json:"entry"`
+	Templates []Template `json:"templates"`
+}
+
+type WorkflowParameterType string
+
+const (
+	ParameterTypeString   WorkflowParameterType = "string"
+	ParameterTypeInt      WorkflowParameterType = "int"
+	ParameterTypeBool     WorkflowParameterType = "bool"
+	ParameterTypeDuration WorkflowParameterType = "duration"
+)
+
+// WorkflowParameter declares an input parameter of WorkflowTemplate
+type WorkflowParameter struct {
+	// Name is the name of the parameter, it could only contain letters, digits, '-' and '_'.
+	Name string `json:"name"`
+
+	// Type is the type of the parameter, the argument must be a valid value of the type.
+	// +optional
+	// +kubebuilder:validation:Enum=string;int;bool;duration
+	// +kubebuilder:default=string
+	Type WorkflowParameterType `json:"type,omitempty"`
+
+	// Default is the value used when there is no argument for the parameter.
+	// The parameter is required if it's omitted.
+	// +optional
+	Default *string `json:"default,omitempty"`
+
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// WorkflowTemplateRef refers to a WorkflowTemplate in the same namespace
+type WorkflowTemplateRef struct {
+	Name string `json:"name"`
+}
+
+// WorkflowArgument is the value of a parameter declared in WorkflowTemplate
+type WorkflowArgument struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkflowTemplateList contains a list of WorkflowTemplate
+type WorkflowTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkflowTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkflowTemplate{}, &WorkflowTemplateList{})
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var workflowtemplatelog = logf.Log.WithName("workflowtemplate-resource")
+
+var _ webhook.CustomValidator = &WorkflowTemplate{}
+
+func (in *WorkflowTemplate) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	typedObj, ok := obj.(*WorkflowTemplate)
+	if !ok {
+		return nil, errors.Errorf("expected type *WorkflowTemplate, got %T", obj)
+	}
+	workflowtemplatelog.Info("validate create", "name", typedObj.GetName())
+
+	allErrs := typedObj.Spec.Validate(field.NewPath("spec"))
+	if len(allErrs) > 0 {
+		return nil, errors.New(allErrs.ToAggregate().Error())
+	}
+	return nil, nil
+}
+
+func (in *WorkflowTemplate) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	typedNewObj, ok := newObj.(*WorkflowTemplate)
+	if !ok {
+		return nil, errors.Errorf("expected type *WorkflowTemplate, got %T", newObj)
+	}
+
+	workflowtemplatelog.Info("validate update", "name", typedNewObj.GetName())
+	return in.ValidateCreate(ctx, typedNewObj)
+}
+
+func (in *WorkflowTemplate) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// Validate validates the parameters and the templates of WorkflowTemplate.
+// The templates are only validated after the parameters substituted, while the Workflow is created.
+func (in *WorkflowTemplateSpec) Validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	parametersPath := path.Child("parameters")
+	declared := make(map[string]struct{})
+	for i, parameter := range in.Parameters {
+		parameterPath := parametersPath.Index(i)
+		if !parameterNamePattern.MatchString(parameter.Name) {
+			allErrs = append(allErrs, field.Invalid(parameterPath.Child("name"), parameter.Name, "name of parameter could only contain letters, digits, '-' and '_'"))
+		}
+		if _, ok := declared[parameter.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(parameterPath.Child("name"), parameter.Name))
+		}
+		declared[parameter.Name] = struct{}{}
+
+		if parameter.Default != nil {
+			if err := parameter.validateValue(*parameter.Default); err != nil {
+				allErrs = append(allErrs, field.Invalid(parameterPath.Child("default"), *parameter.Default, err.Error()))
+			}
+		}
+	}
+
+	templatesPath := path.Child("templates")
+	if len(in.Templates) == 0 {
+		allErrs = append(allErrs, field.Invalid(templatesPath, in.Templates, "templates in workflow template could not be empty"))
+	}
+	allErrs = append(allErrs, entryMustExists(path.Child("entry"), in.Entry, in.Templates)...)
+
+	var names []string
+	for _, template := range in.Templates {
+		names = append(names, template.Name)
+	}
+	allErrs = append(allErrs, namesCouldNotBeDuplicated(templatesPath, names)...)
+
+	referred, err := referredParameters(in.Templates)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(templatesPath, "", err.Error()))
+	}
+	for _, name := range referred {
+		if _, ok := declared[name]; !ok {
+			allErrs = append(allErrs, field.Invalid(templatesPath, name, fmt.Sprintf("parameter %s is referred but not declared", name)))
+		}
+	}
+
+	return allErrs
+}
+
+var _ webhook.CustomDefaulter = &WorkflowTemplate{}
+
+func (in *WorkflowTemplate) Default(_ context.Context, obj runtime.Object) error {
+	typedObj, ok := obj.(*WorkflowTemplate)
+	if !ok {
+		return errors.Errorf("expected type *WorkflowTemplate, got %T", obj)
+	}
+	for i := range typedObj.Spec.Parameters {
+		if len(typedObj.Spec.Parameters[i].Type) == 0 {
+			typedObj.Spec.Parameters[i].Type = ParameterTypeString
+		}
+	}
+	return nil
+}
+
+// WorkflowWebhook is the webhook of Workflow, which also instantiates the referred WorkflowTemplate,
+// so it requires a reader to fetch the WorkflowTemplate.
+// +kubebuilder:object:generate=false
+type WorkflowWebhook struct {
+	Reader client.Reader
+}
+
+var _ webhook.CustomValidator = &WorkflowWebhook{}
+
+func (it *WorkflowWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	typedObj, ok := obj.(*Workflow)
+	if !ok {
+		return nil, errors.Errorf("expected type *Workflow, got %T", obj)
+	}
+
+	if typedObj.Spec.WorkflowTemplateRef != nil {
+		// the workflow has been instantiated by Default, validate the arguments again to report the errors
+		version, entry, templates, allErrs := it.instantiate(ctx, typedObj)
+		if len(allErrs) > 0 {
+			return nil, errors.New(allErrs.ToAggregate().Error())
+		}
+		// Default only fills the entry and templates when both of them are empty, so any other values are set by
+		// the user together with the reference, except the ones instantiated from an earlier version of the
+		// WorkflowTemplate, which are kept by rerunning the workflow.
+		recorded, ok := typedObj.Annotations[WorkflowAnnotationTemplateVersion]
+		rerun := ok && recorded != version && len(typedObj.Spec.Templates) > 0
+		if !rerun && !it.isInstantiated(ctx, typedObj, entry, templates) {
+			return nil, errors.New(field.Forbidden(field.NewPath("spec", "workflowTemplateRef"),
+				"could not be set together with entry or templates").Error())
+		}
+	}
+
+	// the sub workflows have been resolved by Default, resolve them again to report the errors
+	templates := typedObj.DeepCopy().Spec.Templates
+	if allErrs := ResolveSubWorkflows(ctx, it.Reader, typedObj.Namespace, field.NewPath("spec", "templates"), templates); len(allErrs) > 0 {
+		return nil, errors.New(allErrs.ToAggregate().Error())
+	}
+
+	return typedObj.ValidateCreate(ctx, typedObj)
+}
+
+func (it *WorkflowWebhook) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	// the workflow has been instantiated while creating, so the WorkflowTemplate is not required anymore
+	return (&Workflow{}).ValidateUpdate(ctx, oldObj, newObj)
+}
+
+func (it *WorkflowWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return (&Workflow{}).ValidateDelete(ctx, obj)
+}
+
+var _ webhook.CustomDefaulter = &WorkflowWebhook{}
+
+func (it *WorkflowWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	typedObj, ok := obj.(*Workflow)
+	if !ok {
+		return errors.Errorf("expected type *Workflow, got %T", obj)
+	}
+
+	if typedObj.Spec.WorkflowTemplateRef != nil && len(typedObj.Spec.Entry) == 0 && len(typedObj.Spec.Templates) == 0 {
+		version, entry, templates, allErrs := it.instantiate(ctx, typedObj)
+		// the errors will be reported by the validation
+		if len(allErrs) == 0 {
+			typedObj.Spec.Entry = entry
+			typedObj.Spec.Templates = templates
+			if typedObj.Annotations == nil {
+				typedObj.Annotations = make(map[string]string)
+			}
+			typedObj.Annotations[WorkflowAnnotationTemplateVersion] = version
+		}
+	}
+
+	// the referred workflows are resolved while creating, so that the child workflows are validated and authorized
+	// with the parent. The errors will be reported by the validation.
+	_ = ResolveSubWorkflows(ctx, it.Reader, typedObj.Namespace, field.NewPath("spec", "templates"), typedObj.Spec.Templates)
+
+	return typedObj.Default(ctx, typedObj)
+}
+
+// instantiate returns the resourceVersion of the referred WorkflowTemplate, and the entry and templates instantiated
+// from it with the arguments of the workflow.
+func (it *WorkflowWebhook) instantiate(ctx context.Context, workflow *Workflow) (string, string, []Template, field.ErrorList) {
+	specPath := field.NewPath("spec")
+	ref := workflow.Spec.WorkflowTemplateRef
+
+	template := WorkflowTemplate{}
+	err := it.Reader.Get(ctx, types.NamespacedName{
+		Namespace: workflow.Namespace,
+		Name:      ref.Name,
+	}, &template)
+	if err != nil {
+		return "", "", nil, field.ErrorList{
+			field.Invalid(specPath.Child("workflowTemplateRef", "name"), ref.Name, fmt.Sprintf("failed to get workflow template: %s", err)),
+		}
+	}
+
+	entry, templates, allErrs := template.Instantiate(specPath.Child("arguments"), workflow.Spec.Arguments)
+	return template.ResourceVersion, entry, templates, allErrs
+}
+
+// isInstantiated checks whether the entry and templates of the workflow are the ones filled by Default from the
+// referred WorkflowTemplate.
+func (it *WorkflowWebhook) isInstantiated(ctx context.Context, workflow *Workflow, entry string, templates []Template) bool {
+	if workflow.Spec.Entry != entry {
+		return false
+	}
+
+	expected := &Workflow{
+		ObjectMeta: *workflow.ObjectMeta.DeepCopy(),
+		Spec: WorkflowSpec{
+			Entry:     entry,
+			Templates: templates,
+		},
+	}
+	// the errors will be reported by resolving the sub workflows of the workflow itself
+	_ = ResolveSubWorkflows(ctx, it.Reader, expected.Namespace, field.NewPath("spec", "templates"), expected.Spec.Templates)
+	_ = expected.Default(ctx, expected)
+
+	// compare the decoded JSON, as the inline specs of the sub workflows are not required to be encoded in the same way
+	return equalJSON(workflow.Spec.Templates, expected.Spec.Templates)
+}
+
+func equalJSON(a, b interface{}) bool {
+	decodedA, err := decodeJSON(a)
+	if err != nil {
+		return false
+	}
+	decodedB, err := decodeJSON(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(decodedA, decodedB)
+}
+
+func decodeJSON(value interface{}) (interface{}, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var result interface{}
+	err = json.Unmarshal(raw, &result)
+	return result, err
+}
@@ -1628,6 +1628,164 @@ func (in *NetworkChaos) Default(_ context.Context, obj runtime.Object) error {
 	return nil
 }
 
+const KindNodeChaos = "NodeChaos"
+
+// IsDeleted returns whether this resource has been deleted
+func (in *NodeChaos) IsDeleted() bool {
+	return !in.DeletionTimestamp.IsZero()
+}
+
+// IsPaused returns whether this resource has been paused
+func (in *NodeChaos) IsPaused() bool {
+	if in.Annotations == nil || in.Annotations[PauseAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// GetObjectMeta would return the ObjectMeta for chaos
+func (in *NodeChaos) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// GetDuration would return the duration for chaos
+func (in *NodeChaosSpec) GetDuration() (*time.Duration, error) {
+	if in.Duration == nil {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(string(*in.Duration))
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
+
+// GetStatus returns the status
+func (in *NodeChaos) GetStatus() *ChaosStatus {
+	return &in.Status.ChaosStatus
+}
+
+// GetRemoteCluster returns the remoteCluster
+func (in *NodeChaos) GetRemoteCluster() string {
+	return in.Spec.RemoteCluster
+}
+
+// GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
+func (in *NodeChaos) GetSpecAndMetaString() (string, error) {
+	spec, err := json.Marshal(in.Spec)
+	if err != nil {
+		return "", err
+	}
+
+	meta := in.ObjectMeta.DeepCopy()
+	meta.SetResourceVersion("")
+	meta.SetGeneration(0)
+
+	return string(spec) + meta.String(), nil
+}
+
+// +kubebuilder:object:root=true
+
+// NodeChaosList contains a list of NodeChaos
+type NodeChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeChaos `json:"items"`
+}
+
+func (in *NodeChaosList) DeepCopyList() GenericChaosList {
+	return in.DeepCopy()
+}
+
+// ListChaos returns a list of chaos
+func (in *NodeChaosList) ListChaos() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
+
+func (in *NodeChaos) DurationExceeded(now time.Time) (bool, time.Duration, error) {
+	duration, err := in.Spec.GetDuration()
+	if err != nil {
+		return false, 0, err
+	}
+
+	if duration != nil {
+		stopTime := in.GetCreationTimestamp().Add(*duration)
+		if stopTime.Before(now) {
+			return true, 0, nil
+		}
+
+		return false, stopTime.Sub(now), nil
+	}
+
+	return false, 0, nil
+}
+
+func (in *NodeChaos) IsOneShot() bool {
+	return false
+}
+
+var NodeChaosWebhookLog = logf.Log.WithName("NodeChaos-resource")
+
+func (in *NodeChaos) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	typedObj, ok := obj.(*NodeChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *NodeChaos, got %T", obj)
+	}
+	NodeChaosWebhookLog.Info("validate create", "name", typedObj.GetName())
+
+	return typedObj.Validate()
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (in *NodeChaos) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	typedOldObj, ok := oldObj.(*NodeChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *NodeChaos, got %T", oldObj)
+	}
+
+	typedNewObj, ok := newObj.(*NodeChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *NodeChaos, got %T", newObj)
+	}
+
+	NodeChaosWebhookLog.Info("validate update", "name", typedOldObj.GetName())
+	if !reflect.DeepEqual(typedOldObj.Spec, typedNewObj.Spec) {
+		return nil, ErrCanNotUpdateChaos
+	}
+	return typedNewObj.Validate()
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (in *NodeChaos) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	typedObj, ok := obj.(*NodeChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *NodeChaos, got %T", obj)
+	}
+
+	NodeChaosWebhookLog.Info("validate delete", "name", typedObj.GetName())
+
+	return nil, nil
+}
+
+var _ webhook.CustomValidator = &NodeChaos{}
+
+func (in *NodeChaos) Validate() ([]string, error) {
+	errs := gw.Validate(in)
+	return nil, gw.Aggregate(errs)
+}
+
+var _ webhook.CustomDefaulter = &NodeChaos{}
+
+func (in *NodeChaos) Default(_ context.Context, obj runtime.Object) error {
+	gw.Default(obj)
+	return nil
+}
+
 const KindPhysicalMachineChaos = "PhysicalMachineChaos"
 
 // IsDeleted returns whether this resource has been deleted
@@ -2671,6 +2829,12 @@ func init() {
 		list:  &NetworkChaosList{},
 	})
 
+	SchemeBuilder.Register(&NodeChaos{}, &NodeChaosList{})
+	all.register(KindNodeChaos, &ChaosKind{
+		chaos: &NodeChaos{},
+		list:  &NodeChaosList{},
+	})
+
 	SchemeBuilder.Register(&PhysicalMachineChaos{}, &PhysicalMachineChaosList{})
 	all.register(KindPhysicalMachineChaos, &ChaosKind{
 		chaos: &PhysicalMachineChaos{},
@@ -2758,6 +2922,11 @@ func init() {
 		list:  &NetworkChaosList{},
 	})
 
+	allScheduleItem.register(KindNodeChaos, &ChaosKind{
+		chaos: &NodeChaos{},
+		list:  &NodeChaosList{},
+	})
+
 	allScheduleItem.register(KindPhysicalMachineChaos, &ChaosKind{
 		chaos: &PhysicalMachineChaos{},
 		list:  &PhysicalMachineChaosList{},
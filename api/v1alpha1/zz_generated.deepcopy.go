@@ -22,6 +22,7 @@ package v1alpha1
 import (
 	"encoding/json"
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"net/http"
 )
@@ -157,6 +158,42 @@ func (in *AWSSelector) DeepCopy() *AWSSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalSpec) DeepCopyInto(out *ApprovalSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSpec.
+func (in *ApprovalSpec) DeepCopy() *ApprovalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalStatus) DeepCopyInto(out *ApprovalStatus) {
+	*out = *in
+	in.DecisionTime.DeepCopyInto(&out.DecisionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalStatus.
+func (in *ApprovalStatus) DeepCopy() *ApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AttrOverrideSpec) DeepCopyInto(out *AttrOverrideSpec) {
 	*out = *in
@@ -410,6 +447,35 @@ func (in *BlockChaos) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockChaosInjections) DeepCopyInto(out *BlockChaosInjections) {
+	*out = *in
+	if in.InjectionIds != nil {
+		in, out := &in.InjectionIds, &out.InjectionIds
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeNames != nil {
+		in, out := &in.NodeNames, &out.NodeNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockChaosInjections.
+func (in *BlockChaosInjections) DeepCopy() *BlockChaosInjections {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockChaosInjections)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockChaosList) DeepCopyInto(out *BlockChaosList) {
 	*out = *in
@@ -450,6 +516,16 @@ func (in *BlockChaosSpec) DeepCopyInto(out *BlockChaosSpec) {
 		*out = new(BlockDelaySpec)
 		**out = **in
 	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(BlockErrorSpec)
+		**out = **in
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(BlockLimitSpec)
+		**out = **in
+	}
 	in.ContainerNodeVolumePathSelector.DeepCopyInto(&out.ContainerNodeVolumePathSelector)
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
@@ -472,13 +548,7 @@ func (in *BlockChaosSpec) DeepCopy() *BlockChaosSpec {
 func (in *BlockChaosStatus) DeepCopyInto(out *BlockChaosStatus) {
 	*out = *in
 	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
-	if in.InjectionIds != nil {
-		in, out := &in.InjectionIds, &out.InjectionIds
-		*out = make(map[string]int, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
+	in.BlockChaosInjections.DeepCopyInto(&out.BlockChaosInjections)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockChaosStatus.
@@ -506,6 +576,36 @@ func (in *BlockDelaySpec) DeepCopy() *BlockDelaySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockErrorSpec) DeepCopyInto(out *BlockErrorSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockErrorSpec.
+func (in *BlockErrorSpec) DeepCopy() *BlockErrorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockErrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockLimitSpec) DeepCopyInto(out *BlockLimitSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockLimitSpec.
+func (in *BlockLimitSpec) DeepCopy() *BlockLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUStressor) DeepCopyInto(out *CPUStressor) {
 	*out = *in
@@ -532,6 +632,22 @@ func (in *CPUStressor) DeepCopy() *CPUStressor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheStressor) DeepCopyInto(out *CacheStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheStressor.
+func (in *CacheStressor) DeepCopy() *CacheStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosCondition) DeepCopyInto(out *ChaosCondition) {
 	*out = *in
@@ -745,6 +861,78 @@ func (in *CorruptSpec) DeepCopy() *CorruptSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DAGDependency) DeepCopyInto(out *DAGDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DAGDependency.
+func (in *DAGDependency) DeepCopy() *DAGDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(DAGDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DAGSpec) DeepCopyInto(out *DAGSpec) {
+	*out = *in
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make([]DAGTask, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DAGSpec.
+func (in *DAGSpec) DeepCopy() *DAGSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DAGSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DAGTask) DeepCopyInto(out *DAGTask) {
+	*out = *in
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]DAGDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DAGTask.
+func (in *DAGTask) DeepCopy() *DAGTask {
+	if in == nil {
+		return nil
+	}
+	out := new(DAGTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DAGTaskStatus) DeepCopyInto(out *DAGTaskStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DAGTaskStatus.
+func (in *DAGTaskStatus) DeepCopy() *DAGTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DAGTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSChaos) DeepCopyInto(out *DNSChaos) {
 	*out = *in
@@ -772,6 +960,25 @@ func (in *DNSChaos) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSChaosInstance) DeepCopyInto(out *DNSChaosInstance) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosInstance.
+func (in *DNSChaosInstance) DeepCopy() *DNSChaosInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSChaosInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSChaosList) DeepCopyInto(out *DNSChaosList) {
 	*out = *in
@@ -804,6 +1011,26 @@ func (in *DNSChaosList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSChaosRule) DeepCopyInto(out *DNSChaosRule) {
+	*out = *in
+	if in.Answers != nil {
+		in, out := &in.Answers, &out.Answers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosRule.
+func (in *DNSChaosRule) DeepCopy() *DNSChaosRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSChaosRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSChaosSpec) DeepCopyInto(out *DNSChaosSpec) {
 	*out = *in
@@ -818,6 +1045,13 @@ func (in *DNSChaosSpec) DeepCopyInto(out *DNSChaosSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]DNSChaosRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosSpec.
@@ -834,6 +1068,13 @@ func (in *DNSChaosSpec) DeepCopy() *DNSChaosSpec {
 func (in *DNSChaosStatus) DeepCopyInto(out *DNSChaosStatus) {
 	*out = *in
 	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]DNSChaosInstance, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosStatus.
@@ -897,6 +1138,21 @@ func (in *DiskFillSpec) DeepCopy() *DiskFillSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskFillVolumeSpec) DeepCopyInto(out *DiskFillVolumeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskFillVolumeSpec.
+func (in *DiskFillVolumeSpec) DeepCopy() *DiskFillVolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskFillVolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskPayloadSpec) DeepCopyInto(out *DiskPayloadSpec) {
 	*out = *in
@@ -981,6 +1237,11 @@ func (in *EmbedChaos) DeepCopyInto(out *EmbedChaos) {
 		*out = new(NetworkChaosSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeChaos != nil {
+		in, out := &in.NodeChaos, &out.NodeChaos
+		*out = new(NodeChaosSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PhysicalMachineChaos != nil {
 		in, out := &in.PhysicalMachineChaos, &out.PhysicalMachineChaos
 		*out = new(PhysicalMachineChaosSpec)
@@ -1292,6 +1553,11 @@ func (in *ExperimentStatus) DeepCopy() *ExperimentStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailKernRequest) DeepCopyInto(out *FailKernRequest) {
 	*out = *in
+	if in.Preset != nil {
+		in, out := &in.Preset, &out.Preset
+		*out = new(KernelFaultPreset)
+		**out = **in
+	}
 	if in.Headers != nil {
 		in, out := &in.Headers, &out.Headers
 		*out = make([]string, len(*in))
@@ -1424,6 +1690,22 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForkStressor) DeepCopyInto(out *ForkStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkStressor.
+func (in *ForkStressor) DeepCopy() *ForkStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(ForkStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Frame) DeepCopyInto(out *Frame) {
 	*out = *in
@@ -1632,6 +1914,22 @@ func (in *GenericSelectorSpec) DeepCopy() *GenericSelectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HDDStressor) DeepCopyInto(out *HDDStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HDDStressor.
+func (in *HDDStressor) DeepCopy() *HDDStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(HDDStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPAbortSpec) DeepCopyInto(out *HTTPAbortSpec) {
 	*out = *in
@@ -1853,25 +2151,10 @@ func (in *HTTPDelaySpec) DeepCopy() *HTTPDelaySpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPRequestSpec) DeepCopyInto(out *HTTPRequestSpec) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestSpec.
-func (in *HTTPRequestSpec) DeepCopy() *HTTPRequestSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(HTTPRequestSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPStatusCheck) DeepCopyInto(out *HTTPStatusCheck) {
+func (in *HTTPRequestNodeSpec) DeepCopyInto(out *HTTPRequestNodeSpec) {
 	*out = *in
-	if in.RequestHeaders != nil {
-		in, out := &in.RequestHeaders, &out.RequestHeaders
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
 		*out = make(http.Header, len(*in))
 		for key, val := range *in {
 			var outVal []string
@@ -1886,15 +2169,127 @@ func (in *HTTPStatusCheck) DeepCopyInto(out *HTTPStatusCheck) {
 			(*out)[key] = outVal
 		}
 	}
-	out.Criteria = in.Criteria
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(HTTPRequestTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Criteria != nil {
+		in, out := &in.Criteria, &out.Criteria
+		*out = new(HTTPCriteria)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPStatusCheck.
-func (in *HTTPStatusCheck) DeepCopy() *HTTPStatusCheck {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestNodeSpec.
+func (in *HTTPRequestNodeSpec) DeepCopy() *HTTPRequestNodeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPStatusCheck)
+	out := new(HTTPRequestNodeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestSpec) DeepCopyInto(out *HTTPRequestSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestSpec.
+func (in *HTTPRequestSpec) DeepCopy() *HTTPRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestTLS) DeepCopyInto(out *HTTPRequestTLS) {
+	*out = *in
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestTLS.
+func (in *HTTPRequestTLS) DeepCopy() *HTTPRequestTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPResponseStatus) DeepCopyInto(out *HTTPResponseStatus) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(http.Header, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPResponseStatus.
+func (in *HTTPResponseStatus) DeepCopy() *HTTPResponseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPResponseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPStatusCheck) DeepCopyInto(out *HTTPStatusCheck) {
+	*out = *in
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = make(http.Header, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	out.Criteria = in.Criteria
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPStatusCheck.
+func (in *HTTPStatusCheck) DeepCopy() *HTTPStatusCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPStatusCheck)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1945,6 +2340,11 @@ func (in *IOChaosAction) DeepCopyInto(out *IOChaosAction) {
 		*out = new(MistakeSpec)
 		**out = **in
 	}
+	if in.ShortRWSpec != nil {
+		in, out := &in.ShortRWSpec, &out.ShortRWSpec
+		*out = new(ShortRWSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOChaosAction.
@@ -2003,6 +2403,16 @@ func (in *IOChaosSpec) DeepCopyInto(out *IOChaosSpec) {
 		*out = new(MistakeSpec)
 		**out = **in
 	}
+	if in.ShortRW != nil {
+		in, out := &in.ShortRW, &out.ShortRW
+		*out = new(ShortRWSpec)
+		**out = **in
+	}
+	if in.DiskFill != nil {
+		in, out := &in.DiskFill, &out.DiskFill
+		*out = new(DiskFillVolumeSpec)
+		**out = **in
+	}
 	if in.Methods != nil {
 		in, out := &in.Methods, &out.Methods
 		*out = make([]IoMethod, len(*in))
@@ -2048,6 +2458,22 @@ func (in *IOChaosStatus) DeepCopy() *IOChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOMixStressor) DeepCopyInto(out *IOMixStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOMixStressor.
+func (in *IOMixStressor) DeepCopy() *IOMixStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(IOMixStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IoFault) DeepCopyInto(out *IoFault) {
 	*out = *in
@@ -2090,6 +2516,30 @@ func (in *JVMChaos) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JVMChaosInstance) DeepCopyInto(out *JVMChaosInstance) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]JVMRuleStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstallTime != nil {
+		in, out := &in.InstallTime, &out.InstallTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JVMChaosInstance.
+func (in *JVMChaosInstance) DeepCopy() *JVMChaosInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(JVMChaosInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JVMChaosList) DeepCopyInto(out *JVMChaosList) {
 	*out = *in
@@ -2148,6 +2598,13 @@ func (in *JVMChaosSpec) DeepCopy() *JVMChaosSpec {
 func (in *JVMChaosStatus) DeepCopyInto(out *JVMChaosStatus) {
 	*out = *in
 	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]JVMChaosInstance, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JVMChaosStatus.
@@ -2223,6 +2680,36 @@ func (in *JVMGCSpec) DeepCopy() *JVMGCSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JVMHTTPClientSpec) DeepCopyInto(out *JVMHTTPClientSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JVMHTTPClientSpec.
+func (in *JVMHTTPClientSpec) DeepCopy() *JVMHTTPClientSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JVMHTTPClientSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JVMKafkaSpec) DeepCopyInto(out *JVMKafkaSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JVMKafkaSpec.
+func (in *JVMKafkaSpec) DeepCopy() *JVMKafkaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JVMKafkaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JVMLatencySpec) DeepCopyInto(out *JVMLatencySpec) {
 	*out = *in
@@ -2262,6 +2749,10 @@ func (in *JVMParameter) DeepCopyInto(out *JVMParameter) {
 	out.JVMClassMethodSpec = in.JVMClassMethodSpec
 	out.JVMStressCfgSpec = in.JVMStressCfgSpec
 	out.JVMMySQLSpec = in.JVMMySQLSpec
+	out.JVMThreadPoolSpec = in.JVMThreadPoolSpec
+	out.JVMKafkaSpec = in.JVMKafkaSpec
+	out.JVMRedisSpec = in.JVMRedisSpec
+	out.JVMHTTPClientSpec = in.JVMHTTPClientSpec
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JVMParameter.
@@ -2274,6 +2765,21 @@ func (in *JVMParameter) DeepCopy() *JVMParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JVMRedisSpec) DeepCopyInto(out *JVMRedisSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JVMRedisSpec.
+func (in *JVMRedisSpec) DeepCopy() *JVMRedisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JVMRedisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JVMReturnSpec) DeepCopyInto(out *JVMReturnSpec) {
 	*out = *in
@@ -2307,6 +2813,21 @@ func (in *JVMRuleDataSpec) DeepCopy() *JVMRuleDataSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JVMRuleStatus) DeepCopyInto(out *JVMRuleStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JVMRuleStatus.
+func (in *JVMRuleStatus) DeepCopy() *JVMRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JVMRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JVMStressCfgSpec) DeepCopyInto(out *JVMStressCfgSpec) {
 	*out = *in
@@ -2338,6 +2859,124 @@ func (in *JVMStressSpec) DeepCopy() *JVMStressSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JVMThreadPoolSpec) DeepCopyInto(out *JVMThreadPoolSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JVMThreadPoolSpec.
+func (in *JVMThreadPoolSpec) DeepCopy() *JVMThreadPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JVMThreadPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8sApplySpec) DeepCopyInto(out *K8sApplySpec) {
+	*out = *in
+	if in.Patch != nil {
+		in, out := &in.Patch, &out.Patch
+		*out = new(K8sPatch)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sApplySpec.
+func (in *K8sApplySpec) DeepCopy() *K8sApplySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sApplySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8sApplyStatus) DeepCopyInto(out *K8sApplyStatus) {
+	*out = *in
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sApplyStatus.
+func (in *K8sApplyStatus) DeepCopy() *K8sApplyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sApplyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8sPatch) DeepCopyInto(out *K8sPatch) {
+	*out = *in
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sPatch.
+func (in *K8sPatch) DeepCopy() *K8sPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8sResourceReference) DeepCopyInto(out *K8sResourceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sResourceReference.
+func (in *K8sResourceReference) DeepCopy() *K8sResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8sWaitSpec) DeepCopyInto(out *K8sWaitSpec) {
+	*out = *in
+	out.Target = in.Target
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sWaitSpec.
+func (in *K8sWaitSpec) DeepCopy() *K8sWaitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sWaitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8sWaitStatus) DeepCopyInto(out *K8sWaitStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sWaitStatus.
+func (in *K8sWaitStatus) DeepCopy() *K8sWaitStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sWaitStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaCommonSpec) DeepCopyInto(out *KafkaCommonSpec) {
 	*out = *in
@@ -2497,6 +3136,21 @@ func (in *KernelChaosStatus) DeepCopy() *KernelChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelFaultPreset) DeepCopyInto(out *KernelFaultPreset) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelFaultPreset.
+func (in *KernelFaultPreset) DeepCopy() *KernelFaultPreset {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelFaultPreset)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in LabelSelectorRequirements) DeepCopyInto(out *LabelSelectorRequirements) {
 	{
@@ -2518,6 +3172,46 @@ func (in LabelSelectorRequirements) DeepCopy() LabelSelectorRequirements {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoopIteration) DeepCopyInto(out *LoopIteration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoopIteration.
+func (in *LoopIteration) DeepCopy() *LoopIteration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoopIteration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoopSpec) DeepCopyInto(out *LoopSpec) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int)
+		**out = **in
+	}
+	if in.WithItems != nil {
+		in, out := &in.WithItems, &out.WithItems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoopSpec.
+func (in *LoopSpec) DeepCopy() *LoopSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoopSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LossSpec) DeepCopyInto(out *LossSpec) {
 	*out = *in
@@ -2713,136 +3407,317 @@ func (in *NetworkCommonSpec) DeepCopyInto(out *NetworkCommonSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkCommonSpec.
-func (in *NetworkCommonSpec) DeepCopy() *NetworkCommonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkCommonSpec.
+func (in *NetworkCommonSpec) DeepCopy() *NetworkCommonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkCommonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkCorruptSpec) DeepCopyInto(out *NetworkCorruptSpec) {
+	*out = *in
+	out.NetworkCommonSpec = in.NetworkCommonSpec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkCorruptSpec.
+func (in *NetworkCorruptSpec) DeepCopy() *NetworkCorruptSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkCorruptSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkDNSSpec) DeepCopyInto(out *NetworkDNSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkDNSSpec.
+func (in *NetworkDNSSpec) DeepCopy() *NetworkDNSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDNSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkDelaySpec) DeepCopyInto(out *NetworkDelaySpec) {
+	*out = *in
+	out.NetworkCommonSpec = in.NetworkCommonSpec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkDelaySpec.
+func (in *NetworkDelaySpec) DeepCopy() *NetworkDelaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDelaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkDownSpec) DeepCopyInto(out *NetworkDownSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkDownSpec.
+func (in *NetworkDownSpec) DeepCopy() *NetworkDownSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDownSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkDuplicateSpec) DeepCopyInto(out *NetworkDuplicateSpec) {
+	*out = *in
+	out.NetworkCommonSpec = in.NetworkCommonSpec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkDuplicateSpec.
+func (in *NetworkDuplicateSpec) DeepCopy() *NetworkDuplicateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDuplicateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkFloodSpec) DeepCopyInto(out *NetworkFloodSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkFloodSpec.
+func (in *NetworkFloodSpec) DeepCopy() *NetworkFloodSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkFloodSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkLossSpec) DeepCopyInto(out *NetworkLossSpec) {
+	*out = *in
+	out.NetworkCommonSpec = in.NetworkCommonSpec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkLossSpec.
+func (in *NetworkLossSpec) DeepCopy() *NetworkLossSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkCommonSpec)
+	out := new(NetworkLossSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkCorruptSpec) DeepCopyInto(out *NetworkCorruptSpec) {
+func (in *NetworkPartitionSpec) DeepCopyInto(out *NetworkPartitionSpec) {
 	*out = *in
-	out.NetworkCommonSpec = in.NetworkCommonSpec
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkCorruptSpec.
-func (in *NetworkCorruptSpec) DeepCopy() *NetworkCorruptSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPartitionSpec.
+func (in *NetworkPartitionSpec) DeepCopy() *NetworkPartitionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkCorruptSpec)
+	out := new(NetworkPartitionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkDNSSpec) DeepCopyInto(out *NetworkDNSSpec) {
+func (in *NodeChaos) DeepCopyInto(out *NodeChaos) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkDNSSpec.
-func (in *NetworkDNSSpec) DeepCopy() *NetworkDNSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeChaos.
+func (in *NodeChaos) DeepCopy() *NodeChaos {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkDNSSpec)
+	out := new(NodeChaos)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkDelaySpec) DeepCopyInto(out *NetworkDelaySpec) {
+func (in *NodeChaosList) DeepCopyInto(out *NodeChaosList) {
 	*out = *in
-	out.NetworkCommonSpec = in.NetworkCommonSpec
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkDelaySpec.
-func (in *NetworkDelaySpec) DeepCopy() *NetworkDelaySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeChaosList.
+func (in *NodeChaosList) DeepCopy() *NodeChaosList {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkDelaySpec)
+	out := new(NodeChaosList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkDownSpec) DeepCopyInto(out *NetworkDownSpec) {
+func (in *NodeChaosSpec) DeepCopyInto(out *NodeChaosSpec) {
 	*out = *in
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	if in.Taint != nil {
+		in, out := &in.Taint, &out.Taint
+		*out = new(v1.Taint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkDownSpec.
-func (in *NetworkDownSpec) DeepCopy() *NetworkDownSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeChaosSpec.
+func (in *NodeChaosSpec) DeepCopy() *NodeChaosSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkDownSpec)
+	out := new(NodeChaosSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkDuplicateSpec) DeepCopyInto(out *NetworkDuplicateSpec) {
+func (in *NodeChaosStatus) DeepCopyInto(out *NodeChaosStatus) {
 	*out = *in
-	out.NetworkCommonSpec = in.NetworkCommonSpec
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkDuplicateSpec.
-func (in *NetworkDuplicateSpec) DeepCopy() *NetworkDuplicateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeChaosStatus.
+func (in *NodeChaosStatus) DeepCopy() *NodeChaosStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkDuplicateSpec)
+	out := new(NodeChaosStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkFloodSpec) DeepCopyInto(out *NetworkFloodSpec) {
+func (in *NodeOutput) DeepCopyInto(out *NodeOutput) {
 	*out = *in
+	if in.StdoutField != nil {
+		in, out := &in.StdoutField, &out.StdoutField
+		*out = new(string)
+		**out = **in
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkFloodSpec.
-func (in *NetworkFloodSpec) DeepCopy() *NetworkFloodSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeOutput.
+func (in *NodeOutput) DeepCopy() *NodeOutput {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkFloodSpec)
+	out := new(NodeOutput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkLossSpec) DeepCopyInto(out *NetworkLossSpec) {
+func (in *NodeSelector) DeepCopyInto(out *NodeSelector) {
 	*out = *in
-	out.NetworkCommonSpec = in.NetworkCommonSpec
+	in.Selector.DeepCopyInto(&out.Selector)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkLossSpec.
-func (in *NetworkLossSpec) DeepCopy() *NetworkLossSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSelector.
+func (in *NodeSelector) DeepCopy() *NodeSelector {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkLossSpec)
+	out := new(NodeSelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkPartitionSpec) DeepCopyInto(out *NetworkPartitionSpec) {
+func (in *NodeSelectorSpec) DeepCopyInto(out *NodeSelectorSpec) {
 	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelSelectors != nil {
+		in, out := &in.LabelSelectors, &out.LabelSelectors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExpressionSelectors != nil {
+		in, out := &in.ExpressionSelectors, &out.ExpressionSelectors
+		*out = make(LabelSelectorRequirements, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPartitionSpec.
-func (in *NetworkPartitionSpec) DeepCopy() *NetworkPartitionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSelectorSpec.
+func (in *NodeSelectorSpec) DeepCopy() *NodeSelectorSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkPartitionSpec)
+	out := new(NodeSelectorSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3087,6 +3962,22 @@ func (in *PhysicalMachineSpec) DeepCopy() *PhysicalMachineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipeStressor) DeepCopyInto(out *PipeStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipeStressor.
+func (in *PipeStressor) DeepCopy() *PipeStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(PipeStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodChaos) DeepCopyInto(out *PodChaos) {
 	*out = *in
@@ -3155,6 +4046,11 @@ func (in *PodChaosSpec) DeepCopyInto(out *PodChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CrashLoopInterval != nil {
+		in, out := &in.CrashLoopInterval, &out.CrashLoopInterval
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosSpec.
@@ -3171,6 +4067,13 @@ func (in *PodChaosSpec) DeepCopy() *PodChaosSpec {
 func (in *PodChaosStatus) DeepCopyInto(out *PodChaosStatus) {
 	*out = *in
 	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.CrashLoops != nil {
+		in, out := &in.CrashLoops, &out.CrashLoops
+		*out = make(map[string]PodCrashLoopInstance, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosStatus.
@@ -3183,6 +4086,21 @@ func (in *PodChaosStatus) DeepCopy() *PodChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodCrashLoopInstance) DeepCopyInto(out *PodCrashLoopInstance) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodCrashLoopInstance.
+func (in *PodCrashLoopInstance) DeepCopy() *PodCrashLoopInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(PodCrashLoopInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodHttpChaos) DeepCopyInto(out *PodHttpChaos) {
 	*out = *in
@@ -4213,6 +5131,31 @@ func (in *ReorderSpec) DeepCopy() *ReorderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetrySpec) DeepCopyInto(out *RetrySpec) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetrySpec.
+func (in *RetrySpec) DeepCopy() *RetrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RetrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schedule) DeepCopyInto(out *Schedule) {
 	*out = *in
@@ -4335,6 +5278,37 @@ func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShortRWSpec) DeepCopyInto(out *ShortRWSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShortRWSpec.
+func (in *ShortRWSpec) DeepCopy() *ShortRWSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShortRWSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SocketStressor) DeepCopyInto(out *SocketStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SocketStressor.
+func (in *SocketStressor) DeepCopy() *SocketStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(SocketStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatusCheck) DeepCopyInto(out *StatusCheck) {
 	*out = *in
@@ -4633,6 +5607,11 @@ func (in *StressInstance) DeepCopyInto(out *StressInstance) {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Stressors != nil {
+		in, out := &in.Stressors, &out.Stressors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.MemoryStartTime != nil {
 		in, out := &in.MemoryStartTime, &out.MemoryStartTime
 		*out = (*in).DeepCopy()
@@ -4644,67 +5623,167 @@ func (in *StressInstance) DeepCopy() *StressInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(StressInstance)
+	out := new(StressInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StressMemorySpec) DeepCopyInto(out *StressMemorySpec) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StressMemorySpec.
+func (in *StressMemorySpec) DeepCopy() *StressMemorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StressMemorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Stressor) DeepCopyInto(out *Stressor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stressor.
+func (in *Stressor) DeepCopy() *Stressor {
+	if in == nil {
+		return nil
+	}
+	out := new(Stressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Stressors) DeepCopyInto(out *Stressors) {
+	*out = *in
+	if in.MemoryStressor != nil {
+		in, out := &in.MemoryStressor, &out.MemoryStressor
+		*out = new(MemoryStressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CPUStressor != nil {
+		in, out := &in.CPUStressor, &out.CPUStressor
+		*out = new(CPUStressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HDDStressor != nil {
+		in, out := &in.HDDStressor, &out.HDDStressor
+		*out = new(HDDStressor)
+		**out = **in
+	}
+	if in.IOMixStressor != nil {
+		in, out := &in.IOMixStressor, &out.IOMixStressor
+		*out = new(IOMixStressor)
+		**out = **in
+	}
+	if in.SocketStressor != nil {
+		in, out := &in.SocketStressor, &out.SocketStressor
+		*out = new(SocketStressor)
+		**out = **in
+	}
+	if in.ForkStressor != nil {
+		in, out := &in.ForkStressor, &out.ForkStressor
+		*out = new(ForkStressor)
+		**out = **in
+	}
+	if in.PipeStressor != nil {
+		in, out := &in.PipeStressor, &out.PipeStressor
+		*out = new(PipeStressor)
+		**out = **in
+	}
+	if in.CacheStressor != nil {
+		in, out := &in.CacheStressor, &out.CacheStressor
+		*out = new(CacheStressor)
+		**out = **in
+	}
+	if in.VMStressor != nil {
+		in, out := &in.VMStressor, &out.VMStressor
+		*out = new(VMStressor)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stressors.
+func (in *Stressors) DeepCopy() *Stressors {
+	if in == nil {
+		return nil
+	}
+	out := new(Stressors)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StressMemorySpec) DeepCopyInto(out *StressMemorySpec) {
+func (in *SubWorkflowReference) DeepCopyInto(out *SubWorkflowReference) {
 	*out = *in
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StressMemorySpec.
-func (in *StressMemorySpec) DeepCopy() *StressMemorySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubWorkflowReference.
+func (in *SubWorkflowReference) DeepCopy() *SubWorkflowReference {
 	if in == nil {
 		return nil
 	}
-	out := new(StressMemorySpec)
+	out := new(SubWorkflowReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Stressor) DeepCopyInto(out *Stressor) {
+func (in *SubWorkflowSpec) DeepCopyInto(out *SubWorkflowSpec) {
 	*out = *in
+	if in.Workflow != nil {
+		in, out := &in.Workflow, &out.Workflow
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkflowRef != nil {
+		in, out := &in.WorkflowRef, &out.WorkflowRef
+		*out = new(SubWorkflowReference)
+		**out = **in
+	}
+	if in.WorkflowTemplateRef != nil {
+		in, out := &in.WorkflowTemplateRef, &out.WorkflowTemplateRef
+		*out = new(WorkflowTemplateRef)
+		**out = **in
+	}
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make([]WorkflowArgument, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stressor.
-func (in *Stressor) DeepCopy() *Stressor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubWorkflowSpec.
+func (in *SubWorkflowSpec) DeepCopy() *SubWorkflowSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Stressor)
+	out := new(SubWorkflowSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Stressors) DeepCopyInto(out *Stressors) {
+func (in *SubWorkflowStatus) DeepCopyInto(out *SubWorkflowStatus) {
 	*out = *in
-	if in.MemoryStressor != nil {
-		in, out := &in.MemoryStressor, &out.MemoryStressor
-		*out = new(MemoryStressor)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CPUStressor != nil {
-		in, out := &in.CPUStressor, &out.CPUStressor
-		*out = new(CPUStressor)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stressors.
-func (in *Stressors) DeepCopy() *Stressors {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubWorkflowStatus.
+func (in *SubWorkflowStatus) DeepCopy() *SubWorkflowStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Stressors)
+	out := new(SubWorkflowStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4826,6 +5905,53 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(StatusCheckSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(LoopSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DAG != nil {
+		in, out := &in.DAG, &out.DAG
+		*out = new(DAGSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRequest != nil {
+		in, out := &in.HTTPRequest, &out.HTTPRequest
+		*out = new(HTTPRequestNodeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.K8sApply != nil {
+		in, out := &in.K8sApply, &out.K8sApply
+		*out = new(K8sApplySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.K8sWait != nil {
+		in, out := &in.K8sWait, &out.K8sWait
+		*out = new(K8sWaitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubWorkflow != nil {
+		in, out := &in.SubWorkflow, &out.SubWorkflow
+		*out = new(SubWorkflowSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]NodeOutput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Template.
@@ -4927,6 +6053,13 @@ func (in *TimeChaosSpec) DeepCopy() *TimeChaosSpec {
 func (in *TimeChaosStatus) DeepCopyInto(out *TimeChaosStatus) {
 	*out = *in
 	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]TimeSkewInstance, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeChaosStatus.
@@ -4939,6 +6072,29 @@ func (in *TimeChaosStatus) DeepCopy() *TimeChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeSkewInstance) DeepCopyInto(out *TimeSkewInstance) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ObservedTime != nil {
+		in, out := &in.ObservedTime, &out.ObservedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeSkewInstance.
+func (in *TimeSkewInstance) DeepCopy() *TimeSkewInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeSkewInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timespec) DeepCopyInto(out *Timespec) {
 	*out = *in
@@ -4984,6 +6140,22 @@ func (in *VMSpec) DeepCopy() *VMSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMStressor) DeepCopyInto(out *VMStressor) {
+	*out = *in
+	out.Stressor = in.Stressor
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStressor.
+func (in *VMStressor) DeepCopy() *VMStressor {
+	if in == nil {
+		return nil
+	}
+	out := new(VMStressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workflow) DeepCopyInto(out *Workflow) {
 	*out = *in
@@ -5011,6 +6183,21 @@ func (in *Workflow) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowArgument) DeepCopyInto(out *WorkflowArgument) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowArgument.
+func (in *WorkflowArgument) DeepCopy() *WorkflowArgument {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowArgument)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowCondition) DeepCopyInto(out *WorkflowCondition) {
 	*out = *in
@@ -5177,6 +6364,58 @@ func (in *WorkflowNodeSpec) DeepCopyInto(out *WorkflowNodeSpec) {
 		*out = new(StatusCheckSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(LoopSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DAG != nil {
+		in, out := &in.DAG, &out.DAG
+		*out = new(DAGSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRequest != nil {
+		in, out := &in.HTTPRequest, &out.HTTPRequest
+		*out = new(HTTPRequestNodeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.K8sApply != nil {
+		in, out := &in.K8sApply, &out.K8sApply
+		*out = new(K8sApplySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.K8sWait != nil {
+		in, out := &in.K8sWait, &out.K8sWait
+		*out = new(K8sWaitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubWorkflow != nil {
+		in, out := &in.SubWorkflow, &out.SubWorkflow
+		*out = new(SubWorkflowSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]NodeOutput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Iteration != nil {
+		in, out := &in.Iteration, &out.Iteration
+		*out = new(LoopIteration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowNodeSpec.
@@ -5212,6 +6451,52 @@ func (in *WorkflowNodeStatus) DeepCopyInto(out *WorkflowNodeStatus) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PostponedDuration != nil {
+		in, out := &in.PostponedDuration, &out.PostponedDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DAGTasks != nil {
+		in, out := &in.DAGTasks, &out.DAGTasks
+		*out = make([]DAGTaskStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPResponse != nil {
+		in, out := &in.HTTPResponse, &out.HTTPResponse
+		*out = new(HTTPResponseStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.K8sApply != nil {
+		in, out := &in.K8sApply, &out.K8sApply
+		*out = new(K8sApplyStatus)
+		**out = **in
+	}
+	if in.K8sWait != nil {
+		in, out := &in.K8sWait, &out.K8sWait
+		*out = new(K8sWaitStatus)
+		**out = **in
+	}
+	if in.SubWorkflow != nil {
+		in, out := &in.SubWorkflow, &out.SubWorkflow
+		*out = new(SubWorkflowStatus)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]WorkflowNodeCondition, len(*in))
@@ -5229,6 +6514,26 @@ func (in *WorkflowNodeStatus) DeepCopy() *WorkflowNodeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowParameter) DeepCopyInto(out *WorkflowParameter) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowParameter.
+func (in *WorkflowParameter) DeepCopy() *WorkflowParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 	*out = *in
@@ -5239,6 +6544,16 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WorkflowTemplateRef != nil {
+		in, out := &in.WorkflowTemplateRef, &out.WorkflowTemplateRef
+		*out = new(WorkflowTemplateRef)
+		**out = **in
+	}
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make([]WorkflowArgument, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
@@ -5267,6 +6582,11 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 		in, out := &in.EndTime, &out.EndTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ExitHandlerNodes != nil {
+		in, out := &in.ExitHandlerNodes, &out.ExitHandlerNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]WorkflowCondition, len(*in))
@@ -5285,3 +6605,105 @@ func (in *WorkflowStatus) DeepCopy() *WorkflowStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplate) DeepCopyInto(out *WorkflowTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplate.
+func (in *WorkflowTemplate) DeepCopy() *WorkflowTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateList) DeepCopyInto(out *WorkflowTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateList.
+func (in *WorkflowTemplateList) DeepCopy() *WorkflowTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateRef) DeepCopyInto(out *WorkflowTemplateRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateRef.
+func (in *WorkflowTemplateRef) DeepCopy() *WorkflowTemplateRef {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateSpec) DeepCopyInto(out *WorkflowTemplateSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]WorkflowParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]Template, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateSpec.
+func (in *WorkflowTemplateSpec) DeepCopy() *WorkflowTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -30,6 +30,14 @@ const (
 	TypeSuspend TemplateType = "Suspend"
 	TypeStatusCheck TemplateType = "StatusCheck"
 	TypeSchedule TemplateType = "Schedule"
+	TypeLoop TemplateType = "Loop"
+	TypeRetry TemplateType = "Retry"
+	TypeApproval TemplateType = "Approval"
+	TypeDAG TemplateType = "DAG"
+	TypeHTTPRequest TemplateType = "HTTPRequest"
+	TypeK8sApply TemplateType = "K8sApply"
+	TypeK8sWait TemplateType = "K8sWait"
+	TypeSubWorkflow TemplateType = "SubWorkflow"
 	TypeAWSChaos TemplateType = "AWSChaos"
 	TypeAzureChaos TemplateType = "AzureChaos"
 	TypeBlockChaos TemplateType = "BlockChaos"
@@ -40,6 +48,7 @@ const (
 	TypeJVMChaos TemplateType = "JVMChaos"
 	TypeKernelChaos TemplateType = "KernelChaos"
 	TypeNetworkChaos TemplateType = "NetworkChaos"
+	TypeNodeChaos TemplateType = "NodeChaos"
 	TypePhysicalMachineChaos TemplateType = "PhysicalMachineChaos"
 	TypePodChaos TemplateType = "PodChaos"
 	TypeStressChaos TemplateType = "StressChaos"
@@ -59,6 +68,7 @@ var allChaosTemplateType = []TemplateType{
 	TypeJVMChaos,
 	TypeKernelChaos,
 	TypeNetworkChaos,
+	TypeNodeChaos,
 	TypePhysicalMachineChaos,
 	TypePodChaos,
 	TypeStressChaos,
@@ -88,6 +98,8 @@ type EmbedChaos struct {
 	// +optional
 	NetworkChaos *NetworkChaosSpec `json:"networkChaos,omitempty"`
 	// +optional
+	NodeChaos *NodeChaosSpec `json:"nodeChaos,omitempty"`
+	// +optional
 	PhysicalMachineChaos *PhysicalMachineChaosSpec `json:"physicalmachineChaos,omitempty"`
 	// +optional
 	PodChaos *PodChaosSpec `json:"podChaos,omitempty"`
@@ -140,6 +152,10 @@ func (it *EmbedChaos) SpawnNewObject(templateType TemplateType) (GenericChaos, e
 		result := NetworkChaos{}
 		result.Spec = *it.NetworkChaos
 		return &result, nil
+	case TypeNodeChaos:
+		result := NodeChaos{}
+		result.Spec = *it.NodeChaos
+		return &result, nil
 	case TypePhysicalMachineChaos:
 		result := PhysicalMachineChaos{}
 		result.Spec = *it.PhysicalMachineChaos
@@ -194,6 +210,9 @@ func (it *EmbedChaos) RestoreChaosSpec(root interface{}) error {
 	case *NetworkChaos:
 		*it.NetworkChaos = chaos.Spec
 		return nil
+	case *NodeChaos:
+		*it.NodeChaos = chaos.Spec
+		return nil
 	case *PhysicalMachineChaos:
 		*it.PhysicalMachineChaos = chaos.Spec
 		return nil
@@ -244,6 +263,9 @@ func (it *EmbedChaos) SpawnNewList(templateType TemplateType) (GenericChaosList,
 	case TypeNetworkChaos:
 		result := NetworkChaosList{}
 		return &result, nil
+	case TypeNodeChaos:
+		result := NodeChaosList{}
+		return &result, nil
 	case TypePhysicalMachineChaos:
 		result := PhysicalMachineChaosList{}
 		return &result, nil
@@ -342,6 +364,14 @@ func (in *NetworkChaosList) GetItems() []GenericChaos {
 	}
 	return result
 }
+func (in *NodeChaosList) GetItems() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
 func (in *PhysicalMachineChaosList) GetItems() []GenericChaos {
 	var result []GenericChaos
 	for _, item := range in.Items {
@@ -89,6 +89,14 @@ const (
 	TypeSuspend TemplateType = "Suspend"
 	TypeStatusCheck TemplateType = "StatusCheck"
 	TypeSchedule TemplateType = "Schedule"
+	TypeLoop TemplateType = "Loop"
+	TypeRetry TemplateType = "Retry"
+	TypeApproval TemplateType = "Approval"
+	TypeDAG TemplateType = "DAG"
+	TypeHTTPRequest TemplateType = "HTTPRequest"
+	TypeK8sApply TemplateType = "K8sApply"
+	TypeK8sWait TemplateType = "K8sWait"
+	TypeSubWorkflow TemplateType = "SubWorkflow"
 %s
 )
 
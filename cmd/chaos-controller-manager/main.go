@@ -163,10 +163,35 @@ func Run(params RunParams) error {
 	}
 
 	if ccfg.ShouldStartWebhook("workflow") {
+		// the workflow webhook instantiates the referred WorkflowTemplate, so it reads from the apiserver directly
+		workflowWebhook := &v1alpha1.WorkflowWebhook{Reader: mgr.GetAPIReader()}
 		err = ctrl.NewWebhookManagedBy(mgr).
 			For(&v1alpha1.Workflow{}).
-			WithValidator(&v1alpha1.Workflow{}).
-			WithDefaulter(&v1alpha1.Workflow{}).
+			WithValidator(workflowWebhook).
+			WithDefaulter(workflowWebhook).
+			Complete()
+		if err != nil {
+			return err
+		}
+	}
+
+	if ccfg.ShouldStartWebhook("workflowtemplate") {
+		err = ctrl.NewWebhookManagedBy(mgr).
+			For(&v1alpha1.WorkflowTemplate{}).
+			WithValidator(&v1alpha1.WorkflowTemplate{}).
+			WithDefaulter(&v1alpha1.WorkflowTemplate{}).
+			Complete()
+		if err != nil {
+			return err
+		}
+	}
+
+	if ccfg.ShouldStartWebhook("workflownode") {
+		// the webhook records the user who made the decision on the approval node
+		err = ctrl.NewWebhookManagedBy(mgr).
+			For(&v1alpha1.WorkflowNode{}).
+			WithValidator(&v1alpha1.WorkflowNode{}).
+			WithDefaulter(&v1alpha1.WorkflowNode{}).
 			Complete()
 		if err != nil {
 			return err
@@ -188,7 +213,7 @@ func Run(params RunParams) error {
 	}
 
 	hookServer.Register("/validate-auth", &webhook.Admission{
-		Handler: apiWebhook.NewAuthValidator(ccfg.ControllerCfg.SecurityMode, authCli, mgr.GetScheme(),
+		Handler: apiWebhook.NewAuthValidator(ccfg.ControllerCfg.SecurityMode, authCli, mgr.GetRESTMapper(), mgr.GetScheme(),
 			ccfg.ControllerCfg.ClusterScoped, ccfg.ControllerCfg.TargetNamespace, ccfg.ControllerCfg.EnableFilterNamespace,
 			params.Logger.WithName("validate-auth"),
 		),
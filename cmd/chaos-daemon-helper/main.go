@@ -33,6 +33,9 @@ We can write these logic inside this helper, and execute them through nsexec.`,
 
 func main() {
 	rootCmd.AddCommand(helper.NormalizeVolumeNameCmd)
+	rootCmd.AddCommand(helper.FillDiskCmd)
+	rootCmd.AddCommand(helper.CleanDiskFillCmd)
+	rootCmd.AddCommand(helper.DNSServerCmd)
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -51,6 +51,7 @@ func init() {
 	flag.StringVar(&conf.Cert, "cert", "", "certificate of grpc server")
 	flag.StringVar(&conf.Key, "key", "", "key of grpc server")
 	flag.BoolVar(&conf.Profiling, "pprof", false, "enable pprof")
+	flag.StringVar(&conf.StateDir, "state-dir", "", "the directory to persist the state of long-running chaos, which is resumed after restart")
 
 	flag.Parse()
 }
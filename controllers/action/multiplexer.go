@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -30,7 +31,7 @@ import (
 
 // TODO: refactor this as a map[name]impltypes.ChaosImpl style, remove reflect usage.
 
-var _ impltypes.ChaosImpl = (*Multiplexer)(nil)
+var _ impltypes.ChaosImplWithRequeue = (*Multiplexer)(nil)
 
 // Multiplexer could combine ChaosImpl implementations into one, and route them by Action in the ChaosSpec.
 // Field impl should be a struct which contains several fields with struct tag "action", each field should be an implementation of ChaosImpl.
@@ -97,6 +98,24 @@ func (i *Multiplexer) callAccordingToAction(action, methodName string, defaultPh
 	return defaultPhase, NewErrorUnknownAction(gvk, action)
 }
 
+// implAccordingToAction returns the implementation of the action, or nil if the action is unknown
+func (i *Multiplexer) implAccordingToAction(action string) interface{} {
+	implType := reflect.TypeOf(i.impl).Elem()
+	implVal := reflect.ValueOf(i.impl)
+
+	for i := 0; i < implType.NumField(); i++ {
+		field := implType.Field(i)
+
+		actions := strings.Split(field.Tag.Get("action"), ",")
+		for i := range actions {
+			if actions[i] == action {
+				return implVal.Elem().FieldByIndex(field.Index).Interface()
+			}
+		}
+	}
+	return nil
+}
+
 type ErrorUnknownAction struct {
 	GroupVersionKind string
 	Action           string
@@ -123,6 +142,22 @@ func (i *Multiplexer) Recover(ctx context.Context, index int, records []*v1alpha
 	return i.callAccordingToAction(i.getAction(obj), "Recover", v1alpha1.Injected, ctx, index, records, obj)
 }
 
+// Refresh calls the Refresh of the implementation of the action, if it's a ChaosImplWithRequeue
+func (i *Multiplexer) Refresh(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (bool, error) {
+	if impl, ok := i.implAccordingToAction(i.getAction(obj)).(impltypes.ChaosImplWithRequeue); ok {
+		return impl.Refresh(ctx, index, records, obj)
+	}
+	return false, nil
+}
+
+// RequeueAfter calls the RequeueAfter of the implementation of the action, if it's a ChaosImplWithRequeue
+func (i *Multiplexer) RequeueAfter(records []*v1alpha1.Record, obj v1alpha1.InnerObject) time.Duration {
+	if impl, ok := i.implAccordingToAction(i.getAction(obj)).(impltypes.ChaosImplWithRequeue); ok {
+		return impl.RequeueAfter(records, obj)
+	}
+	return 0
+}
+
 // NewMultiplexer is a constructor of Multiplexer.
 // For the detail of the parameter "impl", see the comment of type Multiplexer.
 func NewMultiplexer(impl interface{}) Multiplexer {
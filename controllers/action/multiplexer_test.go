@@ -18,6 +18,7 @@ package action
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -50,6 +51,49 @@ func (it *chaosImplMustFailed) Recover(ctx context.Context, index int, records [
 	return v1alpha1.Injected, mockRecoverError{}
 }
 
+type chaosImplWithRequeue struct {
+	chaosImplMustFailed
+}
+
+func (it *chaosImplWithRequeue) Refresh(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (bool, error) {
+	return true, nil
+}
+
+func (it *chaosImplWithRequeue) RequeueAfter(records []*v1alpha1.Record, obj v1alpha1.InnerObject) time.Duration {
+	return time.Second
+}
+
+func TestMultiplexer_passthroughsRequeue(t *testing.T) {
+	type adHoc struct {
+		Backend  *chaosImplWithRequeue `action:"requeue"`
+		Backend2 *chaosImplMustFailed  `action:"must-failed"`
+	}
+	multiplexer := NewMultiplexer(&adHoc{
+		Backend:  &chaosImplWithRequeue{},
+		Backend2: &chaosImplMustFailed{},
+	})
+
+	chaos := v1alpha1.PodChaos{
+		Spec: v1alpha1.PodChaosSpec{
+			Action: "requeue",
+		},
+	}
+	if changed, err := multiplexer.Refresh(context.Background(), 0, []*v1alpha1.Record{}, &chaos); !changed || err != nil {
+		t.Fatal("should refresh by the backend")
+	}
+	if multiplexer.RequeueAfter([]*v1alpha1.Record{}, &chaos) != time.Second {
+		t.Fatal("should requeue after the duration returned by the backend")
+	}
+
+	chaos.Spec.Action = "must-failed"
+	if changed, err := multiplexer.Refresh(context.Background(), 0, []*v1alpha1.Record{}, &chaos); changed || err != nil {
+		t.Fatal("should not refresh if the backend doesn't support it")
+	}
+	if multiplexer.RequeueAfter([]*v1alpha1.Record{}, &chaos) != 0 {
+		t.Fatal("should not requeue if the backend doesn't support it")
+	}
+}
+
 func TestMultiplexer_passthroughsError(t *testing.T) {
 	type adHoc struct {
 		Backend *chaosImplMustFailed `action:"must-failed"`
@@ -0,0 +1,128 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package blockchaos
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/config"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	pb_kernel "github.com/chaos-mesh/chaos-mesh/pkg/chaoskernel/pb"
+	grpcUtils "github.com/chaos-mesh/chaos-mesh/pkg/grpc"
+)
+
+// writeFailureRequest returns the bpfki request which fails the writes to the volume from the
+// processes of the container
+func writeFailureRequest(ctx context.Context, decodedContainer utils.DecodedContainerRecord, volumePath string, percent int) (*pb_kernel.FailKernRequest, error) {
+	device, err := decodedContainer.PbClient.GetBlockDevice(ctx, &pb.GetBlockDeviceRequest{
+		VolumePath: volumePath,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	containerResponse, err := decodedContainer.PbClient.ContainerGetPid(ctx, &pb.ContainerRequest{
+		Action: &pb.ContainerAction{
+			Action: pb.ContainerAction_GETPID,
+		},
+		ContainerId: decodedContainer.ContainerId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the bio failure doesn't hook any syscall, so it's the same on all architectures
+	request, err := (&v1alpha1.FailKernRequest{
+		Preset: &v1alpha1.KernelFaultPreset{
+			Type:   v1alpha1.BIOFailurePreset,
+			Device: fmt.Sprintf("%d:%d", device.Major, device.Minor),
+		},
+		Probability: uint32(percent),
+	}).Compile("")
+	if err != nil {
+		return nil, err
+	}
+
+	var callchain []*pb_kernel.FailKernRequestFrame
+	for _, frame := range request.Callchain {
+		callchain = append(callchain, &pb_kernel.FailKernRequestFrame{
+			Funcname:   frame.Funcname,
+			Parameters: frame.Parameters,
+			// only the bios writing to the device are failed
+			Predicate: frame.Predicate + " && op_is_write(bio_op(bio))",
+		})
+	}
+	return &pb_kernel.FailKernRequest{
+		Pid:         containerResponse.Pid,
+		Ftype:       pb_kernel.FailKernRequest_FAILTYPE(request.FailType),
+		Headers:     request.Headers,
+		Callchain:   callchain,
+		Probability: float32(request.Probability) / 100,
+		Times:       request.Times,
+	}, nil
+}
+
+// createBPFKIConnection creates a grpc connection with the bpfki on the node of the pod
+func (impl *Impl) createBPFKIConnection(ctx context.Context, pod *v1.Pod) (*grpc.ClientConn, error) {
+	daemonIP, err := impl.decoder.FindDaemonIP(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+	return grpcUtils.Builder(daemonIP, config.ControllerCfg.BPFKIPort).
+		WithDefaultTimeout().
+		Insecure().
+		Build()
+}
+
+// failWrites fails the writes to the volume through bpfki
+func (impl *Impl) failWrites(ctx context.Context, decodedContainer utils.DecodedContainerRecord, volumePath string, percent int) error {
+	request, err := writeFailureRequest(ctx, decodedContainer, volumePath, percent)
+	if err != nil {
+		return err
+	}
+
+	conn, err := impl.createBPFKIConnection(ctx, decodedContainer.Pod)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = pb_kernel.NewBPFKIServiceClient(conn).FailMMOrBIO(ctx, request)
+	return err
+}
+
+// recoverWrites stops failing the writes to the volume
+func (impl *Impl) recoverWrites(ctx context.Context, decodedContainer utils.DecodedContainerRecord, volumePath string) error {
+	request, err := writeFailureRequest(ctx, decodedContainer, volumePath, 0)
+	if err != nil {
+		return err
+	}
+
+	conn, err := impl.createBPFKIConnection(ctx, decodedContainer.Pod)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = pb_kernel.NewBPFKIServiceClient(conn).RecoverMMOrBIO(ctx, request)
+	return err
+}
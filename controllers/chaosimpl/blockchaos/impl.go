@@ -20,9 +20,13 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/docker/go-units"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"go.uber.org/fx"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
@@ -69,8 +73,13 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		return v1alpha1.Injected, nil
 	}
 
-	var res *pb.ApplyBlockChaosResponse
-	if blockchaos.Spec.Action == v1alpha1.BlockDelay {
+	req := &pb.ApplyBlockChaosRequest{
+		ContainerId: containerId,
+		VolumePath:  volumePath,
+		EnterNS:     true,
+	}
+	switch blockchaos.Spec.Action {
+	case v1alpha1.BlockDelay:
 		delay, err := time.ParseDuration(blockchaos.Spec.Delay.Latency)
 		if err != nil {
 			return v1alpha1.NotInjected, errors.Wrapf(err, "parse latency: %s", blockchaos.Spec.Delay.Latency)
@@ -86,26 +95,47 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 			return v1alpha1.NotInjected, errors.Wrapf(err, "parse jitter: %s", blockchaos.Spec.Delay.Jitter)
 		}
 
-		res, err = pbClient.ApplyBlockChaos(ctx, &pb.ApplyBlockChaosRequest{
-			ContainerId: containerId,
-			VolumePath:  volumePath,
-			Action:      pb.ApplyBlockChaosRequest_Delay,
-			Delay: &pb.BlockDelaySpec{
-				Delay:       delay.Nanoseconds(),
-				Correlation: corr,
-				Jitter:      jitter.Nanoseconds(),
-			},
-			EnterNS: true,
-		})
+		req.Action = pb.ApplyBlockChaosRequest_Delay
+		req.Delay = &pb.BlockDelaySpec{
+			Delay:       delay.Nanoseconds(),
+			Correlation: corr,
+			Jitter:      jitter.Nanoseconds(),
+		}
+	case v1alpha1.BlockError:
+		if blockchaos.Spec.Error.WriteOnly {
+			if err := impl.failWrites(ctx, decodedContainer, volumePath, blockchaos.Spec.Error.Percent); err != nil {
+				return v1alpha1.NotInjected, err
+			}
+			blockchaos.Status.InjectionIds[records[index].Id] = v1alpha1.BlockWriteErrorInjectionID
+			return v1alpha1.Injected, nil
+		}
 
+		req.Action = pb.ApplyBlockChaosRequest_Error
+		req.Error = &pb.BlockErrorSpec{
+			Percent: uint32(blockchaos.Spec.Error.Percent),
+		}
+	case v1alpha1.BlockLimit:
+		limit, err := convertBlockLimit(blockchaos.Spec.Limit)
 		if err != nil {
 			return v1alpha1.NotInjected, err
 		}
-	} else {
+
+		req.Action = pb.ApplyBlockChaosRequest_Limit
+		req.Limit = limit
+	default:
 		return v1alpha1.NotInjected, utils.ErrUnknownAction
 	}
 
+	res, err := pbClient.ApplyBlockChaos(ctx, req)
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
 	blockchaos.Status.InjectionIds[records[index].Id] = int(res.InjectionId)
+	if blockchaos.Status.NodeNames == nil {
+		blockchaos.Status.NodeNames = make(map[string]string)
+	}
+	blockchaos.Status.NodeNames[records[index].Id] = decodedContainer.Pod.Spec.NodeName
 
 	return v1alpha1.Injected, nil
 }
@@ -113,13 +143,27 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
 	impl.Log.Info("blockchaos recover", "record", records[index])
 
+	_, _, volumePath, err := controller.ParseNamespacedNameContainerVolumePath(records[index].Id)
+	if err != nil {
+		return v1alpha1.Injected, errors.Wrapf(err, "parse container and volumePath %s", records[index].Id)
+	}
+
 	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
 	pbClient := decodedContainer.PbClient
+	containerId := decodedContainer.ContainerId
 	if pbClient != nil {
 		defer pbClient.Close()
 	}
 	if err != nil {
 		if errors.Is(err, utils.ErrContainerNotFound) {
+			blockchaos := obj.(*v1alpha1.BlockChaos)
+			id, injected := blockchaos.Status.InjectionIds[records[index].Id]
+			nodeName, recorded := blockchaos.Status.NodeNames[records[index].Id]
+			if injected && recorded && blockchaos.Spec.Action == v1alpha1.BlockError && id <= v1alpha1.MaxBlockErrorInjectionID {
+				// the error injected by fail_make_request fails the volume on the whole node, so it's
+				// recovered by the injection id on the recorded node even if the pod has gone
+				return impl.recoverBlockError(ctx, records[index], blockchaos, nodeName, id)
+			}
 			// pretend the disappeared container has been recovered
 			return v1alpha1.NotInjected, nil
 		}
@@ -136,16 +180,93 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 		return v1alpha1.NotInjected, nil
 	}
 
+	if injection_id == v1alpha1.BlockWriteErrorInjectionID {
+		if err := impl.recoverWrites(ctx, decodedContainer, volumePath); err != nil {
+			return v1alpha1.Injected, err
+		}
+		delete(blockchaos.Status.InjectionIds, records[index].Id)
+		delete(blockchaos.Status.NodeNames, records[index].Id)
+		return v1alpha1.NotInjected, nil
+	}
+
+	action, ok := recoverActions[blockchaos.Spec.Action]
+	if !ok {
+		return v1alpha1.Injected, utils.ErrUnknownAction
+	}
 	if _, err = pbClient.RecoverBlockChaos(ctx, &pb.RecoverBlockChaosRequest{
 		InjectionId: int32(injection_id),
+		ContainerId: containerId,
+		VolumePath:  volumePath,
+		Action:      action,
 	}); err != nil {
 		// TODO: check whether the error still exists
 		return v1alpha1.Injected, err
 	}
 	delete(blockchaos.Status.InjectionIds, records[index].Id)
+	delete(blockchaos.Status.NodeNames, records[index].Id)
 	return v1alpha1.NotInjected, nil
 }
 
+// recoverBlockError recovers the error injected by fail_make_request through the chaos-daemon on the node
+func (impl *Impl) recoverBlockError(ctx context.Context, record *v1alpha1.Record, blockchaos *v1alpha1.BlockChaos, nodeName string, id int) (v1alpha1.Phase, error) {
+	pbClient, err := impl.decoder.BuildForNode(ctx, nodeName, &types.NamespacedName{
+		Namespace: blockchaos.Namespace,
+		Name:      blockchaos.Name,
+	})
+	if err != nil {
+		var node v1.Node
+		if getErr := impl.Get(ctx, types.NamespacedName{Name: nodeName}, &node); apierrors.IsNotFound(getErr) {
+			// the error has gone with the node
+			delete(blockchaos.Status.InjectionIds, record.Id)
+			delete(blockchaos.Status.NodeNames, record.Id)
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+	defer pbClient.Close()
+
+	if _, err = pbClient.RecoverBlockChaos(ctx, &pb.RecoverBlockChaosRequest{
+		InjectionId: int32(id),
+		Action:      pb.ApplyBlockChaosRequest_Error,
+	}); err != nil {
+		impl.Log.Error(err, "recover block error", "node", nodeName, "injectionId", id)
+		return v1alpha1.Injected, err
+	}
+	delete(blockchaos.Status.InjectionIds, record.Id)
+	delete(blockchaos.Status.NodeNames, record.Id)
+	return v1alpha1.NotInjected, nil
+}
+
+var recoverActions = map[v1alpha1.BlockChaosAction]pb.ApplyBlockChaosRequest_Action{
+	v1alpha1.BlockDelay: pb.ApplyBlockChaosRequest_Delay,
+	v1alpha1.BlockError: pb.ApplyBlockChaosRequest_Error,
+	v1alpha1.BlockLimit: pb.ApplyBlockChaosRequest_Limit,
+}
+
+func convertBlockLimit(spec *v1alpha1.BlockLimitSpec) (*pb.BlockLimitSpec, error) {
+	limit := &pb.BlockLimitSpec{
+		ReadIops:  uint64(spec.ReadIOPS),
+		WriteIops: uint64(spec.WriteIOPS),
+	}
+
+	if len(spec.ReadBytesPerSec) > 0 {
+		bps, err := units.FromHumanSize(spec.ReadBytesPerSec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse readBytesPerSec: %s", spec.ReadBytesPerSec)
+		}
+		limit.ReadBps = uint64(bps)
+	}
+	if len(spec.WriteBytesPerSec) > 0 {
+		bps, err := units.FromHumanSize(spec.WriteBytesPerSec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse writeBytesPerSec: %s", spec.WriteBytesPerSec)
+		}
+		limit.WriteBps = uint64(bps)
+	}
+
+	return limit, nil
+}
+
 func NewImpl(c client.Client, log logr.Logger, decoder *utils.ContainerRecordDecoder) *impltypes.ChaosImplPair {
 	return &impltypes.ChaosImplPair{
 		Name:   "blockchaos",
@@ -0,0 +1,130 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package dnschaos
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/config"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// useChaosDNSServer returns whether the action is served by the chaos DNS server
+// deployed with chaos mesh, rather than chaos-daemon
+func useChaosDNSServer(action v1alpha1.DNSChaosAction) bool {
+	return action.IsServedByChaosDNSServer() && len(config.ControllerCfg.DNSServiceName) != 0
+}
+
+// applyDaemonDNSChaos starts a chaos DNS server inside the network namespace of
+// the container through chaos-daemon, and redirects the DNS queries of the
+// container to it. The resolv.conf of the container is left untouched.
+func (impl *Impl) applyDaemonDNSChaos(ctx context.Context, record *v1alpha1.Record, dnschaos *v1alpha1.DNSChaos, decodedContainer utils.DecodedContainerRecord) (v1alpha1.Phase, error) {
+	if dnschaos.Status.Instances == nil {
+		dnschaos.Status.Instances = make(map[string]v1alpha1.DNSChaosInstance)
+	}
+	if _, ok := dnschaos.Status.Instances[record.Id]; ok {
+		impl.Log.Info("a chaos dns server is running for this container")
+		return v1alpha1.Injected, nil
+	}
+
+	rules, err := daemonDNSChaosRules(&dnschaos.Spec)
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	res, err := decodedContainer.PbClient.ApplyDNSChaos(ctx, &pb.ApplyDNSChaosRequest{
+		ContainerId: decodedContainer.ContainerId,
+		Rules:       rules,
+		EnterNS:     true,
+	})
+	if err != nil {
+		impl.Log.Error(err, "apply dns chaos")
+		return v1alpha1.NotInjected, err
+	}
+
+	dnschaos.Status.Instances[record.Id] = v1alpha1.DNSChaosInstance{
+		UID:         res.Instance,
+		InstanceUID: res.InstanceUid,
+		StartTime: &metav1.Time{
+			Time: time.Unix(res.StartTime/1000, (res.StartTime%1000)*int64(time.Millisecond)),
+		},
+	}
+	return v1alpha1.Injected, nil
+}
+
+func (impl *Impl) recoverDaemonDNSChaos(ctx context.Context, record *v1alpha1.Record, dnschaos *v1alpha1.DNSChaos, decodedContainer utils.DecodedContainerRecord) (v1alpha1.Phase, error) {
+	req := &pb.RecoverDNSChaosRequest{
+		ContainerId: decodedContainer.ContainerId,
+		EnterNS:     true,
+	}
+	if instance, ok := dnschaos.Status.Instances[record.Id]; ok {
+		req.Instance = instance.UID
+		req.InstanceUid = instance.InstanceUID
+		if instance.StartTime != nil {
+			req.StartTime = instance.StartTime.UnixNano() / int64(time.Millisecond)
+		}
+	}
+
+	if _, err := decodedContainer.PbClient.RecoverDNSChaos(ctx, req); err != nil {
+		impl.Log.Error(err, "recover dns chaos")
+		return v1alpha1.Injected, err
+	}
+
+	delete(dnschaos.Status.Instances, record.Id)
+	return v1alpha1.NotInjected, nil
+}
+
+// daemonDNSChaosRules converts the patterns and rules in the spec to the rules
+// of the chaos DNS server in chaos-daemon
+func daemonDNSChaosRules(spec *v1alpha1.DNSChaosSpec) ([]*pb.DNSChaosRule, error) {
+	rules := []*pb.DNSChaosRule{}
+	for _, pattern := range spec.DomainNamePatterns {
+		rules = append(rules, &pb.DNSChaosRule{
+			Pattern: pattern,
+			Action:  string(spec.Action),
+		})
+	}
+
+	for _, rule := range spec.Rules {
+		pbRule := &pb.DNSChaosRule{
+			Pattern: rule.Pattern,
+			Action:  string(spec.Action),
+			Answers: rule.Answers,
+		}
+		if len(rule.Delay) != 0 {
+			delay, err := time.ParseDuration(rule.Delay)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parse delay of pattern %s", rule.Pattern)
+			}
+			pbRule.Delay = int64(delay)
+		}
+		rules = append(rules, pbRule)
+	}
+
+	// take effect on all the domain names if no pattern is specified
+	if len(rules) == 0 {
+		rules = append(rules, &pb.DNSChaosRule{
+			Action: string(spec.Action),
+		})
+	}
+	return rules, nil
+}
@@ -57,6 +57,11 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		return v1alpha1.NotInjected, err
 	}
 
+	dnschaos := obj.(*v1alpha1.DNSChaos)
+	if !useChaosDNSServer(dnschaos.Spec.Action) {
+		return impl.applyDaemonDNSChaos(ctx, records[index], dnschaos, decodedContainer)
+	}
+
 	service, err := impl.getService(ctx, config.ControllerCfg.Namespace, config.ControllerCfg.DNSServiceName)
 	if err != nil {
 		impl.Log.Error(err, "fail to get dns service")
@@ -69,7 +74,6 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		return v1alpha1.NotInjected, err
 	}
 
-	dnschaos := obj.(*v1alpha1.DNSChaos)
 	for _, pod := range dnsPods {
 		err = impl.setDNSServerRules(pod.Status.PodIP, config.ControllerCfg.DNSServicePort, dnschaos.Name, decodedContainer.Pod, dnschaos.Spec.Action, dnschaos.Spec.DomainNamePatterns)
 		if err != nil {
@@ -144,6 +148,9 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 	}
 
 	dnschaos := obj.(*v1alpha1.DNSChaos)
+	if _, ok := dnschaos.Status.Instances[records[index].Id]; ok || !useChaosDNSServer(dnschaos.Spec.Action) {
+		return impl.recoverDaemonDNSChaos(ctx, records[index], dnschaos, decodedContainer)
+	}
 
 	// get dns server's ip used for chaos
 	service, err := impl.getService(ctx, config.ControllerCfg.Namespace, config.ControllerCfg.DNSServiceName)
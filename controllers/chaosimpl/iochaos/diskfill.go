@@ -0,0 +1,93 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package iochaos
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// diskFillPath returns the path of the file allocated for the container.
+// It only depends on the chaos and the container, so the file could always
+// be located and removed during recovering.
+func diskFillPath(iochaos *v1alpha1.IOChaos, containerName string) string {
+	return path.Join(iochaos.Spec.VolumePath, fmt.Sprintf(".chaos-mesh-disk-fill-%s-%s", iochaos.UID, containerName))
+}
+
+func (impl *Impl) applyDiskFill(ctx context.Context, record *v1alpha1.Record, iochaos *v1alpha1.IOChaos) (v1alpha1.Phase, error) {
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, record, iochaos)
+	if decodedContainer.PbClient != nil {
+		defer decodedContainer.PbClient.Close()
+	}
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	if iochaos.Spec.DiskFill == nil {
+		return v1alpha1.NotInjected, errors.Errorf("diskFill should be set on %s action", iochaos.Spec.Action)
+	}
+	req := &pb.ApplyDiskFillRequest{
+		ContainerId:  decodedContainer.ContainerId,
+		Path:         diskFillPath(iochaos, decodedContainer.ContainerName),
+		UsagePercent: int32(iochaos.Spec.DiskFill.UsagePercent),
+		EnterNS:      true,
+	}
+	if len(iochaos.Spec.DiskFill.Size) != 0 {
+		size, err := resource.ParseQuantity(iochaos.Spec.DiskFill.Size)
+		if err != nil {
+			return v1alpha1.NotInjected, errors.Wrapf(err, "parse size %s", iochaos.Spec.DiskFill.Size)
+		}
+		req.Size = size.Value()
+	}
+
+	if _, err = decodedContainer.PbClient.ApplyDiskFill(ctx, req); err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	return v1alpha1.Injected, nil
+}
+
+func (impl *Impl) recoverDiskFill(ctx context.Context, record *v1alpha1.Record, iochaos *v1alpha1.IOChaos) (v1alpha1.Phase, error) {
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, record, iochaos)
+	if decodedContainer.PbClient != nil {
+		defer decodedContainer.PbClient.Close()
+	}
+	if err != nil {
+		if errors.Is(err, utils.ErrContainerNotFound) {
+			// pretend the disappeared container has been recovered
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+
+	if _, err = decodedContainer.PbClient.RecoverDiskFill(ctx, &pb.RecoverDiskFillRequest{
+		ContainerId: decodedContainer.ContainerId,
+		Path:        diskFillPath(iochaos, decodedContainer.ContainerName),
+		EnterNS:     true,
+	}); err != nil {
+		return v1alpha1.Injected, err
+	}
+
+	return v1alpha1.NotInjected, nil
+}
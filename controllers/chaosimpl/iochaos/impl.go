@@ -30,6 +30,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/iochaos/podiochaosmanager"
 	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
 )
 
@@ -45,6 +46,7 @@ type Impl struct {
 	Log logr.Logger
 
 	builder *podiochaosmanager.Builder
+	decoder *utils.ContainerRecordDecoder
 }
 
 func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
@@ -52,6 +54,10 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 
 	impl.Log.Info("iochaos Apply", "namespace", obj.GetNamespace(), "name", obj.GetName())
 	iochaos := obj.(*v1alpha1.IOChaos)
+	if iochaos.Spec.Action == v1alpha1.IoDiskFill {
+		return impl.applyDiskFill(ctx, records[index], iochaos)
+	}
+
 	if iochaos.Status.Instances == nil {
 		iochaos.Status.Instances = make(map[string]int64)
 	}
@@ -126,6 +132,7 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		Latency:          iochaos.Spec.Delay,
 		AttrOverrideSpec: iochaos.Spec.Attr,
 		MistakeSpec:      iochaos.Spec.Mistake,
+		ShortRWSpec:      iochaos.Spec.ShortRW,
 		Source:           m.Source,
 	})
 	generationNumber, err := m.Commit(ctx, iochaos)
@@ -142,6 +149,10 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 	// The only possible phase to get in here is "Injected" or "Injected/Wait"
 
 	iochaos := obj.(*v1alpha1.IOChaos)
+	if iochaos.Spec.Action == v1alpha1.IoDiskFill {
+		return impl.recoverDiskFill(ctx, records[index], iochaos)
+	}
+
 	if iochaos.Status.Instances == nil {
 		iochaos.Status.Instances = make(map[string]int64)
 	}
@@ -215,7 +226,7 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 	return waitForRecoverSync, nil
 }
 
-func NewImpl(c client.Client, b *podiochaosmanager.Builder, log logr.Logger) *impltypes.ChaosImplPair {
+func NewImpl(c client.Client, b *podiochaosmanager.Builder, decoder *utils.ContainerRecordDecoder, log logr.Logger) *impltypes.ChaosImplPair {
 	return &impltypes.ChaosImplPair{
 		Name:   "iochaos",
 		Object: &v1alpha1.IOChaos{},
@@ -223,6 +234,7 @@ func NewImpl(c client.Client, b *podiochaosmanager.Builder, log logr.Logger) *im
 			Client:  c,
 			Log:     log.WithName("iochaos"),
 			builder: b,
+			decoder: decoder,
 		},
 		ObjectList: &v1alpha1.IOChaosList{},
 		Controlls:  []client.Object{&v1alpha1.PodIOChaos{}},
@@ -0,0 +1,177 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package jvmchaos
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const (
+	// for action 'jdbc', the SQL is the first parameter of the methods
+	JDBCStatementClass  = "^java.sql.Statement"
+	JDBCConnectionClass = "^java.sql.Connection"
+	JDBCException       = "java.sql.SQLException(\"%s\")"
+
+	KafkaProducerClass  = "org.apache.kafka.clients.producer.KafkaProducer"
+	KafkaProducerMethod = "send(org.apache.kafka.clients.producer.ProducerRecord,org.apache.kafka.clients.producer.Callback)"
+	KafkaConsumerClass  = "org.apache.kafka.clients.consumer.KafkaConsumer"
+	KafkaConsumerMethod = "poll(java.time.Duration)"
+	KafkaException      = "org.apache.kafka.common.KafkaException(\"%s\")"
+
+	JedisInjectClass  = "redis.clients.jedis.Connection"
+	JedisInjectMethod = "sendCommand(redis.clients.jedis.commands.ProtocolCommand,byte[][])"
+	JedisException    = "redis.clients.jedis.exceptions.JedisConnectionException(\"%s\")"
+
+	LettuceInjectClass  = "io.lettuce.core.AbstractRedisAsyncCommands"
+	LettuceInjectMethod = "dispatch(io.lettuce.core.protocol.RedisCommand)"
+	LettuceException    = "io.lettuce.core.RedisException(\"%s\")"
+
+	OkHttpInjectClass  = "^okhttp3.Call"
+	OkHttpInjectMethod = "execute()"
+
+	ApacheHTTPClientInjectClass  = "^org.apache.http.impl.client.CloseableHttpClient"
+	ApacheHTTPClientInjectMethod = "doExecute"
+
+	HTTPClientException = "java.io.IOException(\"%s\")"
+)
+
+// jdbcInjectMethods are the methods of JDBC which receive the SQL
+var jdbcInjectMethods = []struct {
+	class  string
+	method string
+}{
+	{JDBCStatementClass, "execute(String)"},
+	{JDBCStatementClass, "executeQuery(String)"},
+	{JDBCStatementClass, "executeUpdate(String)"},
+	{JDBCConnectionClass, "prepareStatement(String)"},
+}
+
+// generateClientRuleSpecs generates the template specs of the byteman rules for
+// the actions of java client libraries, each spec is rendered to a rule
+func generateClientRuleSpecs(spec *v1alpha1.JVMChaosSpec) ([]BytemanTemplateSpec, error) {
+	var exception string
+	var specs []BytemanTemplateSpec
+
+	switch spec.Action {
+	case v1alpha1.JVMJDBCAction:
+		exception = JDBCException
+		for _, m := range jdbcInjectMethods {
+			specs = append(specs, BytemanTemplateSpec{
+				// the rule name should be unique in the rule script
+				Name:      fmt.Sprintf("%s-%s", spec.Name, strings.TrimSuffix(m.method, "(String)")),
+				Class:     m.class,
+				Interface: true,
+				Method:    m.method,
+				Helper:    SQLHelper,
+				Bind:      fmt.Sprintf("flag:boolean=matchDBTable(\"\", $1, \"%s\", \"%s\", \"%s\")", spec.Database, spec.Table, spec.SQLType),
+				Condition: "flag",
+			})
+		}
+	case v1alpha1.JVMKafkaAction:
+		exception = KafkaException
+		ruleSpec := BytemanTemplateSpec{
+			Name:      spec.Name,
+			Condition: "true",
+		}
+		switch spec.KafkaClient {
+		case "producer":
+			ruleSpec.Class = KafkaProducerClass
+			ruleSpec.Method = KafkaProducerMethod
+			if len(spec.Topic) > 0 {
+				ruleSpec.Condition = fmt.Sprintf("\"%s\".equals($1.topic())", spec.Topic)
+			}
+		case "consumer":
+			ruleSpec.Class = KafkaConsumerClass
+			ruleSpec.Method = KafkaConsumerMethod
+			if len(spec.Topic) > 0 {
+				ruleSpec.Condition = fmt.Sprintf("$0.subscription().contains(\"%s\")", spec.Topic)
+			}
+		default:
+			return nil, errors.Errorf("kafka client %s is not supported", spec.KafkaClient)
+		}
+		specs = append(specs, ruleSpec)
+	case v1alpha1.JVMRedisAction:
+		ruleSpec := BytemanTemplateSpec{
+			Name:      spec.Name,
+			Condition: "true",
+		}
+		switch spec.RedisClient {
+		case "jedis":
+			exception = JedisException
+			ruleSpec.Class = JedisInjectClass
+			ruleSpec.Method = JedisInjectMethod
+			if len(spec.RedisCommand) > 0 {
+				ruleSpec.Condition = fmt.Sprintf("$1.toString().equalsIgnoreCase(\"%s\")", spec.RedisCommand)
+			}
+		case "lettuce":
+			exception = LettuceException
+			ruleSpec.Class = LettuceInjectClass
+			ruleSpec.Method = LettuceInjectMethod
+			if len(spec.RedisCommand) > 0 {
+				ruleSpec.Condition = fmt.Sprintf("$1.getType().toString().equalsIgnoreCase(\"%s\")", spec.RedisCommand)
+			}
+		default:
+			return nil, errors.Errorf("redis client %s is not supported", spec.RedisClient)
+		}
+		specs = append(specs, ruleSpec)
+	case v1alpha1.JVMHTTPClientAction:
+		exception = HTTPClientException
+		ruleSpec := BytemanTemplateSpec{
+			Name:      spec.Name,
+			Condition: "true",
+		}
+		switch spec.HTTPClient {
+		case "okhttp":
+			ruleSpec.Class = OkHttpInjectClass
+			ruleSpec.Interface = true
+			ruleSpec.Method = OkHttpInjectMethod
+			if len(spec.Host) > 0 {
+				ruleSpec.Condition = fmt.Sprintf("\"%s\".equals($0.request().url().host())", spec.Host)
+			}
+		case "apache":
+			ruleSpec.Class = ApacheHTTPClientInjectClass
+			ruleSpec.Method = ApacheHTTPClientInjectMethod
+			// the target host is null if it's not determined by the request
+			if len(spec.Host) > 0 {
+				ruleSpec.Condition = fmt.Sprintf("$1 != null && \"%s\".equals($1.getHostName())", spec.Host)
+			}
+		default:
+			return nil, errors.Errorf("http client %s is not supported", spec.HTTPClient)
+		}
+		specs = append(specs, ruleSpec)
+	default:
+		return nil, errors.Errorf("jvm action %s is not an action of java client", spec.Action)
+	}
+
+	var do string
+	if len(spec.ThrowException) > 0 {
+		do = fmt.Sprintf("throw new %s", fmt.Sprintf(exception, spec.ThrowException))
+	} else if spec.LatencyDuration > 0 {
+		do = fmt.Sprintf("Thread.sleep(%dL)", spec.LatencyDuration)
+	} else {
+		return nil, errors.New("must set one of exception or latency")
+	}
+	for i := range specs {
+		specs[i].Do = do
+	}
+
+	return specs, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package jvmchaos
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestGenerateClientRuleData(t *testing.T) {
+	g := NewWithT(t)
+
+	testCases := []struct {
+		spec     *v1alpha1.JVMChaosSpec
+		ruleData string
+	}{
+		{
+			&v1alpha1.JVMChaosSpec{
+				Action: v1alpha1.JVMJDBCAction,
+				JVMParameter: v1alpha1.JVMParameter{
+					Name: "test",
+					JVMMySQLSpec: v1alpha1.JVMMySQLSpec{
+						Table:   "t1",
+						SQLType: "select",
+					},
+					ThrowException: "BOOM",
+				},
+			},
+			"\nRULE test-execute\nINTERFACE ^java.sql.Statement\nMETHOD execute(String)\nHELPER org.chaos_mesh.byteman.helper.SQLHelper\nAT ENTRY\nBIND flag:boolean=matchDBTable(\"\", $1, \"\", \"t1\", \"select\");\nIF flag\nDO\n\tthrow new java.sql.SQLException(\"BOOM\");\nENDRULE\n\nRULE test-executeQuery\nINTERFACE ^java.sql.Statement\nMETHOD executeQuery(String)\nHELPER org.chaos_mesh.byteman.helper.SQLHelper\nAT ENTRY\nBIND flag:boolean=matchDBTable(\"\", $1, \"\", \"t1\", \"select\");\nIF flag\nDO\n\tthrow new java.sql.SQLException(\"BOOM\");\nENDRULE\n\nRULE test-executeUpdate\nINTERFACE ^java.sql.Statement\nMETHOD executeUpdate(String)\nHELPER org.chaos_mesh.byteman.helper.SQLHelper\nAT ENTRY\nBIND flag:boolean=matchDBTable(\"\", $1, \"\", \"t1\", \"select\");\nIF flag\nDO\n\tthrow new java.sql.SQLException(\"BOOM\");\nENDRULE\n\nRULE test-prepareStatement\nINTERFACE ^java.sql.Connection\nMETHOD prepareStatement(String)\nHELPER org.chaos_mesh.byteman.helper.SQLHelper\nAT ENTRY\nBIND flag:boolean=matchDBTable(\"\", $1, \"\", \"t1\", \"select\");\nIF flag\nDO\n\tthrow new java.sql.SQLException(\"BOOM\");\nENDRULE\n",
+		},
+		{
+			&v1alpha1.JVMChaosSpec{
+				Action: v1alpha1.JVMKafkaAction,
+				JVMParameter: v1alpha1.JVMParameter{
+					Name: "test",
+					JVMKafkaSpec: v1alpha1.JVMKafkaSpec{
+						KafkaClient: "consumer",
+						Topic:       "orders",
+					},
+					LatencyDuration: 5000,
+				},
+			},
+			"\nRULE test\nCLASS org.apache.kafka.clients.consumer.KafkaConsumer\nMETHOD poll(java.time.Duration)\nAT ENTRY\nIF $0.subscription().contains(\"orders\")\nDO\n\tThread.sleep(5000L);\nENDRULE\n",
+		},
+		{
+			&v1alpha1.JVMChaosSpec{
+				Action: v1alpha1.JVMRedisAction,
+				JVMParameter: v1alpha1.JVMParameter{
+					Name: "test",
+					JVMRedisSpec: v1alpha1.JVMRedisSpec{
+						RedisClient:  "lettuce",
+						RedisCommand: "GET",
+					},
+					ThrowException: "BOOM",
+				},
+			},
+			"\nRULE test\nCLASS io.lettuce.core.AbstractRedisAsyncCommands\nMETHOD dispatch(io.lettuce.core.protocol.RedisCommand)\nAT ENTRY\nIF $1.getType().toString().equalsIgnoreCase(\"GET\")\nDO\n\tthrow new io.lettuce.core.RedisException(\"BOOM\");\nENDRULE\n",
+		},
+		{
+			&v1alpha1.JVMChaosSpec{
+				Action: v1alpha1.JVMHTTPClientAction,
+				JVMParameter: v1alpha1.JVMParameter{
+					Name: "test",
+					JVMHTTPClientSpec: v1alpha1.JVMHTTPClientSpec{
+						HTTPClient: "apache",
+						Host:       "example.com",
+					},
+					ThrowException: "BOOM",
+				},
+			},
+			"\nRULE test\nCLASS ^org.apache.http.impl.client.CloseableHttpClient\nMETHOD doExecute\nAT ENTRY\nIF $1 != null && \"example.com\".equals($1.getHostName())\nDO\n\tthrow new java.io.IOException(\"BOOM\");\nENDRULE\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := generateRuleData(testCase.spec)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(testCase.spec.RuleData).Should(Equal(testCase.ruleData))
+	}
+
+	err := generateRuleData(&v1alpha1.JVMChaosSpec{
+		Action: v1alpha1.JVMRedisAction,
+		JVMParameter: v1alpha1.JVMParameter{
+			Name: "test",
+			JVMRedisSpec: v1alpha1.JVMRedisSpec{
+				RedisClient: "redisson",
+			},
+			ThrowException: "BOOM",
+		},
+	})
+	g.Expect(err).Should(HaveOccurred())
+}
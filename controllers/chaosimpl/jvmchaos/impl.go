@@ -19,12 +19,15 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pingcap/errors"
 	"go.uber.org/fx"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
@@ -37,7 +40,10 @@ var (
 	errNilDecoder error = errors.New("impl decoder is nil")
 )
 
-var _ impltypes.ChaosImpl = (*Impl)(nil)
+var _ impltypes.ChaosImplWithRequeue = (*Impl)(nil)
+
+// waitForTransform is the phase to wait for the installed rules to be injected into the target classes
+const waitForTransform v1alpha1.Phase = "Not Injected/Wait"
 
 const (
 	// byteman rule template
@@ -52,13 +58,19 @@ DO
 ENDRULE
 `
 
+	// the helper and the bind are optional, for action 'threadPool', 'monitorHold',
+	// 'heapPressure' and the actions of java client libraries
 	CompleteRuleTemplate = `
 RULE {{.Name}}
-CLASS {{.Class}}
+{{if .Interface}}INTERFACE{{else}}CLASS{{end}} {{.Class}}
 METHOD {{.Method}}
+{{- if .Helper}}
 HELPER {{.Helper}}
+{{- end}}
 AT ENTRY
+{{- if .Bind}}
 BIND {{.Bind}};
+{{- end}}
 IF {{.Condition}}
 DO
 	{{.Do}};
@@ -81,6 +93,13 @@ ENDRULE
 	MySQL8InjectClass  = "com.mysql.cj.NativeSession"
 	MySQL8InjectMethod = "execSQL"
 	MySQL8Exception    = "com.mysql.cj.exceptions.CJException(\"%s\")"
+
+	// the default trigger point for 'threadPool', which is called by the worker before executing each task
+	ThreadPoolClass  = "java.util.concurrent.ThreadPoolExecutor"
+	ThreadPoolMethod = "beforeExecute"
+
+	// maxJavaArrayLength is the max length of an array in most JVMs
+	maxJavaArrayLength = 2147483639
 )
 
 // BytemanTemplateSpec is the template spec for byteman rule
@@ -97,6 +116,9 @@ type BytemanTemplateSpec struct {
 	StressType      string
 	StressValueName string
 	StressValue     string
+
+	// below is only used for condition template, whether the Class is an interface
+	Interface bool
 }
 
 type Impl struct {
@@ -133,14 +155,51 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		return v1alpha1.Injected, err
 	}
 
-	_, err = decodedContainer.PbClient.InstallJVMRules(ctx, &pb.InstallJVMRulesRequest{
-		ContainerId: decodedContainer.ContainerId,
-		Rule:        jvmChaos.Spec.RuleData,
-		Port:        jvmChaos.Spec.Port,
-		EnterNS:     true,
-	})
+	if jvmChaos.Status.Instances == nil {
+		jvmChaos.Status.Instances = make(map[string]v1alpha1.JVMChaosInstance)
+	}
+	instance := jvmChaos.Status.Instances[records[index].Id]
+	// the rules have been installed if it's waiting for them to be injected
+	if records[index].Phase != waitForTransform || instance.InstallTime == nil {
+		_, err = decodedContainer.PbClient.InstallJVMRules(ctx, &pb.InstallJVMRulesRequest{
+			ContainerId: decodedContainer.ContainerId,
+			Rule:        jvmChaos.Spec.RuleData,
+			Port:        jvmChaos.Spec.Port,
+			EnterNS:     true,
+		})
+		if err != nil {
+			impl.Log.Error(err, "install jvm rules")
+			return v1alpha1.NotInjected, err
+		}
+		installTime := metav1.Now()
+		instance = v1alpha1.JVMChaosInstance{
+			InstallTime: &installTime,
+		}
+	}
+
+	wait := false
+	statuses, err := getJVMRuleStatuses(ctx, decodedContainer, &jvmChaos.Spec)
+	if err == nil {
+		instance.Rules = statuses
+		wait, err = checkJVMRules(statuses, &jvmChaos.Spec, instance.InstallTime.Time)
+	}
+	jvmChaos.Status.Instances[records[index].Id] = instance
+	if wait {
+		// the records are reconciled again after jvmRuleResolveInterval
+		return waitForTransform, nil
+	}
 	if err != nil {
-		impl.Log.Error(err, "install jvm rules")
+		impl.Log.Error(err, "check jvm rules")
+		// uninstall the rules, so that they will not take effect after the injection is marked as failed
+		_, uninstallErr := decodedContainer.PbClient.UninstallJVMRules(ctx, &pb.UninstallJVMRulesRequest{
+			ContainerId: decodedContainer.ContainerId,
+			Rule:        jvmChaos.Spec.RuleData,
+			Port:        jvmChaos.Spec.Port,
+			EnterNS:     true,
+		})
+		if uninstallErr != nil {
+			impl.Log.Error(uninstallErr, "uninstall jvm rules")
+		}
 		return v1alpha1.NotInjected, err
 	}
 
@@ -178,6 +237,15 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 		return v1alpha1.Injected, err
 	}
 
+	// record the final hit counts before the rules are uninstalled
+	statuses, err := getJVMRuleStatuses(ctx, decodedContainer, &jvmChaos.Spec)
+	if err != nil {
+		impl.Log.Error(err, "get jvm rules")
+	} else if instance, ok := jvmChaos.Status.Instances[records[index].Id]; ok {
+		instance.Rules = statuses
+		jvmChaos.Status.Instances[records[index].Id] = instance
+	}
+
 	_, err = decodedContainer.PbClient.UninstallJVMRules(ctx, &pb.UninstallJVMRulesRequest{
 		ContainerId: decodedContainer.ContainerId,
 		Rule:        jvmChaos.Spec.RuleData,
@@ -197,6 +265,58 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 	return v1alpha1.NotInjected, nil
 }
 
+// Refresh refreshes the hits of the rules injected into the container
+func (impl *Impl) Refresh(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (bool, error) {
+	if impl.decoder == nil {
+		return false, errors.WithStack(errNilDecoder)
+	}
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
+	if decodedContainer.PbClient != nil {
+		defer func() {
+			err := decodedContainer.PbClient.Close()
+			if err != nil {
+				impl.Log.Error(err, "fail to close pb client")
+			}
+		}()
+	}
+	if err != nil {
+		return false, err
+	}
+
+	jvmChaos := obj.(*v1alpha1.JVMChaos)
+	err = generateRuleData(&jvmChaos.Spec)
+	if err != nil {
+		return false, err
+	}
+
+	statuses, err := getJVMRuleStatuses(ctx, decodedContainer, &jvmChaos.Spec)
+	if err != nil {
+		return false, err
+	}
+	instance, ok := jvmChaos.Status.Instances[records[index].Id]
+	if !ok || reflect.DeepEqual(instance.Rules, statuses) {
+		return false, nil
+	}
+	instance.Rules = statuses
+	jvmChaos.Status.Instances[records[index].Id] = instance
+	return true, nil
+}
+
+// RequeueAfter returns the interval to check the rules which are waiting to be injected,
+// or to refresh the hits of the injected rules
+func (impl *Impl) RequeueAfter(records []*v1alpha1.Record, obj v1alpha1.InnerObject) time.Duration {
+	var requeueAfter time.Duration
+	for _, record := range records {
+		switch record.Phase {
+		case waitForTransform:
+			return jvmRuleResolveInterval
+		case v1alpha1.Injected:
+			requeueAfter = jvmRuleRefreshInterval
+		}
+	}
+	return requeueAfter
+}
+
 // JVMRuleParameter is only used to generate rule data
 type JVMRuleParameter struct {
 	v1alpha1.JVMParameter
@@ -212,6 +332,11 @@ func generateRuleData(spec *v1alpha1.JVMChaosSpec) error {
 		return nil
 	}
 
+	switch spec.Action {
+	case v1alpha1.JVMJDBCAction, v1alpha1.JVMKafkaAction, v1alpha1.JVMRedisAction, v1alpha1.JVMHTTPClientAction:
+		return generateClientRuleData(spec)
+	}
+
 	bytemanTemplateSpec := BytemanTemplateSpec{
 		Name:   spec.Name,
 		Class:  spec.Class,
@@ -271,12 +396,40 @@ func generateRuleData(spec *v1alpha1.JVMChaosSpec) error {
 		} else if spec.LatencyDuration > 0 {
 			bytemanTemplateSpec.Do = fmt.Sprintf("Thread.sleep(%dL)", spec.LatencyDuration)
 		}
+	case v1alpha1.JVMThreadPoolAction:
+		if len(bytemanTemplateSpec.Class) == 0 {
+			bytemanTemplateSpec.Class = ThreadPoolClass
+			bytemanTemplateSpec.Method = ThreadPoolMethod
+		}
+		// the counter records the number of the blocked threads, and it's restored
+		// if the thread is not going to be blocked because there are enough blocked
+		// threads already
+		bytemanTemplateSpec.Condition = fmt.Sprintf("Thread.currentThread().getName().startsWith(\"%s\") && (incrementCounter(\"%s\") <= %d || decrementCounter(\"%s\") < 0)",
+			spec.ThreadNamePrefix, spec.Name, spec.ThreadCount, spec.Name)
+		bytemanTemplateSpec.Do = fmt.Sprintf("Thread.sleep(%dL); decrementCounter(\"%s\")", spec.LatencyDuration, spec.Name)
+	case v1alpha1.JVMMonitorHoldAction:
+		// the monitor of a synchronized method is held at the entry, so the thread
+		// entering the method holds the monitor during the sleep, and the other
+		// threads are blocked on the monitor. The rule fires on every entry, so the
+		// monitor is held again once the next thread acquires it
+		bytemanTemplateSpec.Condition = "true"
+		bytemanTemplateSpec.Do = fmt.Sprintf("Thread.sleep(%dL)", spec.LatencyDuration)
+	case v1alpha1.JVMHeapPressureAction:
+		bytemanTemplateSpec.Class = TriggerClass
+		bytemanTemplateSpec.Method = TriggerMethod
+		// retain a long array to fill the used heap up to the target occupancy, the
+		// array is released once the sleep finishes
+		bytemanTemplateSpec.Bind = fmt.Sprintf("runtime:Runtime = Runtime.getRuntime(); retained:long[] = new long[Math.toIntExact(Math.max(0L, Math.min(%dL, (runtime.maxMemory() / 100L * %dL - (runtime.totalMemory() - runtime.freeMemory())) / 8L)))]",
+			maxJavaArrayLength, spec.HeapOccupancy)
+		bytemanTemplateSpec.Condition = "true"
+		bytemanTemplateSpec.Do = fmt.Sprintf("Thread.sleep(%dL)", spec.LatencyDuration)
 	}
 
 	buf := new(bytes.Buffer)
 	var t *template.Template
 	switch spec.Action {
-	case v1alpha1.JVMStressAction, v1alpha1.JVMGCAction, v1alpha1.JVMMySQLAction:
+	case v1alpha1.JVMStressAction, v1alpha1.JVMGCAction, v1alpha1.JVMMySQLAction,
+		v1alpha1.JVMThreadPoolAction, v1alpha1.JVMMonitorHoldAction, v1alpha1.JVMHeapPressureAction:
 		t = template.Must(template.New("byteman rule").Parse(CompleteRuleTemplate))
 	case v1alpha1.JVMExceptionAction, v1alpha1.JVMLatencyAction, v1alpha1.JVMReturnAction:
 		t = template.Must(template.New("byteman rule").Parse(SimpleRuleTemplate))
@@ -295,6 +448,27 @@ func generateRuleData(spec *v1alpha1.JVMChaosSpec) error {
 	return nil
 }
 
+// generateClientRuleData generates the rule data for the actions of java client
+// libraries, which may contain several rules
+func generateClientRuleData(spec *v1alpha1.JVMChaosSpec) error {
+	bytemanTemplateSpecs, err := generateClientRuleSpecs(spec)
+	if err != nil {
+		return err
+	}
+
+	t := template.Must(template.New("byteman rule").Parse(CompleteRuleTemplate))
+	buf := new(bytes.Buffer)
+	for _, bytemanTemplateSpec := range bytemanTemplateSpecs {
+		err := t.Execute(buf, bytemanTemplateSpec)
+		if err != nil {
+			return err
+		}
+	}
+
+	spec.RuleData = buf.String()
+	return nil
+}
+
 // Object would return the instance of chaos
 func NewImpl(c client.Client, decoder *utils.ContainerRecordDecoder, log logr.Logger) *impltypes.ChaosImplPair {
 	return &impltypes.ChaosImplPair{
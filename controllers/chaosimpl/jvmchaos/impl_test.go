@@ -161,6 +161,56 @@ func TestGenerateRuleData(t *testing.T) {
 			},
 			"\nRULE test\nCLASS com.mysql.cj.NativeSession\nMETHOD execSQL\nHELPER org.chaos_mesh.byteman.helper.SQLHelper\nAT ENTRY\nBIND flag:boolean=matchDBTable(\"\", $2, \"test\", \"t1\", \"select\");\nIF flag\nDO\n\tThread.sleep(5000L);\nENDRULE\n",
 		},
+		{
+			&v1alpha1.JVMChaosSpec{
+				Action: v1alpha1.JVMThreadPoolAction,
+				JVMParameter: v1alpha1.JVMParameter{
+					Name: "test",
+					JVMCommonSpec: v1alpha1.JVMCommonSpec{
+						Pid: 1234,
+					},
+					JVMThreadPoolSpec: v1alpha1.JVMThreadPoolSpec{
+						ThreadNamePrefix: "pool-1-thread-",
+						ThreadCount:      4,
+					},
+					LatencyDuration: 5000,
+				},
+			},
+			"\nRULE test\nCLASS java.util.concurrent.ThreadPoolExecutor\nMETHOD beforeExecute\nAT ENTRY\nIF Thread.currentThread().getName().startsWith(\"pool-1-thread-\") && (incrementCounter(\"test\") <= 4 || decrementCounter(\"test\") < 0)\nDO\n\tThread.sleep(5000L); decrementCounter(\"test\");\nENDRULE\n",
+		},
+		{
+			&v1alpha1.JVMChaosSpec{
+				Action: v1alpha1.JVMMonitorHoldAction,
+				JVMParameter: v1alpha1.JVMParameter{
+					Name: "test",
+					JVMCommonSpec: v1alpha1.JVMCommonSpec{
+						Pid: 1234,
+					},
+					JVMClassMethodSpec: v1alpha1.JVMClassMethodSpec{
+						Class:  "testClass",
+						Method: "testMethod",
+					},
+					LatencyDuration: 5000,
+				},
+			},
+			"\nRULE test\nCLASS testClass\nMETHOD testMethod\nAT ENTRY\nIF true\nDO\n\tThread.sleep(5000L);\nENDRULE\n",
+		},
+		{
+			&v1alpha1.JVMChaosSpec{
+				Action: v1alpha1.JVMHeapPressureAction,
+				JVMParameter: v1alpha1.JVMParameter{
+					Name: "test",
+					JVMCommonSpec: v1alpha1.JVMCommonSpec{
+						Pid: 1234,
+					},
+					JVMStressCfgSpec: v1alpha1.JVMStressCfgSpec{
+						HeapOccupancy: 80,
+					},
+					LatencyDuration: 5000,
+				},
+			},
+			"\nRULE test\nCLASS org.chaos_mesh.chaos_agent.TriggerThread\nMETHOD triggerFunc\nAT ENTRY\nBIND runtime:Runtime = Runtime.getRuntime(); retained:long[] = new long[Math.toIntExact(Math.max(0L, Math.min(2147483639L, (runtime.maxMemory() / 100L * 80L - (runtime.totalMemory() - runtime.freeMemory())) / 8L)))];\nIF true\nDO\n\tThread.sleep(5000L);\nENDRULE\n",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -169,3 +219,27 @@ func TestGenerateRuleData(t *testing.T) {
 		g.Expect(testCase.spec.RuleData).Should(Equal(testCase.ruleData))
 	}
 }
+
+func TestMonitorHoldRuleFiresRepeatedly(t *testing.T) {
+	g := NewWithT(t)
+
+	spec := &v1alpha1.JVMChaosSpec{
+		Action: v1alpha1.JVMMonitorHoldAction,
+		JVMParameter: v1alpha1.JVMParameter{
+			Name: "test",
+			JVMClassMethodSpec: v1alpha1.JVMClassMethodSpec{
+				Class:  "testClass",
+				Method: "testMethod",
+			},
+			LatencyDuration: 5000,
+		},
+	}
+	g.Expect(generateRuleData(spec)).Should(Succeed())
+
+	// byteman's flag, countDown and rendezvous builtins only pass once, the hold
+	// must be injected on every entry of the method
+	g.Expect(spec.RuleData).Should(ContainSubstring("\nIF true\n"))
+	for _, builtin := range []string{"flag(", "clear(", "countDown(", "createCountDown(", "rendezvous("} {
+		g.Expect(spec.RuleData).ShouldNot(ContainSubstring(builtin))
+	}
+}
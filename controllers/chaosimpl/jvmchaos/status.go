@@ -0,0 +1,136 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package jvmchaos
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const (
+	// defaultJVMRuleResolveTimeout is the max time to wait for the rules to be injected into the
+	// target classes if it's not set in the spec. Byteman transforms the loaded classes once the
+	// rules are submitted, so it's enough unless the target class hasn't been loaded.
+	defaultJVMRuleResolveTimeout = 10 * time.Second
+	// jvmRuleResolveInterval is the interval to query the status of the rules until they're injected
+	jvmRuleResolveInterval = time.Second
+	// jvmRuleRefreshInterval is the interval to refresh the hits of the injected rules
+	jvmRuleRefreshInterval = 30 * time.Second
+)
+
+// ruleNames returns the names of the rules in the rule data
+func ruleNames(ruleData string) []string {
+	names := []string{}
+	for _, line := range strings.Split(ruleData, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "RULE ") {
+			names = append(names, strings.TrimSpace(strings.TrimPrefix(line, "RULE ")))
+		}
+	}
+	return names
+}
+
+// getJVMRuleStatuses queries the byteman agent for the status of the rules in the spec
+func getJVMRuleStatuses(ctx context.Context, decodedContainer utils.DecodedContainerRecord, spec *v1alpha1.JVMChaosSpec) ([]v1alpha1.JVMRuleStatus, error) {
+	res, err := decodedContainer.PbClient.GetJVMRules(ctx, &pb.GetJVMRulesRequest{
+		ContainerId: decodedContainer.ContainerId,
+		Port:        spec.Port,
+		EnterNS:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	loaded := make(map[string]*pb.JVMRuleStatus)
+	for _, rule := range res.Rules {
+		loaded[rule.Name] = rule
+	}
+
+	statuses := []v1alpha1.JVMRuleStatus{}
+	for _, name := range ruleNames(spec.RuleData) {
+		status := v1alpha1.JVMRuleStatus{
+			Name: name,
+		}
+		if rule, ok := loaded[name]; ok {
+			status.Transformed = rule.Transformed
+			status.Hits = rule.Hits
+			status.Error = rule.Error
+		} else {
+			status.Error = "rule is not loaded by the byteman agent"
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// jvmRuleResolveTimeout returns the max time to wait for the rules in the spec to be injected
+func jvmRuleResolveTimeout(spec *v1alpha1.JVMChaosSpec) (time.Duration, error) {
+	if len(spec.TransformTimeout) == 0 {
+		return defaultJVMRuleResolveTimeout, nil
+	}
+	timeout, err := time.ParseDuration(spec.TransformTimeout)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse transform timeout %s", spec.TransformTimeout)
+	}
+	return timeout, nil
+}
+
+// checkJVMRuleStatuses returns an error if any rule failed, or hasn't been injected into the target
+// class if transformed is required
+func checkJVMRuleStatuses(statuses []v1alpha1.JVMRuleStatus, transformed bool) error {
+	for _, status := range statuses {
+		if len(status.Error) != 0 {
+			return errors.Errorf("byteman rule %s failed: %s", status.Name, status.Error)
+		}
+		if transformed && !status.Transformed {
+			return errors.Errorf("byteman rule %s is not injected into any class, please check whether the class and method exist and the class has been loaded", status.Name)
+		}
+	}
+	return nil
+}
+
+// checkJVMRules checks whether all the rules in the spec are injected into the target
+// classes. It returns true if it should wait for the rules to be injected, that's none
+// of them fails and the timeout since they were installed isn't reached. If the timeout
+// is 0, the rules are only checked for failures.
+func checkJVMRules(statuses []v1alpha1.JVMRuleStatus, spec *v1alpha1.JVMChaosSpec, installTime time.Time) (bool, error) {
+	timeout, err := jvmRuleResolveTimeout(spec)
+	if err != nil {
+		return false, err
+	}
+
+	err = checkJVMRuleStatuses(statuses, timeout > 0)
+	if err == nil {
+		return false, nil
+	}
+	for _, status := range statuses {
+		if len(status.Error) != 0 {
+			// the failed rule will not be injected by waiting
+			return false, err
+		}
+	}
+	if time.Since(installTime) < timeout {
+		return true, nil
+	}
+	return false, err
+}
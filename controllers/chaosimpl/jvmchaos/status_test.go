@@ -0,0 +1,103 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package jvmchaos
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestCheckJVMRuleStatuses(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ruleNames("\nRULE test-execute\nINTERFACE ^java.sql.Statement\nENDRULE\n\nRULE test-prepareStatement\nENDRULE\n")).
+		To(Equal([]string{"test-execute", "test-prepareStatement"}))
+
+	g.Expect(checkJVMRuleStatuses([]v1alpha1.JVMRuleStatus{
+		{Name: "foo1", Transformed: true, Hits: 3},
+	}, true)).To(Succeed())
+	g.Expect(checkJVMRuleStatuses([]v1alpha1.JVMRuleStatus{
+		{Name: "foo1", Transformed: true},
+		{Name: "foo2"},
+	}, true)).To(MatchError(ContainSubstring("byteman rule foo2 is not injected")))
+	g.Expect(checkJVMRuleStatuses([]v1alpha1.JVMRuleStatus{
+		{Name: "foo1", Transformed: true},
+		{Name: "foo2"},
+	}, false)).To(Succeed())
+	g.Expect(checkJVMRuleStatuses([]v1alpha1.JVMRuleStatus{
+		{Name: "foo1", Transformed: true, Error: "failed to compile"},
+	}, false)).To(MatchError(ContainSubstring("byteman rule foo1 failed: failed to compile")))
+}
+
+func TestJVMRuleResolveTimeout(t *testing.T) {
+	g := NewWithT(t)
+
+	spec := &v1alpha1.JVMChaosSpec{}
+	g.Expect(jvmRuleResolveTimeout(spec)).To(Equal(defaultJVMRuleResolveTimeout))
+
+	spec.TransformTimeout = "1m"
+	g.Expect(jvmRuleResolveTimeout(spec)).To(Equal(time.Minute))
+
+	spec.TransformTimeout = "0s"
+	g.Expect(jvmRuleResolveTimeout(spec)).To(BeZero())
+
+	spec.TransformTimeout = "foo"
+	_, err := jvmRuleResolveTimeout(spec)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestCheckJVMRules(t *testing.T) {
+	g := NewWithT(t)
+
+	spec := &v1alpha1.JVMChaosSpec{}
+	notTransformed := []v1alpha1.JVMRuleStatus{{Name: "foo1"}}
+
+	wait, err := checkJVMRules(notTransformed, spec, time.Now())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(wait).To(BeTrue())
+
+	wait, err = checkJVMRules(notTransformed, spec, time.Now().Add(-defaultJVMRuleResolveTimeout))
+	g.Expect(err).To(MatchError(ContainSubstring("byteman rule foo1 is not injected")))
+	g.Expect(wait).To(BeFalse())
+
+	wait, err = checkJVMRules([]v1alpha1.JVMRuleStatus{{Name: "foo1", Error: "failed to compile"}}, spec, time.Now())
+	g.Expect(err).To(MatchError(ContainSubstring("byteman rule foo1 failed")))
+	g.Expect(wait).To(BeFalse())
+
+	wait, err = checkJVMRules([]v1alpha1.JVMRuleStatus{{Name: "foo1", Transformed: true}}, spec, time.Now())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(wait).To(BeFalse())
+
+	spec.TransformTimeout = "0s"
+	wait, err = checkJVMRules(notTransformed, spec, time.Now())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(wait).To(BeFalse())
+}
+
+func TestRequeueAfter(t *testing.T) {
+	g := NewWithT(t)
+
+	impl := &Impl{}
+	g.Expect(impl.RequeueAfter([]*v1alpha1.Record{{Phase: v1alpha1.NotInjected}}, nil)).To(BeZero())
+	g.Expect(impl.RequeueAfter([]*v1alpha1.Record{{Phase: v1alpha1.NotInjected}, {Phase: v1alpha1.Injected}}, nil)).
+		To(Equal(jvmRuleRefreshInterval))
+	g.Expect(impl.RequeueAfter([]*v1alpha1.Record{{Phase: v1alpha1.Injected}, {Phase: waitForTransform}}, nil)).
+		To(Equal(jvmRuleResolveInterval))
+}
@@ -149,8 +149,17 @@ func (impl *Impl) recoverPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.K
 	}
 	defer conn.Close()
 
+	node, err := getNode(ctx, impl.Client, pod)
+	if err != nil {
+		return err
+	}
+	request, err := chaos.Spec.FailKernRequest.Compile(node.Status.NodeInfo.Architecture)
+	if err != nil {
+		return err
+	}
+
 	var callchain []*pb_kernel.FailKernRequestFrame
-	for _, frame := range chaos.Spec.FailKernRequest.Callchain {
+	for _, frame := range request.Callchain {
 		callchain = append(callchain, &pb_kernel.FailKernRequestFrame{
 			Funcname:   frame.Funcname,
 			Parameters: frame.Parameters,
@@ -202,8 +211,22 @@ func (impl *Impl) applyPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.Ker
 	}
 	defer conn.Close()
 
+	node, err := getNode(ctx, impl.Client, pod)
+	if err != nil {
+		return err
+	}
+	if chaos.Spec.FailKernRequest.Preset != nil {
+		if err := chaos.Spec.FailKernRequest.Preset.CheckKernelVersion(node.Status.NodeInfo.KernelVersion); err != nil {
+			return err
+		}
+	}
+	request, err := chaos.Spec.FailKernRequest.Compile(node.Status.NodeInfo.Architecture)
+	if err != nil {
+		return err
+	}
+
 	var callchain []*pb_kernel.FailKernRequestFrame
-	for _, frame := range chaos.Spec.FailKernRequest.Callchain {
+	for _, frame := range request.Callchain {
 		callchain = append(callchain, &pb_kernel.FailKernRequestFrame{
 			Funcname:   frame.Funcname,
 			Parameters: frame.Parameters,
@@ -214,16 +237,26 @@ func (impl *Impl) applyPod(ctx context.Context, pod *v1.Pod, chaos *v1alpha1.Ker
 	bpfClient := pb_kernel.NewBPFKIServiceClient(conn)
 	_, err = bpfClient.FailMMOrBIO(ctx, &pb_kernel.FailKernRequest{
 		Pid:         containerResponse.Pid,
-		Ftype:       pb_kernel.FailKernRequest_FAILTYPE(chaos.Spec.FailKernRequest.FailType),
-		Headers:     chaos.Spec.FailKernRequest.Headers,
+		Ftype:       pb_kernel.FailKernRequest_FAILTYPE(request.FailType),
+		Headers:     request.Headers,
 		Callchain:   callchain,
-		Probability: float32(chaos.Spec.FailKernRequest.Probability) / 100,
-		Times:       chaos.Spec.FailKernRequest.Times,
+		Probability: float32(request.Probability) / 100,
+		Times:       request.Times,
 	})
 
 	return err
 }
 
+// getNode returns the node of the pod, whose architecture, such as amd64, and kernel version are
+// used to compile the presets of the kernel faults
+func getNode(ctx context.Context, c client.Reader, pod *v1.Pod) (*v1.Node, error) {
+	var node v1.Node
+	if err := c.Get(ctx, types.NamespacedName{Name: pod.Spec.NodeName}, &node); err != nil {
+		return nil, errors.Wrapf(err, "get node %s of pod %s/%s", pod.Spec.NodeName, pod.Namespace, pod.Name)
+	}
+	return &node, nil
+}
+
 // CreateBPFKIConnection create a grpc connection with bpfki
 func (impl *Impl) CreateBPFKIConnection(ctx context.Context, c client.Client, pod *v1.Pod) (*grpc.ClientConn, error) {
 	daemonIP, err := impl.chaosDaemonClientBuilder.FindDaemonIP(ctx, pod)
@@ -0,0 +1,217 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package drain
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"go.uber.org/fx"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/pkg/annotation"
+)
+
+var _ impltypes.ChaosImplWithRequeue = (*Impl)(nil)
+
+// waitForEviction is the phase to retry the evictions blocked by PodDisruptionBudgets
+const waitForEviction v1alpha1.Phase = "Not Injected/Wait"
+
+// evictionRetryInterval is the interval to retry the blocked evictions
+const evictionRetryInterval = 5 * time.Second
+
+var errEvictionBlocked = errors.New("the evictions are blocked by PodDisruptionBudgets")
+
+type Impl struct {
+	client.Client
+
+	Log logr.Logger
+
+	reader client.Reader
+}
+
+// Apply cordons the node and evicts the pods on it. The evictions are rejected by the api server
+// if they would violate a PodDisruptionBudget, and they are retried until the chaos is stopped.
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	nodechaos := obj.(*v1alpha1.NodeChaos)
+
+	if records[index].Phase == waitForEviction && nodechaos.GetStatus().Experiment.DesiredPhase != v1alpha1.RunningPhase {
+		// the chaos is stopped while retrying the evictions, the node has been cordoned, so it's
+		// injected and will be uncordoned on recovery
+		return v1alpha1.Injected, nil
+	}
+
+	var node v1.Node
+	if err := impl.Get(ctx, types.NamespacedName{Name: records[index].Id}, &node); err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	// if the node has been unschedulable, it's not cordoned by this chaos and should be kept as it is
+	if !node.Spec.Unschedulable {
+		origin := node.DeepCopy()
+		node.Spec.Unschedulable = true
+		if node.Annotations == nil {
+			node.Annotations = make(map[string]string)
+		}
+		node.Annotations[annotation.GenKeyForNodeChaos(nodechaos)] = string(nodechaos.Spec.Action)
+		if err := impl.Patch(ctx, &node, client.MergeFrom(origin)); err != nil {
+			return v1alpha1.NotInjected, err
+		}
+	}
+
+	if err := impl.evictPods(ctx, node.Name, nodechaos.Spec.GracePeriod); err != nil {
+		if errors.Is(err, errEvictionBlocked) {
+			// the records are reconciled again after evictionRetryInterval
+			impl.Log.Info("retry the blocked evictions later", "node", node.Name, "reason", err.Error())
+			return waitForEviction, nil
+		}
+		// the node has been cordoned, so it's injected and should be uncordoned on recovery
+		impl.Log.Error(err, "drain node", "node", node.Name)
+		return v1alpha1.Injected, err
+	}
+
+	return v1alpha1.Injected, nil
+}
+
+// Recover uncordons the node if it's cordoned by Apply. The evicted pods are not restored,
+// they are expected to be recreated by their controllers.
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	nodechaos := obj.(*v1alpha1.NodeChaos)
+
+	var node v1.Node
+	if err := impl.Get(ctx, types.NamespacedName{Name: records[index].Id}, &node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+
+	key := annotation.GenKeyForNodeChaos(nodechaos)
+	if _, ok := node.Annotations[key]; !ok {
+		return v1alpha1.NotInjected, nil
+	}
+
+	origin := node.DeepCopy()
+	node.Spec.Unschedulable = false
+	delete(node.Annotations, key)
+	if err := impl.Patch(ctx, &node, client.MergeFrom(origin)); err != nil {
+		return v1alpha1.Injected, err
+	}
+
+	return v1alpha1.NotInjected, nil
+}
+
+func (impl *Impl) evictPods(ctx context.Context, nodeName string, gracePeriod *int64) error {
+	var podList v1.PodList
+	if err := impl.reader.List(ctx, &podList, client.MatchingFields{"spec.nodeName": nodeName}); err != nil {
+		return errors.Wrapf(err, "list pods on node %s", nodeName)
+	}
+
+	var blocked []string
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if !shouldEvict(pod) {
+			continue
+		}
+
+		eviction := &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+			},
+		}
+		if gracePeriod != nil && *gracePeriod >= 0 {
+			eviction.DeleteOptions = &metav1.DeleteOptions{
+				GracePeriodSeconds: gracePeriod,
+			}
+		}
+
+		err := impl.SubResource("eviction").Create(ctx, pod, eviction)
+		if err == nil || apierrors.IsNotFound(err) {
+			continue
+		}
+		if apierrors.IsTooManyRequests(err) {
+			// the eviction would violate a PodDisruptionBudget
+			blocked = append(blocked, pod.Namespace+"/"+pod.Name)
+			continue
+		}
+		return errors.Wrapf(err, "evict pod %s/%s", pod.Namespace, pod.Name)
+	}
+
+	if len(blocked) > 0 {
+		return errors.Wrapf(errEvictionBlocked, "pods %v", blocked)
+	}
+	return nil
+}
+
+// Refresh does nothing, the drained node is kept cordoned until it's recovered
+func (impl *Impl) Refresh(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (bool, error) {
+	return false, nil
+}
+
+// RequeueAfter returns the interval to retry the evictions blocked by PodDisruptionBudgets
+func (impl *Impl) RequeueAfter(records []*v1alpha1.Record, obj v1alpha1.InnerObject) time.Duration {
+	for _, record := range records {
+		if record.Phase == waitForEviction {
+			return evictionRetryInterval
+		}
+	}
+	return 0
+}
+
+// shouldEvict returns false for the pods which are ignored by `kubectl drain`
+func shouldEvict(pod *v1.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
+	if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+		return false
+	}
+	// the mirror pods of static pods could not be evicted
+	if _, ok := pod.Annotations[v1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	// the pods of DaemonSets would be recreated on the node immediately
+	if owner := metav1.GetControllerOf(pod); owner != nil && owner.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
+
+type Params struct {
+	fx.In
+
+	Client client.Client
+	Reader client.Reader `name:"no-cache"`
+	Logger logr.Logger
+}
+
+func NewImpl(params Params) *Impl {
+	return &Impl{
+		Client: params.Client,
+		Log:    params.Logger.WithName("drain"),
+		reader: params.Reader,
+	}
+}
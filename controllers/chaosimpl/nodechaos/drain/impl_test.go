@@ -0,0 +1,316 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package drain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/annotation"
+)
+
+func newPod(name string, nodeName string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1.PodSpec{
+			NodeName: nodeName,
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+		},
+	}
+}
+
+func TestShouldEvict(t *testing.T) {
+	g := NewWithT(t)
+
+	normal := newPod("normal", "n0")
+	g.Expect(shouldEvict(normal)).To(BeTrue())
+
+	succeeded := newPod("succeeded", "n0")
+	succeeded.Status.Phase = v1.PodSucceeded
+	g.Expect(shouldEvict(succeeded)).To(BeFalse())
+
+	mirror := newPod("mirror", "n0")
+	mirror.Annotations = map[string]string{v1.MirrorPodAnnotationKey: "mirror"}
+	g.Expect(shouldEvict(mirror)).To(BeFalse())
+
+	controller := true
+	daemon := newPod("daemon", "n0")
+	daemon.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: "apps/v1",
+			Kind:       "DaemonSet",
+			Name:       "daemon",
+			Controller: &controller,
+		},
+	}
+	g.Expect(shouldEvict(daemon)).To(BeFalse())
+}
+
+func TestApplyAndRecover(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "n0",
+		},
+	}
+	mirror := newPod("mirror", "n0")
+	mirror.Annotations = map[string]string{v1.MirrorPodAnnotationKey: "mirror"}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(node, newPod("p0", "n0"), newPod("p1", "n1"), mirror).
+		WithIndex(&v1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+			return []string{obj.(*v1.Pod).Spec.NodeName}
+		}).
+		Build()
+
+	impl := NewImpl(Params{
+		Client: c,
+		Reader: c,
+		Logger: logr.Discard(),
+	})
+
+	nodechaos := &v1alpha1.NodeChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "drain",
+			UID:  "uid",
+		},
+		Spec: v1alpha1.NodeChaosSpec{
+			Action: v1alpha1.NodeDrainAction,
+		},
+	}
+	records := []*v1alpha1.Record{{Id: "n0"}}
+
+	phase, err := impl.Apply(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.Injected))
+
+	var got v1.Node
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "n0"}, &got)).To(Succeed())
+	g.Expect(got.Spec.Unschedulable).To(BeTrue())
+	g.Expect(got.Annotations).To(HaveKey(annotation.GenKeyForNodeChaos(nodechaos)))
+
+	var pods v1.PodList
+	g.Expect(c.List(ctx, &pods)).To(Succeed())
+	var names []string
+	for _, pod := range pods.Items {
+		names = append(names, pod.Name)
+	}
+	g.Expect(names).To(ConsistOf("p1", "mirror"))
+
+	phase, err = impl.Recover(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "n0"}, &got)).To(Succeed())
+	g.Expect(got.Spec.Unschedulable).To(BeFalse())
+	g.Expect(got.Annotations).NotTo(HaveKey(annotation.GenKeyForNodeChaos(nodechaos)))
+}
+
+func TestKeepCordonedNode(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "n0",
+		},
+		Spec: v1.NodeSpec{
+			Unschedulable: true,
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(node).
+		WithIndex(&v1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+			return []string{obj.(*v1.Pod).Spec.NodeName}
+		}).
+		Build()
+
+	impl := NewImpl(Params{
+		Client: c,
+		Reader: c,
+		Logger: logr.Discard(),
+	})
+
+	nodechaos := &v1alpha1.NodeChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "drain",
+			UID:  "uid",
+		},
+	}
+	records := []*v1alpha1.Record{{Id: "n0"}}
+
+	_, err := impl.Apply(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	_, err = impl.Recover(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	var got v1.Node
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "n0"}, &got)).To(Succeed())
+	g.Expect(got.Spec.Unschedulable).To(BeTrue())
+}
+
+func TestRetryBlockedEviction(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "n0",
+		},
+	}
+	// the eviction of the pod is rejected as it would violate a PodDisruptionBudget
+	blocked := true
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(node, newPod("p0", "n0")).
+		WithIndex(&v1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+			return []string{obj.(*v1.Pod).Spec.NodeName}
+		}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+				if subResourceName == "eviction" && blocked {
+					return apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10)
+				}
+				return c.SubResource(subResourceName).Create(ctx, obj, subResource, opts...)
+			},
+		}).
+		Build()
+
+	impl := NewImpl(Params{
+		Client: c,
+		Reader: c,
+		Logger: logr.Discard(),
+	})
+
+	nodechaos := &v1alpha1.NodeChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "drain",
+			UID:  "uid",
+		},
+		Spec: v1alpha1.NodeChaosSpec{
+			Action: v1alpha1.NodeDrainAction,
+		},
+	}
+	nodechaos.Status.Experiment.DesiredPhase = v1alpha1.RunningPhase
+	records := []*v1alpha1.Record{{Id: "n0"}}
+
+	phase, err := impl.Apply(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(waitForEviction))
+	records[0].Phase = phase
+	g.Expect(impl.RequeueAfter(records, nodechaos)).To(Equal(evictionRetryInterval))
+
+	var got v1.Node
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "n0"}, &got)).To(Succeed())
+	g.Expect(got.Spec.Unschedulable).To(BeTrue())
+
+	// the eviction is retried until it's allowed
+	phase, err = impl.Apply(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(waitForEviction))
+
+	blocked = false
+	phase, err = impl.Apply(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.Injected))
+	records[0].Phase = phase
+	g.Expect(impl.RequeueAfter(records, nodechaos)).To(BeZero())
+
+	var pods v1.PodList
+	g.Expect(c.List(ctx, &pods)).To(Succeed())
+	g.Expect(pods.Items).To(BeEmpty())
+}
+
+func TestStopWhileEvictionBlocked(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "n0",
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(node, newPod("p0", "n0")).
+		WithIndex(&v1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+			return []string{obj.(*v1.Pod).Spec.NodeName}
+		}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+				return apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10)
+			},
+		}).
+		Build()
+
+	impl := NewImpl(Params{
+		Client: c,
+		Reader: c,
+		Logger: logr.Discard(),
+	})
+
+	nodechaos := &v1alpha1.NodeChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "drain",
+			UID:  "uid",
+		},
+		Spec: v1alpha1.NodeChaosSpec{
+			Action: v1alpha1.NodeDrainAction,
+		},
+	}
+	nodechaos.Status.Experiment.DesiredPhase = v1alpha1.RunningPhase
+	records := []*v1alpha1.Record{{Id: "n0"}}
+
+	phase, err := impl.Apply(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(waitForEviction))
+	records[0].Phase = phase
+
+	// the cordoned node is recovered once the duration ends
+	nodechaos.Status.Experiment.DesiredPhase = v1alpha1.StoppedPhase
+	phase, err = impl.Apply(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.Injected))
+
+	phase, err = impl.Recover(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+
+	var got v1.Node
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "n0"}, &got)).To(Succeed())
+	g.Expect(got.Spec.Unschedulable).To(BeFalse())
+}
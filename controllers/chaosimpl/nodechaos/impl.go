@@ -0,0 +1,54 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package nodechaos
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/action"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/nodechaos/drain"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/nodechaos/kubeletstop"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/nodechaos/taint"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+)
+
+type Impl struct {
+	fx.In
+
+	Drain       *drain.Impl       `action:"drain"`
+	Taint       *taint.Impl       `action:"taint"`
+	KubeletStop *kubeletstop.Impl `action:"kubelet-stop"`
+}
+
+func NewImpl(impl Impl) *impltypes.ChaosImplPair {
+	delegate := action.NewMultiplexer(&impl)
+	return &impltypes.ChaosImplPair{
+		Name:   "nodechaos",
+		Object: &v1alpha1.NodeChaos{},
+		Impl:   &delegate,
+	}
+}
+
+var Module = fx.Provide(
+	fx.Annotated{
+		Group:  "impl",
+		Target: NewImpl,
+	},
+	drain.NewImpl,
+	taint.NewImpl,
+	kubeletstop.NewImpl,
+)
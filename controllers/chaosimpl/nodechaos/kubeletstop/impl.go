@@ -0,0 +1,98 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package kubeletstop
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+type Impl struct {
+	client.Client
+
+	Log logr.Logger
+
+	builder *chaosdaemon.ChaosDaemonClientBuilder
+}
+
+// Apply stops the kubelet through the chaos-daemon on the node. The chaos-daemon also schedules
+// a timer on the node to start the kubelet after the duration, in case the chaos is never recovered.
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	nodechaos := obj.(*v1alpha1.NodeChaos)
+
+	duration, err := nodechaos.Spec.GetDuration()
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+	if duration == nil {
+		return v1alpha1.NotInjected, errors.New("duration is required to stop kubelet")
+	}
+
+	pbClient, err := impl.builder.BuildForNode(ctx, records[index].Id, &types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	})
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+	defer pbClient.Close()
+
+	if _, err = pbClient.StopKubelet(ctx, &pb.StopKubeletRequest{
+		DurationMs: duration.Milliseconds(),
+	}); err != nil {
+		impl.Log.Error(err, "stop kubelet", "node", records[index].Id)
+		return v1alpha1.NotInjected, err
+	}
+
+	return v1alpha1.Injected, nil
+}
+
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	pbClient, err := impl.builder.BuildForNode(ctx, records[index].Id, &types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	})
+	if err != nil {
+		return v1alpha1.Injected, err
+	}
+	defer pbClient.Close()
+
+	if _, err = pbClient.RecoverKubelet(ctx, &pb.RecoverKubeletRequest{}); err != nil {
+		impl.Log.Error(err, "recover kubelet", "node", records[index].Id)
+		return v1alpha1.Injected, err
+	}
+
+	return v1alpha1.NotInjected, nil
+}
+
+func NewImpl(c client.Client, log logr.Logger, builder *chaosdaemon.ChaosDaemonClientBuilder) *Impl {
+	return &Impl{
+		Client:  c,
+		Log:     log.WithName("kubeletstop"),
+		builder: builder,
+	}
+}
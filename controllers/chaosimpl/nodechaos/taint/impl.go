@@ -0,0 +1,106 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package taint
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/pkg/annotation"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+type Impl struct {
+	client.Client
+
+	Log logr.Logger
+}
+
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	nodechaos := obj.(*v1alpha1.NodeChaos)
+
+	var node v1.Node
+	if err := impl.Get(ctx, types.NamespacedName{Name: records[index].Id}, &node); err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	// if the node has been tainted, the taint is not added by this chaos and should be kept as it is
+	taint := nodechaos.Spec.Taint
+	for i := range node.Spec.Taints {
+		if node.Spec.Taints[i].MatchTaint(taint) {
+			return v1alpha1.Injected, nil
+		}
+	}
+
+	origin := node.DeepCopy()
+	node.Spec.Taints = append(node.Spec.Taints, *taint)
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	node.Annotations[annotation.GenKeyForNodeChaos(nodechaos)] = string(nodechaos.Spec.Action)
+	if err := impl.Patch(ctx, &node, client.MergeFrom(origin)); err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	return v1alpha1.Injected, nil
+}
+
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	nodechaos := obj.(*v1alpha1.NodeChaos)
+
+	var node v1.Node
+	if err := impl.Get(ctx, types.NamespacedName{Name: records[index].Id}, &node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+
+	key := annotation.GenKeyForNodeChaos(nodechaos)
+	if _, ok := node.Annotations[key]; !ok {
+		return v1alpha1.NotInjected, nil
+	}
+
+	origin := node.DeepCopy()
+	taints := make([]v1.Taint, 0, len(node.Spec.Taints))
+	for _, taint := range node.Spec.Taints {
+		if !taint.MatchTaint(nodechaos.Spec.Taint) {
+			taints = append(taints, taint)
+		}
+	}
+	node.Spec.Taints = taints
+	delete(node.Annotations, key)
+	if err := impl.Patch(ctx, &node, client.MergeFrom(origin)); err != nil {
+		return v1alpha1.Injected, err
+	}
+
+	return v1alpha1.NotInjected, nil
+}
+
+func NewImpl(c client.Client, log logr.Logger) *Impl {
+	return &Impl{
+		Client: c,
+		Log:    log.WithName("taint"),
+	}
+}
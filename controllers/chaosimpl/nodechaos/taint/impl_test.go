@@ -0,0 +1,126 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package taint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/annotation"
+)
+
+func newNodeChaos(taint *v1.Taint) *v1alpha1.NodeChaos {
+	return &v1alpha1.NodeChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "taint",
+			UID:  "uid",
+		},
+		Spec: v1alpha1.NodeChaosSpec{
+			Action: v1alpha1.NodeTaintAction,
+			Taint:  taint,
+		},
+	}
+}
+
+func TestApplyAndRecover(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	other := v1.Taint{Key: "other", Value: "bar", Effect: v1.TaintEffectNoSchedule}
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "n0",
+		},
+		Spec: v1.NodeSpec{
+			Taints: []v1.Taint{other},
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(node).
+		Build()
+
+	impl := NewImpl(c, logr.Discard())
+
+	taint := v1.Taint{Key: "chaos", Value: "foo", Effect: v1.TaintEffectNoExecute}
+	nodechaos := newNodeChaos(&taint)
+	records := []*v1alpha1.Record{{Id: "n0"}}
+
+	phase, err := impl.Apply(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.Injected))
+
+	var got v1.Node
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "n0"}, &got)).To(Succeed())
+	g.Expect(got.Spec.Taints).To(ConsistOf(other, taint))
+	g.Expect(got.Annotations).To(HaveKeyWithValue(annotation.GenKeyForNodeChaos(nodechaos), string(v1alpha1.NodeTaintAction)))
+
+	phase, err = impl.Recover(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "n0"}, &got)).To(Succeed())
+	g.Expect(got.Spec.Taints).To(ConsistOf(other))
+	g.Expect(got.Annotations).NotTo(HaveKey(annotation.GenKeyForNodeChaos(nodechaos)))
+}
+
+func TestKeepExistingTaint(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	taint := v1.Taint{Key: "chaos", Value: "foo", Effect: v1.TaintEffectNoExecute}
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "n0",
+		},
+		Spec: v1.NodeSpec{
+			Taints: []v1.Taint{taint},
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(node).
+		Build()
+
+	impl := NewImpl(c, logr.Discard())
+
+	nodechaos := newNodeChaos(&taint)
+	records := []*v1alpha1.Record{{Id: "n0"}}
+
+	phase, err := impl.Apply(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.Injected))
+
+	var got v1.Node
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "n0"}, &got)).To(Succeed())
+	g.Expect(got.Annotations).NotTo(HaveKey(annotation.GenKeyForNodeChaos(nodechaos)))
+
+	phase, err = impl.Recover(ctx, 0, records, nodechaos)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "n0"}, &got)).To(Succeed())
+	g.Expect(got.Spec.Taints).To(ConsistOf(taint))
+}
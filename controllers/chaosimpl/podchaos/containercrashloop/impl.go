@@ -0,0 +1,167 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package containercrashloop
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+type Impl struct {
+	client.Client
+
+	Log logr.Logger
+
+	decoder *utils.ContainerRecordDecoder
+}
+
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
+	pbClient := decodedContainer.PbClient
+	containerId := decodedContainer.ContainerId
+	if pbClient != nil {
+		defer pbClient.Close()
+	}
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	podchaos := obj.(*v1alpha1.PodChaos)
+	if podchaos.Spec.CrashLoopInterval == nil {
+		return v1alpha1.NotInjected, errors.New("crashLoopInterval is required")
+	}
+	interval, err := time.ParseDuration(*podchaos.Spec.CrashLoopInterval)
+	if err != nil {
+		return v1alpha1.NotInjected, errors.Wrap(err, "parse crashLoopInterval")
+	}
+
+	if _, err = pbClient.ContainerKill(ctx, &pb.ContainerRequest{
+		Action: &pb.ContainerAction{
+			Action: pb.ContainerAction_KILL,
+		},
+		ContainerId: containerId,
+	}); err != nil {
+		impl.Log.Error(err, "kill container error", "containerID", containerId)
+		return v1alpha1.NotInjected, err
+	}
+
+	if _, err = pbClient.StartCrashLoop(ctx, &pb.CrashLoopRequest{
+		PodUid:        string(decodedContainer.Pod.UID),
+		ContainerName: decodedContainer.ContainerName,
+		IntervalMs:    interval.Milliseconds(),
+	}); err != nil {
+		impl.Log.Error(err, "start crash loop error", "containerID", containerId)
+		return v1alpha1.NotInjected, err
+	}
+
+	if podchaos.Status.CrashLoops == nil {
+		podchaos.Status.CrashLoops = make(map[string]v1alpha1.PodCrashLoopInstance)
+	}
+	podchaos.Status.CrashLoops[records[index].Id] = v1alpha1.PodCrashLoopInstance{
+		NodeName: decodedContainer.Pod.Spec.NodeName,
+		PodUID:   string(decodedContainer.Pod.UID),
+	}
+
+	return v1alpha1.Injected, nil
+}
+
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	podchaos := obj.(*v1alpha1.PodChaos)
+	if instance, ok := podchaos.Status.CrashLoops[records[index].Id]; ok {
+		// the crash loop keeps running on the node even if the pod has gone or been recreated,
+		// so it's stopped through the chaos-daemon on the recorded node
+		return impl.stopCrashLoop(ctx, records[index], podchaos, instance)
+	}
+
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
+	pbClient := decodedContainer.PbClient
+	if pbClient != nil {
+		defer pbClient.Close()
+	}
+	if err != nil {
+		if errors.Is(err, utils.ErrContainerNotFound) {
+			// pretend the disappeared container has been recovered
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+
+	if _, err = pbClient.StopCrashLoop(ctx, &pb.CrashLoopRequest{
+		PodUid:        string(decodedContainer.Pod.UID),
+		ContainerName: decodedContainer.ContainerName,
+	}); err != nil {
+		impl.Log.Error(err, "stop crash loop error", "pod", decodedContainer.Pod.UID)
+		return v1alpha1.Injected, err
+	}
+
+	return v1alpha1.NotInjected, nil
+}
+
+func (impl *Impl) stopCrashLoop(ctx context.Context, record *v1alpha1.Record, podchaos *v1alpha1.PodChaos, instance v1alpha1.PodCrashLoopInstance) (v1alpha1.Phase, error) {
+	_, containerName, err := controller.ParseNamespacedNameContainer(record.Id)
+	if err != nil {
+		return v1alpha1.Injected, err
+	}
+
+	pbClient, err := impl.decoder.BuildForNode(ctx, instance.NodeName, &types.NamespacedName{
+		Namespace: podchaos.Namespace,
+		Name:      podchaos.Name,
+	})
+	if err != nil {
+		var node v1.Node
+		if getErr := impl.Get(ctx, types.NamespacedName{Name: instance.NodeName}, &node); apierrors.IsNotFound(getErr) {
+			// the crash loop has gone with the node
+			delete(podchaos.Status.CrashLoops, record.Id)
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+	defer pbClient.Close()
+
+	if _, err = pbClient.StopCrashLoop(ctx, &pb.CrashLoopRequest{
+		PodUid:        instance.PodUID,
+		ContainerName: containerName,
+	}); err != nil {
+		impl.Log.Error(err, "stop crash loop error", "pod", instance.PodUID, "node", instance.NodeName)
+		return v1alpha1.Injected, err
+	}
+
+	delete(podchaos.Status.CrashLoops, record.Id)
+	return v1alpha1.NotInjected, nil
+}
+
+func NewImpl(c client.Client, log logr.Logger, decoder *utils.ContainerRecordDecoder) *Impl {
+	return &Impl{
+		Client:  c,
+		Log:     log.WithName("containercrashloop"),
+		decoder: decoder,
+	}
+}
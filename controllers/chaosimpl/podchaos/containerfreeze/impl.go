@@ -0,0 +1,104 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package containerfreeze
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+type Impl struct {
+	client.Client
+
+	Log logr.Logger
+
+	decoder *utils.ContainerRecordDecoder
+}
+
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
+	pbClient := decodedContainer.PbClient
+	containerId := decodedContainer.ContainerId
+	if pbClient != nil {
+		defer pbClient.Close()
+	}
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	podchaos := obj.(*v1alpha1.PodChaos)
+	if _, err = pbClient.FreezeContainer(ctx, &pb.FreezeContainerRequest{
+		ContainerId: containerId,
+		Method:      freezeMethod(podchaos.Spec.FreezeMethod),
+	}); err != nil {
+		impl.Log.Error(err, "freeze container error", "containerID", containerId)
+		return v1alpha1.NotInjected, err
+	}
+
+	return v1alpha1.Injected, nil
+}
+
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
+	pbClient := decodedContainer.PbClient
+	containerId := decodedContainer.ContainerId
+	if pbClient != nil {
+		defer pbClient.Close()
+	}
+	if err != nil {
+		if errors.Is(err, utils.ErrContainerNotFound) {
+			// pretend the disappeared container has been recovered
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+
+	podchaos := obj.(*v1alpha1.PodChaos)
+	if _, err = pbClient.UnfreezeContainer(ctx, &pb.FreezeContainerRequest{
+		ContainerId: containerId,
+		Method:      freezeMethod(podchaos.Spec.FreezeMethod),
+	}); err != nil {
+		impl.Log.Error(err, "unfreeze container error", "containerID", containerId)
+		return v1alpha1.Injected, err
+	}
+
+	return v1alpha1.NotInjected, nil
+}
+
+func freezeMethod(method v1alpha1.FreezeMethod) pb.FreezeContainerRequest_Method {
+	if method == v1alpha1.SigstopFreezeMethod {
+		return pb.FreezeContainerRequest_SIGSTOP
+	}
+	return pb.FreezeContainerRequest_CGROUP
+}
+
+func NewImpl(c client.Client, log logr.Logger, decoder *utils.ContainerRecordDecoder) *Impl {
+	return &Impl{
+		Client:  c,
+		Log:     log.WithName("containerfreeze"),
+		decoder: decoder,
+	}
+}
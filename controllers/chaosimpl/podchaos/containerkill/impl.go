@@ -48,11 +48,14 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		return v1alpha1.NotInjected, err
 	}
 
+	podchaos := obj.(*v1alpha1.PodChaos)
 	if _, err = pbClient.ContainerKill(ctx, &pb.ContainerRequest{
 		Action: &pb.ContainerAction{
 			Action: pb.ContainerAction_KILL,
 		},
 		ContainerId: containerId,
+		Signal:      podchaos.Spec.Signal,
+		GracePeriod: podchaos.Spec.GracePeriod,
 	}); err != nil {
 		impl.Log.Error(err, "kill container error", "containerID", containerId)
 		return v1alpha1.NotInjected, err
@@ -20,6 +20,8 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/action"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/podchaos/containercrashloop"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/podchaos/containerfreeze"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/podchaos/containerkill"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/podchaos/podfailure"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/podchaos/podkill"
@@ -29,9 +31,11 @@ import (
 type Impl struct {
 	fx.In
 
-	PodKill       *podkill.Impl       `action:"pod-kill"`
-	PodFailure    *podfailure.Impl    `action:"pod-failure"`
-	ContainerKill *containerkill.Impl `action:"container-kill"`
+	PodKill            *podkill.Impl            `action:"pod-kill"`
+	PodFailure         *podfailure.Impl         `action:"pod-failure"`
+	ContainerKill      *containerkill.Impl      `action:"container-kill"`
+	ContainerFreeze    *containerfreeze.Impl    `action:"container-freeze"`
+	ContainerCrashLoop *containercrashloop.Impl `action:"container-crash-loop"`
 }
 
 func NewImpl(impl Impl) *impltypes.ChaosImplPair {
@@ -51,4 +55,6 @@ var Module = fx.Provide(
 	podkill.NewImpl,
 	podfailure.NewImpl,
 	containerkill.NewImpl,
+	containerfreeze.NewImpl,
+	containercrashloop.NewImpl,
 )
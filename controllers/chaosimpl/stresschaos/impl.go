@@ -33,6 +33,11 @@ import (
 
 var _ impltypes.ChaosImpl = (*Impl)(nil)
 
+// oomProtectedScoreAdj is the oom_score_adj of the memory stressor when TriggerOOM is set.
+// It's not -1000, which disables the OOM killer for the stressor completely, so that the
+// kernel could still reclaim the memory if the stressor is the only process left.
+const oomProtectedScoreAdj = -999
+
 type Impl struct {
 	client.Client
 
@@ -82,7 +87,17 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		EnterNS:         true,
 	}
 	if stresschaos.Spec.Stressors != nil && stresschaos.Spec.Stressors.MemoryStressor != nil {
-		req.OomScoreAdj = int32(stresschaos.Spec.Stressors.MemoryStressor.OOMScoreAdj)
+		memoryStressor := stresschaos.Spec.Stressors.MemoryStressor
+		req.OomScoreAdj = int32(memoryStressor.OOMScoreAdj)
+		req.MemoryLimitPercent = int32(memoryStressor.LimitPercent)
+		if memoryStressor.TriggerOOM {
+			// protect the stressor, so the OOM killer will kill the application
+			// process in the same cgroup
+			req.OomScoreAdj = oomProtectedScoreAdj
+			if req.MemoryLimitPercent == 0 {
+				req.MemoryLimitPercent = 100
+			}
+		}
 	}
 	res, err := pbClient.ExecStressors(ctx, &req)
 
@@ -90,7 +105,7 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		return v1alpha1.NotInjected, err
 	}
 	// TODO: support custom status
-	stresschaos.Status.Instances[records[index].Id] = v1alpha1.StressInstance{
+	instance := v1alpha1.StressInstance{
 		UID: res.CpuInstance,
 		StartTime: &metav1.Time{
 			Time: time.Unix(res.CpuStartTime/1000, (res.CpuStartTime%1000)*int64(time.Millisecond)),
@@ -100,6 +115,10 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 			Time: time.Unix(res.MemoryStartTime/1000, (res.MemoryStartTime%1000)*int64(time.Millisecond)),
 		},
 	}
+	if len(stressors) == 0 {
+		instance.Stressors = stresschaos.Spec.Stressors.Names()
+	}
+	stresschaos.Status.Instances[records[index].Id] = instance
 
 	return v1alpha1.Injected, nil
 }
@@ -0,0 +1,118 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timechaos
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/config"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/builder"
+)
+
+// driftStatusInterval is the interval to refresh the effective skew of a drifting TimeChaos
+const driftStatusInterval = 30 * time.Second
+
+// DriftStatusReconciler refreshes the effective skew of the injected instances of a drifting TimeChaos
+type DriftStatusReconciler struct {
+	client.Client
+	Log logr.Logger
+}
+
+// Reconcile is also triggered by the updates of itself, so the status is only updated once the effective skew
+// is older than driftStatusInterval.
+func (r *DriftStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var requeueAfter time.Duration
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		requeueAfter = 0
+		timechaos := &v1alpha1.TimeChaos{}
+		if err := r.Client.Get(ctx, req.NamespacedName, timechaos); err != nil {
+			return err
+		}
+		if timechaos.Spec.DriftRate == 0 || len(timechaos.Status.Instances) == 0 {
+			return nil
+		}
+
+		now := time.Now()
+		if observed := lastObservedTime(timechaos); now.Sub(observed) < driftStatusInterval {
+			requeueAfter = driftStatusInterval - now.Sub(observed)
+			return nil
+		}
+		if !refreshEffectiveSkew(timechaos, now) {
+			return nil
+		}
+		requeueAfter = driftStatusInterval
+		return r.Client.Update(ctx, timechaos)
+	})
+	if client.IgnoreNotFound(updateError) != nil {
+		r.Log.Error(updateError, "fail to refresh effective skew", "timechaos", req.NamespacedName)
+		return ctrl.Result{}, updateError
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// lastObservedTime returns the earliest time when the effective skew of the instances is observed
+func lastObservedTime(timechaos *v1alpha1.TimeChaos) time.Time {
+	var observed time.Time
+	for _, instance := range timechaos.Status.Instances {
+		if instance.ObservedTime == nil {
+			return time.Time{}
+		}
+		if observed.IsZero() || instance.ObservedTime.Time.Before(observed) {
+			observed = instance.ObservedTime.Time
+		}
+	}
+	return observed
+}
+
+// refreshEffectiveSkew sets the effective skew of every injected instance at the given moment, it returns false
+// if there is no injected instance
+func refreshEffectiveSkew(timechaos *v1alpha1.TimeChaos, now time.Time) bool {
+	refreshed := false
+	for id, instance := range timechaos.Status.Instances {
+		skew, ok, err := timechaos.EffectiveSkew(id, now)
+		if err != nil || !ok {
+			continue
+		}
+		instance.EffectiveSkew = skew.String()
+		instance.ObservedTime = &metav1.Time{Time: now}
+		timechaos.Status.Instances[id] = instance
+		refreshed = true
+	}
+	return refreshed
+}
+
+func BootstrapDriftStatus(mgr ctrl.Manager, client client.Client, logger logr.Logger) error {
+	if !config.ShouldSpawnController("timechaos-drift") {
+		return nil
+	}
+
+	return builder.Default(mgr).
+		For(&v1alpha1.TimeChaos{}).
+		Named("timechaos-drift").
+		Complete(&DriftStatusReconciler{
+			Client: client,
+			Log:    logger.WithName("timechaos-drift"),
+		})
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package timechaos
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func Test_refreshEffectiveSkew(t *testing.T) {
+	g := NewWithT(t)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	timechaos := &v1alpha1.TimeChaos{
+		Spec: v1alpha1.TimeChaosSpec{TimeOffset: "-10s", DriftRate: 1000},
+		Status: v1alpha1.TimeChaosStatus{Instances: map[string]v1alpha1.TimeSkewInstance{
+			"default/app-0/main": {StartTime: &metav1.Time{Time: start}},
+			"default/app-1/main": {StartTime: &metav1.Time{Time: start.Add(100 * time.Second)}},
+		}},
+	}
+	g.Expect(lastObservedTime(timechaos).IsZero()).To(BeTrue())
+
+	now := start.Add(200 * time.Second)
+	g.Expect(refreshEffectiveSkew(timechaos, now)).To(BeTrue())
+	g.Expect(timechaos.Status.Instances["default/app-0/main"].EffectiveSkew).To(Equal("-9.8s"))
+	g.Expect(timechaos.Status.Instances["default/app-1/main"].EffectiveSkew).To(Equal("-9.9s"))
+	g.Expect(lastObservedTime(timechaos)).To(Equal(now))
+
+	g.Expect(refreshEffectiveSkew(&v1alpha1.TimeChaos{}, now)).To(BeFalse())
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"go.uber.org/fx"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
@@ -57,14 +58,27 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		return v1alpha1.NotInjected, err
 	}
 
-	duration, err := time.ParseDuration(timechaos.Spec.TimeOffset)
-	if err != nil {
-		return v1alpha1.NotInjected, err
+	var duration time.Duration
+	if len(timechaos.Spec.TimeOffset) != 0 {
+		duration, err = time.ParseDuration(timechaos.Spec.TimeOffset)
+		if err != nil {
+			return v1alpha1.NotInjected, err
+		}
 	}
 
 	sec, nsec := secAndNSecFromDuration(duration)
 
-	impl.Log.Info("setting time shift", "mask", mask, "sec", sec, "nsec", nsec, "containerId", containerId)
+	// the drift of an instance which is applied again keeps starting from the recorded start time
+	startTime := time.Now()
+	if instance, ok := timechaos.Status.Instances[records[index].Id]; ok && instance.StartTime != nil {
+		startTime = instance.StartTime.Time
+	}
+	var driftStart int64
+	if timechaos.Spec.DriftRate != 0 {
+		driftStart = startTime.UnixNano()
+	}
+
+	impl.Log.Info("setting time shift", "mask", mask, "sec", sec, "nsec", nsec, "driftRate", timechaos.Spec.DriftRate, "containerId", containerId)
 	_, err = pbClient.SetTimeOffset(ctx, &pb.TimeRequest{
 		ContainerId:      containerId,
 		Sec:              sec,
@@ -72,11 +86,21 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		ClkIdsMask:       mask,
 		Uid:              string(obj.GetUID()) + string(decodedContainer.Pod.GetUID()),
 		PodContainerName: fmt.Sprintf("%s:%s", decodedContainer.Pod.GetUID(), decodedContainer.ContainerName),
+		DriftRate:        timechaos.Spec.DriftRate,
+		DriftStart:       driftStart,
 	})
 	if err != nil {
 		return v1alpha1.NotInjected, err
 	}
 
+	if timechaos.Status.Instances == nil {
+		timechaos.Status.Instances = make(map[string]v1alpha1.TimeSkewInstance)
+	}
+	timechaos.Status.Instances[records[index].Id] = v1alpha1.TimeSkewInstance{
+		StartTime: &metav1.Time{Time: startTime},
+	}
+	refreshEffectiveSkew(timechaos, time.Now())
+
 	return v1alpha1.Injected, nil
 }
 
@@ -105,6 +129,9 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 		return v1alpha1.Injected, err
 	}
 
+	timechaos := obj.(*v1alpha1.TimeChaos)
+	delete(timechaos.Status.Instances, records[index].Id)
+
 	return v1alpha1.NotInjected, nil
 }
 
@@ -127,9 +154,12 @@ func NewImpl(c client.Client, log logr.Logger, decoder *utils.ContainerRecordDec
 	}
 }
 
-var Module = fx.Provide(
-	fx.Annotated{
-		Group:  "impl",
-		Target: NewImpl,
-	},
+var Module = fx.Options(
+	fx.Provide(
+		fx.Annotated{
+			Group:  "impl",
+			Target: NewImpl,
+		},
+	),
+	fx.Invoke(BootstrapDriftStatus),
 )
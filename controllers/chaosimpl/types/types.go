@@ -17,6 +17,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -28,6 +29,18 @@ type ChaosImpl interface {
 	Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error)
 }
 
+// ChaosImplWithRequeue is implemented by the chaos impls which need to reconcile the records again after a
+// while, even if nothing changes. For example, to wait for an injection which takes effect asynchronously
+// in a "Not Injected/*" phase, or to refresh the status of the injected records.
+type ChaosImplWithRequeue interface {
+	ChaosImpl
+
+	// Refresh is called for the injected records of a running chaos, it returns whether the status is changed
+	Refresh(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (bool, error)
+	// RequeueAfter returns the duration after which the records should be reconciled again, 0 means never
+	RequeueAfter(records []*v1alpha1.Record, obj v1alpha1.InnerObject) time.Duration
+}
+
 type ChaosImplPair struct {
 	Name   string
 	Object v1alpha1.InnerObjectWithSelector
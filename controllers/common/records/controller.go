@@ -19,6 +19,7 @@ import (
 	"context"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -220,9 +221,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 					Id: records[index].Id,
 				})
 			}
+		} else if desiredPhase == v1alpha1.RunningPhase && originalPhase == v1alpha1.Injected {
+			if impl, ok := r.Impl.(types.ChaosImplWithRequeue); ok {
+				changed, err := impl.Refresh(context.TODO(), index, records, obj)
+				if err != nil {
+					// the record is still injected, so the failure is not recorded as an event
+					idLogger.Error(err, "fail to refresh chaos")
+				}
+				if changed {
+					shouldUpdate = true
+				}
+			}
 		}
 	}
 
+	var requeueAfter time.Duration
+	if impl, ok := r.Impl.(types.ChaosImplWithRequeue); ok && !needRetry {
+		requeueAfter = impl.RequeueAfter(records, obj)
+	}
+
 	// TODO: auto generate SetCustomStatus rather than reflect
 	var customStatus reflect.Value
 	if objWithStatus, ok := obj.(v1alpha1.InnerObjectWithCustomStatus); ok {
@@ -259,7 +276,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			Field: "records",
 		})
 	}
-	return ctrl.Result{Requeue: needRetry}, nil
+	return ctrl.Result{Requeue: needRetry, RequeueAfter: requeueAfter}, nil
 }
 
 func newRecordEvent(eventType v1alpha1.RecordEventType, eventStage v1alpha1.RecordEventOperation, msg string) *v1alpha1.RecordEvent {
@@ -97,6 +97,12 @@ func validate(logger logr.Logger, criteria v1alpha1.HTTPCriteria, resp response)
 	return ok, "", nil
 }
 
+// MatchStatusCode returns true if the status code satisfies the criteria, the format of criteria is the same as
+// v1alpha1.HTTPCriteria.
+func MatchStatusCode(criteria string, statusCode int) bool {
+	return validateStatusCode(criteria, response{statusCode: statusCode})
+}
+
 // validateStatusCode validate whether the result is as expected.
 // A criteria(statusCode) string could be a single code (e.g. 200), or
 // an inclusive range (e.g. 200-400, both `200` and `400` are included).
@@ -50,6 +50,22 @@ func (c *MockChaosDaemonClient) ContainerGetPid(ctx context.Context, in *chaosda
 	return nil, mockError("ContainerGetPid")
 }
 
+func (c *MockChaosDaemonClient) FreezeContainer(ctx context.Context, in *chaosdaemon.FreezeContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("FreezeContainer")
+}
+
+func (c *MockChaosDaemonClient) UnfreezeContainer(ctx context.Context, in *chaosdaemon.FreezeContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("UnfreezeContainer")
+}
+
+func (c *MockChaosDaemonClient) StartCrashLoop(ctx context.Context, in *chaosdaemon.CrashLoopRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("StartCrashLoop")
+}
+
+func (c *MockChaosDaemonClient) StopCrashLoop(ctx context.Context, in *chaosdaemon.CrashLoopRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("StopCrashLoop")
+}
+
 func mockError(name string) error {
 	if err := mock.On(fmt.Sprintf("Mock%sError", name)); err != nil {
 		return err.(error)
@@ -81,6 +97,14 @@ func (c *MockChaosDaemonClient) ApplyIOChaos(ctx context.Context, in *chaosdaemo
 	return nil, mockError("ApplyIOChaos")
 }
 
+func (c *MockChaosDaemonClient) ApplyDiskFill(ctx context.Context, in *chaosdaemon.ApplyDiskFillRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("ApplyDiskFill")
+}
+
+func (c *MockChaosDaemonClient) RecoverDiskFill(ctx context.Context, in *chaosdaemon.RecoverDiskFillRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RecoverDiskFill")
+}
+
 func (c *MockChaosDaemonClient) ApplyHttpChaos(ctx context.Context, in *chaosdaemon.ApplyHttpChaosRequest, opts ...grpc.CallOption) (*chaosdaemon.ApplyHttpChaosResponse, error) {
 	return nil, mockError("ApplyHttpChaos")
 }
@@ -89,6 +113,14 @@ func (c *MockChaosDaemonClient) SetDNSServer(ctx context.Context, in *chaosdaemo
 	return nil, mockError("SetDNSServer")
 }
 
+func (c *MockChaosDaemonClient) ApplyDNSChaos(ctx context.Context, in *chaosdaemon.ApplyDNSChaosRequest, opts ...grpc.CallOption) (*chaosdaemon.ApplyDNSChaosResponse, error) {
+	return nil, mockError("ApplyDNSChaos")
+}
+
+func (c *MockChaosDaemonClient) RecoverDNSChaos(ctx context.Context, in *chaosdaemon.RecoverDNSChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RecoverDNSChaos")
+}
+
 func (c *MockChaosDaemonClient) SetTcs(ctx context.Context, in *chaosdaemon.TcsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, mockError("SetTcs")
 }
@@ -105,6 +137,18 @@ func (c *MockChaosDaemonClient) UninstallJVMRules(ctx context.Context, in *chaos
 	return nil, mockError("UninstallJVMRules")
 }
 
+func (c *MockChaosDaemonClient) GetJVMRules(ctx context.Context, in *chaosdaemon.GetJVMRulesRequest, opts ...grpc.CallOption) (*chaosdaemon.GetJVMRulesResponse, error) {
+	return nil, mockError("GetJVMRules")
+}
+
+func (c *MockChaosDaemonClient) StopKubelet(ctx context.Context, in *chaosdaemon.StopKubeletRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("StopKubelet")
+}
+
+func (c *MockChaosDaemonClient) RecoverKubelet(ctx context.Context, in *chaosdaemon.RecoverKubeletRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RecoverKubelet")
+}
+
 func (c *MockChaosDaemonClient) ApplyBlockChaos(ctx context.Context, req *chaosdaemon.ApplyBlockChaosRequest, opts ...grpc.CallOption) (*chaosdaemon.ApplyBlockChaosResponse, error) {
 	return nil, mockError("ApplyBlockChaosRequest")
 }
@@ -112,3 +156,7 @@ func (c *MockChaosDaemonClient) ApplyBlockChaos(ctx context.Context, req *chaosd
 func (c *MockChaosDaemonClient) RecoverBlockChaos(ctx context.Context, req *chaosdaemon.RecoverBlockChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return &empty.Empty{}, nil
 }
+
+func (c *MockChaosDaemonClient) GetBlockDevice(ctx context.Context, req *chaosdaemon.GetBlockDeviceRequest, opts ...grpc.CallOption) (*chaosdaemon.GetBlockDeviceResponse, error) {
+	return nil, mockError("GetBlockDevice")
+}
@@ -148,6 +148,14 @@ var ChaosObjects = fx.Supply(
 			Object: &v1alpha1.BlockChaos{},
 		},
 	},
+
+	fx.Annotated{
+		Group: "objs",
+		Target: Object{
+			Name:   "nodechaos",
+			Object: &v1alpha1.NodeChaos{},
+		},
+	},
 )
 
 // WebhookObject only used for registration the
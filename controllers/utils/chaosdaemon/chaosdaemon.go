@@ -55,7 +55,10 @@ type ChaosDaemonClientBuilder struct {
 }
 
 func (b *ChaosDaemonClientBuilder) FindDaemonIP(ctx context.Context, pod *v1.Pod) (string, error) {
-	nodeName := pod.Spec.NodeName
+	return b.findDaemonIPOnNode(ctx, pod.Spec.NodeName)
+}
+
+func (b *ChaosDaemonClientBuilder) findDaemonIPOnNode(ctx context.Context, nodeName string) (string, error) {
 	log.Info("Creating client to chaos-daemon", "node", nodeName)
 
 	ns := config.ControllerCfg.Namespace
@@ -77,6 +80,11 @@ func (b *ChaosDaemonClientBuilder) FindDaemonIP(ctx context.Context, pod *v1.Pod
 // The `id` parameter is the namespacedName of current handling resource,
 // which will be printed in the log of the chaos-daemon
 func (b *ChaosDaemonClientBuilder) Build(ctx context.Context, pod *v1.Pod, id *types.NamespacedName) (chaosdaemonclient.ChaosDaemonClientInterface, error) {
+	return b.BuildForNode(ctx, pod.Spec.NodeName, id)
+}
+
+// BuildForNode will construct a ChaosDaemonClient to the chaos-daemon running on the node
+func (b *ChaosDaemonClientBuilder) BuildForNode(ctx context.Context, nodeName string, id *types.NamespacedName) (chaosdaemonclient.ChaosDaemonClientInterface, error) {
 	if cli := mock.On("MockChaosDaemonClient"); cli != nil {
 		return cli.(chaosdaemonclient.ChaosDaemonClientInterface), nil
 	}
@@ -84,7 +92,7 @@ func (b *ChaosDaemonClientBuilder) Build(ctx context.Context, pod *v1.Pod, id *t
 		return nil, err.(error)
 	}
 
-	daemonIP, err := b.FindDaemonIP(ctx, pod)
+	daemonIP, err := b.findDaemonIPOnNode(ctx, nodeName)
 	if err != nil {
 		return nil, err
 	}
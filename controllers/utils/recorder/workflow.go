@@ -374,6 +374,312 @@ func (it WorkflowAborted) Message() string {
 	return fmt.Sprintf("abort the node because workflow %s aborted", it.WorkflowName)
 }
 
+type RetryScheduled struct {
+	FailedNode string
+	Attempt    int
+}
+
+func (it RetryScheduled) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it RetryScheduled) Reason() string {
+	return v1alpha1.RetryScheduled
+}
+
+func (it RetryScheduled) Message() string {
+	return fmt.Sprintf("child node %s failed, attempt %d is scheduled", it.FailedNode, it.Attempt)
+}
+
+type ExitHandlersCreated struct {
+	Nodes []string
+}
+
+func (it ExitHandlersCreated) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it ExitHandlersCreated) Reason() string {
+	return v1alpha1.ExitHandlersCreated
+}
+
+func (it ExitHandlersCreated) Message() string {
+	return fmt.Sprintf("exit handler nodes created, %s", strings.Join(it.Nodes, ","))
+}
+
+type ApprovalDecided struct {
+	Action   string
+	Approver string
+	TimedOut bool
+}
+
+func (it ApprovalDecided) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it ApprovalDecided) Reason() string {
+	return v1alpha1.ApprovalDecided
+}
+
+func (it ApprovalDecided) Message() string {
+	if it.TimedOut {
+		return fmt.Sprintf("approval timed out, default action %s is taken", it.Action)
+	}
+	return fmt.Sprintf("approval decided, action %s by %s", it.Action, it.Approver)
+}
+
+type WorkflowPaused struct {
+}
+
+func (it WorkflowPaused) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it WorkflowPaused) Reason() string {
+	return v1alpha1.WorkflowPaused
+}
+
+func (it WorkflowPaused) Message() string {
+	return "workflow paused"
+}
+
+type WorkflowResumed struct {
+	PausedDuration string
+}
+
+func (it WorkflowResumed) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it WorkflowResumed) Reason() string {
+	return v1alpha1.WorkflowResumed
+}
+
+func (it WorkflowResumed) Message() string {
+	return fmt.Sprintf("workflow resumed after being paused for %s", it.PausedDuration)
+}
+
+type HTTPRequestSent struct {
+	StatusCode int
+}
+
+func (it HTTPRequestSent) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it HTTPRequestSent) Reason() string {
+	return v1alpha1.HTTPRequestSent
+}
+
+func (it HTTPRequestSent) Message() string {
+	return fmt.Sprintf("http request sent, status code %d", it.StatusCode)
+}
+
+type HTTPRequestFailed struct {
+	Err string
+}
+
+func (it HTTPRequestFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it HTTPRequestFailed) Reason() string {
+	return v1alpha1.HTTPRequestFailed
+}
+
+func (it HTTPRequestFailed) Message() string {
+	return fmt.Sprintf("http request failed, %s", it.Err)
+}
+
+type K8sResourceApplied struct {
+	Resource string
+}
+
+func (it K8sResourceApplied) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it K8sResourceApplied) Reason() string {
+	return v1alpha1.K8sResourceApplied
+}
+
+func (it K8sResourceApplied) Message() string {
+	return fmt.Sprintf("%s applied", it.Resource)
+}
+
+type K8sResourceApplyFailed struct {
+	Resource string
+	Err      string
+}
+
+func (it K8sResourceApplyFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it K8sResourceApplyFailed) Reason() string {
+	return v1alpha1.K8sResourceApplyFailed
+}
+
+func (it K8sResourceApplyFailed) Message() string {
+	return fmt.Sprintf("failed to apply %s, %s", it.Resource, it.Err)
+}
+
+type K8sResourceReverted struct {
+	Resource string
+}
+
+func (it K8sResourceReverted) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it K8sResourceReverted) Reason() string {
+	return v1alpha1.K8sResourceReverted
+}
+
+func (it K8sResourceReverted) Message() string {
+	return fmt.Sprintf("%s reverted", it.Resource)
+}
+
+type K8sResourceRevertFailed struct {
+	Resource string
+	Err      string
+}
+
+func (it K8sResourceRevertFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it K8sResourceRevertFailed) Reason() string {
+	return v1alpha1.K8sResourceRevertFailed
+}
+
+func (it K8sResourceRevertFailed) Message() string {
+	return fmt.Sprintf("failed to revert %s, %s", it.Resource, it.Err)
+}
+
+type K8sWaitConditionMet struct {
+	Value string
+}
+
+func (it K8sWaitConditionMet) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it K8sWaitConditionMet) Reason() string {
+	return v1alpha1.K8sWaitConditionMet
+}
+
+func (it K8sWaitConditionMet) Message() string {
+	return fmt.Sprintf("condition met with value %q", it.Value)
+}
+
+type K8sWaitTimeout struct {
+	Value string
+}
+
+func (it K8sWaitTimeout) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it K8sWaitTimeout) Reason() string {
+	return v1alpha1.K8sWaitTimeout
+}
+
+func (it K8sWaitTimeout) Message() string {
+	return fmt.Sprintf("condition not met before deadline, the latest value is %q", it.Value)
+}
+
+type SubWorkflowCreated struct {
+	Name string
+}
+
+func (it SubWorkflowCreated) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it SubWorkflowCreated) Reason() string {
+	return v1alpha1.SubWorkflowCreated
+}
+
+func (it SubWorkflowCreated) Message() string {
+	return fmt.Sprintf("child workflow %s created", it.Name)
+}
+
+type SubWorkflowCreateFailed struct {
+	Err string
+}
+
+func (it SubWorkflowCreateFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it SubWorkflowCreateFailed) Reason() string {
+	return v1alpha1.SubWorkflowCreateFailed
+}
+
+func (it SubWorkflowCreateFailed) Message() string {
+	return fmt.Sprintf("failed to create child workflow, %s", it.Err)
+}
+
+type SubWorkflowAccomplished struct {
+	Name   string
+	Failed bool
+}
+
+func (it SubWorkflowAccomplished) Type() string {
+	if it.Failed {
+		return corev1.EventTypeWarning
+	}
+	return corev1.EventTypeNormal
+}
+
+func (it SubWorkflowAccomplished) Reason() string {
+	if it.Failed {
+		return v1alpha1.SubWorkflowFailed
+	}
+	return v1alpha1.SubWorkflowSucceed
+}
+
+func (it SubWorkflowAccomplished) Message() string {
+	if it.Failed {
+		return fmt.Sprintf("child workflow %s failed", it.Name)
+	}
+	return fmt.Sprintf("child workflow %s succeeded", it.Name)
+}
+
+type SubWorkflowAborted struct {
+	Name string
+}
+
+func (it SubWorkflowAborted) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it SubWorkflowAborted) Reason() string {
+	return v1alpha1.SubWorkflowAborted
+}
+
+func (it SubWorkflowAborted) Message() string {
+	return fmt.Sprintf("child workflow %s aborted", it.Name)
+}
+
+type OutputsResolveFailed struct {
+	Template string
+	Err      string
+}
+
+func (it OutputsResolveFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it OutputsResolveFailed) Reason() string {
+	return v1alpha1.OutputsResolveFailed
+}
+
+func (it OutputsResolveFailed) Message() string {
+	return fmt.Sprintf("failed to resolve the outputs referred by template %s, %s", it.Template, it.Err)
+}
+
 func init() {
 	register(
 		InvalidEntry{},
@@ -397,5 +703,23 @@ func init() {
 		StatusCheckDeleted{},
 		StatusCheckDeletedFailed{},
 		ParentNodeAborted{},
+		RetryScheduled{},
+		ExitHandlersCreated{},
+		ApprovalDecided{},
+		WorkflowPaused{},
+		WorkflowResumed{},
+		HTTPRequestSent{},
+		HTTPRequestFailed{},
+		K8sResourceApplied{},
+		K8sResourceApplyFailed{},
+		K8sResourceReverted{},
+		K8sResourceRevertFailed{},
+		K8sWaitConditionMet{},
+		K8sWaitTimeout{},
+		SubWorkflowCreated{},
+		SubWorkflowCreateFailed{},
+		SubWorkflowAccomplished{},
+		SubWorkflowAborted{},
+		OutputsResolveFailed{},
 	)
 }
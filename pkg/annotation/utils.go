@@ -46,3 +46,10 @@ func GenKeyForImage(pc *v1alpha1.PodChaos, containerName string, isInit bool) st
 func GenKeyForWebhook(prefix string, podName string) string {
 	return fmt.Sprintf("%s-%s", prefix, podName)
 }
+
+// GenKeyForNodeChaos generates the key of the annotation on a node, which records
+// that the node has been changed by the NodeChaos and should be restored on recovery.
+func GenKeyForNodeChaos(nc *v1alpha1.NodeChaos) string {
+	// the uid is used to keep the key unique and no more than 63 characters
+	return fmt.Sprintf("%s-nodechaos-%s", AnnotationPrefix, nc.UID)
+}
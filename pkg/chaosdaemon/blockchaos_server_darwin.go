@@ -31,6 +31,10 @@ func (s *DaemonServer) ApplyBlockChaos(ctx context.Context, req *pb.ApplyBlockCh
 	return nil, nil
 }
 
+func (s *DaemonServer) GetBlockDevice(ctx context.Context, req *pb.GetBlockDeviceRequest) (*pb.GetBlockDeviceResponse, error) {
+	return nil, nil
+}
+
 func normalizeVolumeName(ctx context.Context, volumePath string) (string, error) {
 	return "", nil
 }
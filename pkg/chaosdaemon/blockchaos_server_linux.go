@@ -18,18 +18,39 @@ package chaosdaemon
 import (
 	"context"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/chaos-mesh/chaos-driver/pkg/client"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
 
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/cgroups"
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 )
 
 const chaosDaemonHelperCommand = "cdh"
 
+const (
+	// hostBlockPath is the sysfs directory of the block devices on the host
+	hostBlockPath = "/host-sys/block"
+	// failMakeRequestPath is the debugfs directory of the fail_make_request fault injection on the host
+	failMakeRequestPath = "/host-sys/kernel/debug/fail_make_request"
+
+	// blockErrorStateKind is the kind of the persisted states of the errors injected by fail_make_request
+	blockErrorStateKind = "block-error"
+)
+
+// blockErrorState is the persisted state of an error injected by fail_make_request, so the error
+// could be recovered by the injection id even if the pod and its volume have gone
+type blockErrorState struct {
+	VolumeName string `json:"volumeName"`
+}
+
 func (s *DaemonServer) ApplyBlockChaos(ctx context.Context, req *pb.ApplyBlockChaosRequest) (*pb.ApplyBlockChaosResponse, error) {
 	log := s.getLoggerFromContext(ctx)
 
@@ -39,7 +60,52 @@ func (s *DaemonServer) ApplyBlockChaos(ctx context.Context, req *pb.ApplyBlockCh
 		return nil, err
 	}
 
-	err = enableIOEMElevator(volumeName)
+	switch req.Action {
+	case pb.ApplyBlockChaosRequest_Delay:
+		return s.applyBlockDelay(ctx, req, volumeName)
+	case pb.ApplyBlockChaosRequest_Error:
+		log.Info("Injecting block error", "volumeName", volumeName, "percent", req.Error.Percent)
+
+		if err := enableFailMakeRequest(volumeName, req.Error.Percent); err != nil {
+			log.Error(err, "inject block error", "volumeName", volumeName)
+			return nil, err
+		}
+		id, err := s.saveBlockError(volumeName)
+		if err != nil {
+			log.Error(err, "error while persisting block error", "volumeName", volumeName)
+			if err := disableFailMakeRequest(volumeName); err != nil {
+				log.Error(err, "recover block error", "volumeName", volumeName)
+			}
+			return nil, err
+		}
+		return &pb.ApplyBlockChaosResponse{
+			InjectionId: id,
+		}, nil
+	case pb.ApplyBlockChaosRequest_Limit:
+		log.Info("Injecting block limit", "volumeName", volumeName, "limit", req.Limit)
+
+		limit := cgroups.BlockLimit{
+			ReadBps:   req.Limit.ReadBps,
+			WriteBps:  req.Limit.WriteBps,
+			ReadIOPS:  req.Limit.ReadIops,
+			WriteIOPS: req.Limit.WriteIops,
+		}
+		if err := s.setBlockLimit(ctx, req.ContainerId, volumeName, limit, true); err != nil {
+			log.Error(err, "inject block limit", "volumeName", volumeName)
+			return nil, err
+		}
+		return &pb.ApplyBlockChaosResponse{
+			InjectionId: v1alpha1.BlockLimitInjectionID,
+		}, nil
+	}
+
+	return nil, errors.New("unknown action")
+}
+
+func (s *DaemonServer) applyBlockDelay(ctx context.Context, req *pb.ApplyBlockChaosRequest, volumeName string) (*pb.ApplyBlockChaosResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	err := enableIOEMElevator(volumeName)
 	if err != nil {
 		log.Error(err, "error while enabling ioem elevator", "volumeName", volumeName)
 		return nil, errors.Wrapf(err, "enable ioem elevator for volume %s", volumeName)
@@ -64,20 +130,16 @@ func (s *DaemonServer) ApplyBlockChaos(ctx context.Context, req *pb.ApplyBlockCh
 	}
 	defer c.Close()
 
-	if req.Action == pb.ApplyBlockChaosRequest_Delay {
-		log.Info("Injecting IOEM Delay", "delay", req.Delay.Delay, "jitter", req.Delay.Jitter, "corr", req.Delay.Correlation)
+	log.Info("Injecting IOEM Delay", "delay", req.Delay.Delay, "jitter", req.Delay.Jitter, "corr", req.Delay.Correlation)
 
-		id, err := c.InjectIOEMDelay(volumePath, 0, uint(pid), req.Delay.Delay, req.Delay.Jitter, float64(req.Delay.Correlation))
-		if err != nil {
-			log.Error(err, "inject ioem delay")
-			return nil, err
-		}
-		return &pb.ApplyBlockChaosResponse{
-			InjectionId: int32(id),
-		}, nil
+	id, err := c.InjectIOEMDelay(volumePath, 0, uint(pid), req.Delay.Delay, req.Delay.Jitter, float64(req.Delay.Correlation))
+	if err != nil {
+		log.Error(err, "inject ioem delay")
+		return nil, err
 	}
-
-	return nil, errors.New("unknown action")
+	return &pb.ApplyBlockChaosResponse{
+		InjectionId: int32(id),
+	}, nil
 }
 
 func normalizeVolumeName(ctx context.Context, volumePath string) (string, error) {
@@ -95,7 +157,7 @@ func normalizeVolumeName(ctx context.Context, volumePath string) (string, error)
 }
 
 func enableIOEMElevator(volumeName string) error {
-	schedulerPath := "/sys/block/" + volumeName + "/queue/scheduler"
+	schedulerPath := filepath.Join(hostBlockPath, volumeName, "queue/scheduler")
 	rawSchedulers, err := os.ReadFile(schedulerPath)
 	if err != nil {
 		return errors.Wrapf(err, "reading schedulers %s", schedulerPath)
@@ -139,6 +201,31 @@ func enableIOEMElevator(volumeName string) error {
 func (s *DaemonServer) RecoverBlockChaos(ctx context.Context, req *pb.RecoverBlockChaosRequest) (*empty.Empty, error) {
 	log := s.getLoggerFromContext(ctx)
 
+	if req.Action == pb.ApplyBlockChaosRequest_Error && req.InjectionId <= v1alpha1.MaxBlockErrorInjectionID {
+		return s.recoverBlockError(ctx, req.InjectionId)
+	}
+
+	if req.Action != pb.ApplyBlockChaosRequest_Delay {
+		volumeName, err := normalizeVolumeName(ctx, req.VolumePath)
+		if err != nil {
+			log.Error(err, "normalize volume name", "volumePath", req.VolumePath)
+			return nil, err
+		}
+
+		if req.Action == pb.ApplyBlockChaosRequest_Error {
+			log.Info("Recovering block error", "volumeName", volumeName)
+			err = disableFailMakeRequest(volumeName)
+		} else {
+			log.Info("Recovering block limit", "volumeName", volumeName)
+			err = s.setBlockLimit(ctx, req.ContainerId, volumeName, cgroups.BlockLimit{}, false)
+		}
+		if err != nil {
+			log.Error(err, "recover block chaos", "volumeName", volumeName)
+			return nil, err
+		}
+		return &empty.Empty{}, nil
+	}
+
 	c, err := client.New()
 	if err != nil {
 		log.Error(err, "create chaos-driver client")
@@ -155,3 +242,215 @@ func (s *DaemonServer) RecoverBlockChaos(ctx context.Context, req *pb.RecoverBlo
 
 	return &empty.Empty{}, nil
 }
+
+// saveBlockError persists the error injected into the volume, and returns the id allocated for it
+func (s *DaemonServer) saveBlockError(volumeName string) (int32, error) {
+	states, err := loadStates[blockErrorState](s.stateStore, blockErrorStateKind)
+	if err != nil {
+		return 0, err
+	}
+
+	id := int32(v1alpha1.MaxBlockErrorInjectionID)
+	for {
+		if _, ok := states[strconv.Itoa(int(id))]; !ok {
+			break
+		}
+		id--
+	}
+	if err := s.stateStore.save(blockErrorStateKind, strconv.Itoa(int(id)), blockErrorState{VolumeName: volumeName}); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// recoverBlockError stops failing the volume recorded with the injection id, it does nothing if the
+// error has been recovered
+func (s *DaemonServer) recoverBlockError(ctx context.Context, id int32) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	states, err := loadStates[blockErrorState](s.stateStore, blockErrorStateKind)
+	if err != nil {
+		return nil, err
+	}
+	key := strconv.Itoa(int(id))
+	state, ok := states[key]
+	if !ok {
+		log.Info("block error has been recovered", "injectionId", id)
+		return &empty.Empty{}, nil
+	}
+
+	log.Info("Recovering block error", "injectionId", id, "volumeName", state.VolumeName)
+	if err := disableFailMakeRequest(state.VolumeName); err != nil {
+		log.Error(err, "recover block error", "volumeName", state.VolumeName)
+		return nil, err
+	}
+	if err := s.stateStore.delete(blockErrorStateKind, key); err != nil {
+		log.Error(err, "error while deleting persisted block error")
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// GetBlockDevice returns the device number of the volume
+func (s *DaemonServer) GetBlockDevice(ctx context.Context, req *pb.GetBlockDeviceRequest) (*pb.GetBlockDeviceResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	volumeName, err := normalizeVolumeName(ctx, req.VolumePath)
+	if err != nil {
+		log.Error(err, "normalize volume name", "volumePath", req.VolumePath)
+		return nil, err
+	}
+
+	major, minor, err := blockDeviceNumber(volumeName)
+	if err != nil {
+		log.Error(err, "get device number", "volumeName", volumeName)
+		return nil, err
+	}
+	return &pb.GetBlockDeviceResponse{
+		Major: major,
+		Minor: minor,
+	}, nil
+}
+
+func blockDeviceNumber(volumeName string) (uint32, uint32, error) {
+	volumePath := "/dev/" + volumeName
+	var stat unix.Stat_t
+	if err := unix.Stat(volumePath, &stat); err != nil {
+		return 0, 0, errors.Wrapf(err, "stat volume %s", volumePath)
+	}
+	return unix.Major(uint64(stat.Rdev)), unix.Minor(uint64(stat.Rdev)), nil
+}
+
+// setBlockLimit throttles the io of the container on the volume through its cgroup, which works
+// without the chaos-driver. If exclusive is true, it's refused to throttle the container which
+// has been throttled on the volume, to avoid overriding the limit of another injection.
+func (s *DaemonServer) setBlockLimit(ctx context.Context, containerID string, volumeName string, limit cgroups.BlockLimit, exclusive bool) error {
+	major, minor, err := blockDeviceNumber(volumeName)
+	if err != nil {
+		return err
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, containerID)
+	if err != nil {
+		return errors.Wrapf(err, "get pid of container %s", containerID)
+	}
+
+	if exclusive {
+		current, err := cgroups.GetBlockLimitForPID(int(pid), major, minor)
+		if err != nil {
+			return err
+		}
+		if current != (cgroups.BlockLimit{}) {
+			return errors.Errorf("container %s has been throttled on volume %s with %+v, overlapping injections are refused", containerID, volumeName, current)
+		}
+	}
+
+	return cgroups.SetBlockLimitForPID(int(pid), major, minor, limit)
+}
+
+// enableFailMakeRequest makes the requests to the volume fail with EIO in the given percentage
+// through the fail_make_request fault injection of the kernel, which fails the requests from every
+// process on the node. The probability is shared by all the devices on the node, so the volumes
+// could only be injected with the same percentage at the same time, and the volume which is failing
+// can't be injected again.
+func enableFailMakeRequest(volumeName string, percent uint32) error {
+	makeItFail := filepath.Join(hostBlockPath, volumeName, "make-it-fail")
+	failing, err := os.ReadFile(makeItFail)
+	if err != nil {
+		return errors.Wrapf(err, "read %s", makeItFail)
+	}
+	if strings.TrimSpace(string(failing)) == "1" {
+		return errors.Errorf("volume %s is failing, overlapping injections are refused", volumeName)
+	}
+
+	probability, err := readFailMakeRequestAttr("probability")
+	if err != nil {
+		return err
+	}
+	others, err := failingVolumes(volumeName)
+	if err != nil {
+		return err
+	}
+	if len(others) > 0 && probability != strconv.FormatUint(uint64(percent), 10) {
+		return errors.Errorf("volumes %v are failing with probability %s%%, which conflicts with %d%%", others, probability, percent)
+	}
+
+	attrs := []struct {
+		name  string
+		value string
+	}{
+		{"interval", "1"},
+		{"times", "-1"},
+		{"probability", strconv.FormatUint(uint64(percent), 10)},
+	}
+	for _, attr := range attrs {
+		if err := writeFailMakeRequestAttr(attr.name, attr.value); err != nil {
+			return err
+		}
+	}
+
+	if err := os.WriteFile(makeItFail, []byte("1"), 0); err != nil {
+		return errors.Wrapf(err, "write %s", makeItFail)
+	}
+	return nil
+}
+
+// disableFailMakeRequest stops failing the requests to the volume, and resets the probability
+// if there is no other failing volume
+func disableFailMakeRequest(volumeName string) error {
+	makeItFail := filepath.Join(hostBlockPath, volumeName, "make-it-fail")
+	// the requests are not failed any more if the device has been removed
+	if err := os.WriteFile(makeItFail, []byte("0"), 0); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "write %s", makeItFail)
+	}
+
+	others, err := failingVolumes(volumeName)
+	if err != nil {
+		return err
+	}
+	if len(others) > 0 {
+		return nil
+	}
+	return writeFailMakeRequestAttr("probability", "0")
+}
+
+// failingVolumes returns the volumes other than the given one whose requests are made to fail
+func failingVolumes(excluded string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(hostBlockPath, "*", "make-it-fail"))
+	if err != nil {
+		return nil, errors.Wrap(err, "list make-it-fail of block devices")
+	}
+
+	var volumes []string
+	for _, path := range paths {
+		volumeName := filepath.Base(filepath.Dir(path))
+		if volumeName == excluded {
+			continue
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "read %s", path)
+		}
+		if strings.TrimSpace(string(content)) == "1" {
+			volumes = append(volumes, volumeName)
+		}
+	}
+	return volumes, nil
+}
+
+func readFailMakeRequestAttr(name string) (string, error) {
+	path := filepath.Join(failMakeRequestPath, name)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "read %s, is the kernel built with CONFIG_FAIL_MAKE_REQUEST and debugfs mounted", path)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func writeFailMakeRequestAttr(name string, value string) error {
+	path := filepath.Join(failMakeRequestPath, name)
+	if err := os.WriteFile(path, []byte(value), 0); err != nil {
+		return errors.Wrapf(err, "write %s to %s", value, path)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/cgroups"
+	"github.com/pkg/errors"
+)
+
+// BlockLimit describes the io throttling on a block device. A zero field means unlimited.
+type BlockLimit struct {
+	ReadBps   uint64
+	WriteBps  uint64
+	ReadIOPS  uint64
+	WriteIOPS uint64
+}
+
+// SetBlockLimitForPID throttles the io of the cgroup which the target pid belongs to on the
+// device major:minor. It uses io.max with cgroup v2 and the blkio throttle files with cgroup v1.
+// Passing a zero BlockLimit removes the throttling.
+func SetBlockLimitForPID(targetPID int, major uint32, minor uint32, limit BlockLimit) error {
+	if cgroups.Mode() == cgroups.Unified {
+		groupPath, err := V2PidGroupPath(targetPID)
+		if err != nil {
+			return err
+		}
+		ioMax := fmt.Sprintf("/host-sys/fs/cgroup%s/io.max", groupPath)
+		if err := os.WriteFile(ioMax, []byte(ioMaxEntry(major, minor, limit)), 0); err != nil {
+			return errors.Wrapf(err, "write io.max of pid %d", targetPID)
+		}
+		return nil
+	}
+
+	groupPath, err := PidPath(targetPID)(cgroups.Blkio)
+	if err != nil {
+		return errors.Wrapf(err, "get blkio cgroup path of pid %d", targetPID)
+	}
+	for file, value := range blkioThrottleEntries(major, minor, limit) {
+		throttleFile := fmt.Sprintf("/host-sys/fs/cgroup/blkio%s/%s", groupPath, file)
+		if err := os.WriteFile(throttleFile, []byte(value), 0); err != nil {
+			return errors.Wrapf(err, "write %s of pid %d", file, targetPID)
+		}
+	}
+	return nil
+}
+
+// GetBlockLimitForPID returns the io throttling of the cgroup which the target pid belongs to on the
+// device major:minor. A zero BlockLimit means the io is not throttled.
+func GetBlockLimitForPID(targetPID int, major uint32, minor uint32) (BlockLimit, error) {
+	if cgroups.Mode() == cgroups.Unified {
+		groupPath, err := V2PidGroupPath(targetPID)
+		if err != nil {
+			return BlockLimit{}, err
+		}
+		content, err := os.ReadFile(fmt.Sprintf("/host-sys/fs/cgroup%s/io.max", groupPath))
+		if err != nil {
+			return BlockLimit{}, errors.Wrapf(err, "read io.max of pid %d", targetPID)
+		}
+		return parseIOMax(string(content), major, minor), nil
+	}
+
+	groupPath, err := PidPath(targetPID)(cgroups.Blkio)
+	if err != nil {
+		return BlockLimit{}, errors.Wrapf(err, "get blkio cgroup path of pid %d", targetPID)
+	}
+	values := make(map[string]uint64)
+	for file := range blkioThrottleEntries(major, minor, BlockLimit{}) {
+		content, err := os.ReadFile(fmt.Sprintf("/host-sys/fs/cgroup/blkio%s/%s", groupPath, file))
+		if err != nil {
+			return BlockLimit{}, errors.Wrapf(err, "read %s of pid %d", file, targetPID)
+		}
+		values[file] = parseBlkioThrottle(string(content), major, minor)
+	}
+	return BlockLimit{
+		ReadBps:   values["blkio.throttle.read_bps_device"],
+		WriteBps:  values["blkio.throttle.write_bps_device"],
+		ReadIOPS:  values["blkio.throttle.read_iops_device"],
+		WriteIOPS: values["blkio.throttle.write_iops_device"],
+	}, nil
+}
+
+// parseIOMax returns the throttling of the device in the content of io.max (cgroup v2), in which
+// every line is like `8:16 rbps=1048576 wbps=max riops=max wiops=100`
+func parseIOMax(content string, major uint32, minor uint32) BlockLimit {
+	var limit BlockLimit
+	device := fmt.Sprintf("%d:%d", major, minor)
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != device {
+			continue
+		}
+		for _, field := range fields[1:] {
+			key, value, found := strings.Cut(field, "=")
+			if !found {
+				continue
+			}
+			v, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				// it's max
+				continue
+			}
+			switch key {
+			case "rbps":
+				limit.ReadBps = v
+			case "wbps":
+				limit.WriteBps = v
+			case "riops":
+				limit.ReadIOPS = v
+			case "wiops":
+				limit.WriteIOPS = v
+			}
+		}
+	}
+	return limit
+}
+
+// parseBlkioThrottle returns the throttling of the device in the content of a blkio throttle file
+// (cgroup v1), in which every line is like `8:16 1048576`
+func parseBlkioThrottle(content string, major uint32, minor uint32) uint64 {
+	device := fmt.Sprintf("%d:%d", major, minor)
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != device {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		return v
+	}
+	return 0
+}
+
+// ioMaxEntry returns the line to write into io.max (cgroup v2)
+func ioMaxEntry(major uint32, minor uint32, limit BlockLimit) string {
+	value := func(v uint64) string {
+		if v == 0 {
+			return "max"
+		}
+		return strconv.FormatUint(v, 10)
+	}
+
+	return fmt.Sprintf("%d:%d rbps=%s wbps=%s riops=%s wiops=%s", major, minor,
+		value(limit.ReadBps), value(limit.WriteBps), value(limit.ReadIOPS), value(limit.WriteIOPS))
+}
+
+// blkioThrottleEntries returns the content to write into every blkio throttle file (cgroup v1),
+// in which writing 0 removes the throttling of the device
+func blkioThrottleEntries(major uint32, minor uint32, limit BlockLimit) map[string]string {
+	entry := func(v uint64) string {
+		return fmt.Sprintf("%d:%d %d", major, minor, v)
+	}
+
+	return map[string]string{
+		"blkio.throttle.read_bps_device":   entry(limit.ReadBps),
+		"blkio.throttle.write_bps_device":  entry(limit.WriteBps),
+		"blkio.throttle.read_iops_device":  entry(limit.ReadIOPS),
+		"blkio.throttle.write_iops_device": entry(limit.WriteIOPS),
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIOMaxEntry(t *testing.T) {
+	cases := []struct {
+		limit  BlockLimit
+		expect string
+	}{
+		{
+			limit:  BlockLimit{},
+			expect: "8:16 rbps=max wbps=max riops=max wiops=max",
+		},
+		{
+			limit:  BlockLimit{ReadBps: 1048576, WriteIOPS: 100},
+			expect: "8:16 rbps=1048576 wbps=max riops=max wiops=100",
+		},
+	}
+
+	for _, c := range cases {
+		if got := ioMaxEntry(8, 16, c.limit); got != c.expect {
+			t.Errorf("ioMaxEntry(%+v): expected %q, got %q", c.limit, c.expect, got)
+		}
+	}
+}
+
+func TestBlkioThrottleEntries(t *testing.T) {
+	expect := map[string]string{
+		"blkio.throttle.read_bps_device":   "8:16 0",
+		"blkio.throttle.write_bps_device":  "8:16 2048",
+		"blkio.throttle.read_iops_device":  "8:16 10",
+		"blkio.throttle.write_iops_device": "8:16 0",
+	}
+
+	got := blkioThrottleEntries(8, 16, BlockLimit{WriteBps: 2048, ReadIOPS: 10})
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("blkioThrottleEntries: expected %v, got %v", expect, got)
+	}
+}
+
+func TestParseIOMax(t *testing.T) {
+	content := "8:0 rbps=max wbps=max riops=max wiops=50\n8:16 rbps=1048576 wbps=max riops=max wiops=100\n"
+
+	expect := BlockLimit{ReadBps: 1048576, WriteIOPS: 100}
+	if got := parseIOMax(content, 8, 16); got != expect {
+		t.Errorf("parseIOMax: expected %+v, got %+v", expect, got)
+	}
+	if got := parseIOMax(content, 8, 32); got != (BlockLimit{}) {
+		t.Errorf("parseIOMax: expected no limit, got %+v", got)
+	}
+}
+
+func TestParseBlkioThrottle(t *testing.T) {
+	content := "8:0 2048\n8:16 1048576\n"
+
+	if got := parseBlkioThrottle(content, 8, 16); got != 1048576 {
+		t.Errorf("parseBlkioThrottle: expected 1048576, got %d", got)
+	}
+	if got := parseBlkioThrottle(content, 8, 32); got != 0 {
+		t.Errorf("parseBlkioThrottle: expected 0, got %d", got)
+	}
+}
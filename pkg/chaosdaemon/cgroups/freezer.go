@@ -0,0 +1,74 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/containerd/cgroups"
+	"github.com/pkg/errors"
+)
+
+// FreezePID freezes all the processes in the cgroup which the target pid belongs to. They are
+// kept stopped by the cgroup freezer until ThawPID is called with a pid of the same cgroup.
+func FreezePID(targetPID int) error {
+	return setFrozenForPID(targetPID, true)
+}
+
+// ThawPID resumes the processes frozen by FreezePID.
+func ThawPID(targetPID int) error {
+	return setFrozenForPID(targetPID, false)
+}
+
+func setFrozenForPID(targetPID int, frozen bool) error {
+	unified := cgroups.Mode() == cgroups.Unified
+
+	var stateFile string
+	if unified {
+		groupPath, err := V2PidGroupPath(targetPID)
+		if err != nil {
+			return err
+		}
+		stateFile = fmt.Sprintf("/host-sys/fs/cgroup%s/cgroup.freeze", groupPath)
+	} else {
+		groupPath, err := PidPath(targetPID)(cgroups.Freezer)
+		if err != nil {
+			return errors.Wrapf(err, "get freezer cgroup path of pid %d", targetPID)
+		}
+		stateFile = fmt.Sprintf("/host-sys/fs/cgroup/freezer%s/freezer.state", groupPath)
+	}
+
+	if err := os.WriteFile(stateFile, []byte(freezerState(unified, frozen)), 0); err != nil {
+		return errors.Wrapf(err, "write freezer state of pid %d", targetPID)
+	}
+	return nil
+}
+
+// freezerState returns the content to write into cgroup.freeze (cgroup v2) or
+// freezer.state (cgroup v1)
+func freezerState(unified bool, frozen bool) string {
+	switch {
+	case unified && frozen:
+		return "1"
+	case unified:
+		return "0"
+	case frozen:
+		return "FROZEN"
+	default:
+		return "THAWED"
+	}
+}
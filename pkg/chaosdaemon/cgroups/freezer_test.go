@@ -0,0 +1,39 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"testing"
+)
+
+func TestFreezerState(t *testing.T) {
+	cases := []struct {
+		unified bool
+		frozen  bool
+		expect  string
+	}{
+		{unified: true, frozen: true, expect: "1"},
+		{unified: true, frozen: false, expect: "0"},
+		{unified: false, frozen: true, expect: "FROZEN"},
+		{unified: false, frozen: false, expect: "THAWED"},
+	}
+
+	for _, c := range cases {
+		if got := freezerState(c.unified, c.frozen); got != c.expect {
+			t.Errorf("freezerState(%v, %v): expected %q, got %q", c.unified, c.frozen, c.expect, got)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/cgroups"
+	"github.com/pkg/errors"
+)
+
+// unlimitedMemoryThreshold is used to detect the "unlimited" value of memory.limit_in_bytes
+// in cgroup v1, which is the max int64 rounded down to the page size
+const unlimitedMemoryThreshold = 1 << 62
+
+// GetMemoryLimitForPID returns the memory limit in bytes of the cgroup which the target pid
+// belongs to. It returns 0 if there is no memory limit.
+func GetMemoryLimitForPID(targetPID int) (uint64, error) {
+	var limitFile string
+	if cgroups.Mode() == cgroups.Unified {
+		groupPath, err := V2PidGroupPath(targetPID)
+		if err != nil {
+			return 0, err
+		}
+		limitFile = fmt.Sprintf("/host-sys/fs/cgroup%s/memory.max", groupPath)
+	} else {
+		groupPath, err := PidPath(targetPID)(cgroups.Memory)
+		if err != nil {
+			return 0, errors.Wrapf(err, "get memory cgroup path of pid %d", targetPID)
+		}
+		limitFile = fmt.Sprintf("/host-sys/fs/cgroup/memory%s/memory.limit_in_bytes", groupPath)
+	}
+
+	content, err := os.ReadFile(limitFile)
+	if err != nil {
+		return 0, errors.Wrapf(err, "read memory limit of pid %d", targetPID)
+	}
+	return parseMemoryLimit(string(content))
+}
+
+func parseMemoryLimit(content string) (uint64, error) {
+	content = strings.TrimSpace(content)
+	if content == "max" {
+		return 0, nil
+	}
+
+	limit, err := strconv.ParseUint(content, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse memory limit %q", content)
+	}
+	if limit >= unlimitedMemoryThreshold {
+		return 0, nil
+	}
+	return limit, nil
+}
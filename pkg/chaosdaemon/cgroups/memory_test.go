@@ -0,0 +1,57 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"testing"
+)
+
+func TestParseMemoryLimit_V2(t *testing.T) {
+	got, err := parseMemoryLimit("536870912\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 536870912 {
+		t.Errorf("expected 536870912, got %d", got)
+	}
+}
+
+func TestParseMemoryLimit_V2Unlimited(t *testing.T) {
+	got, err := parseMemoryLimit("max\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 0 {
+		t.Errorf("expected 0, got %d", got)
+	}
+}
+
+func TestParseMemoryLimit_V1Unlimited(t *testing.T) {
+	got, err := parseMemoryLimit("9223372036854771712\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 0 {
+		t.Errorf("expected 0, got %d", got)
+	}
+}
+
+func TestParseMemoryLimit_Invalid(t *testing.T) {
+	_, err := parseMemoryLimit("foo")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
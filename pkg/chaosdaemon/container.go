@@ -17,13 +17,24 @@ package chaosdaemon
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
 
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 )
 
+// gracefulKillStateKind is the kind of the persisted states of the containers to be killed after the grace period
+const gracefulKillStateKind = "graceful-kill"
+
 // ContainerKill kills container according to container id in the req
 func (s *DaemonServer) ContainerKill(ctx context.Context, req *pb.ContainerRequest) (*empty.Empty, error) {
 	log := s.getLoggerFromContext(ctx)
@@ -37,15 +48,165 @@ func (s *DaemonServer) ContainerKill(ctx context.Context, req *pb.ContainerReque
 		return nil, err
 	}
 
-	err := s.crClient.ContainerKillByContainerID(ctx, req.ContainerId)
+	if req.Signal == "" || req.Signal == "SIGKILL" {
+		err := s.crClient.ContainerKillByContainerID(ctx, req.ContainerId)
+		if err != nil {
+			log.Error(err, "error while killing container")
+			return nil, err
+		}
+
+		return &empty.Empty{}, nil
+	}
+
+	sig := unix.SignalNum(req.Signal)
+	if sig == 0 {
+		err := errors.Errorf("unknown signal %s", req.Signal)
+		log.Error(err, "signal is not expected")
+		return nil, err
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
 	if err != nil {
-		log.Error(err, "error while killing container")
+		log.Error(err, "error while getting pid from container")
 		return nil, err
 	}
 
+	status, err := os.ReadFile(fmt.Sprintf("/proc/%d/status", pid))
+	if err != nil {
+		log.Error(err, "error while reading process status", "pid", pid)
+		return nil, errors.Wrapf(err, "read status of pid %d", pid)
+	}
+	ignored, err := signalIgnored(string(status), sig)
+	if err != nil {
+		log.Error(err, "error while parsing process status", "pid", pid)
+		return nil, err
+	}
+	if ignored {
+		err := errors.Errorf("%s would be ignored by the main process %d of the container, as it has no handler for it", req.Signal, pid)
+		log.Error(err, "signal is not expected")
+		return nil, err
+	}
+
+	if err := syscall.Kill(int(pid), sig); err != nil {
+		log.Error(err, "error while sending signal to container", "signal", req.Signal, "pid", pid)
+		return nil, errors.Wrapf(err, "send %s to pid %d", req.Signal, pid)
+	}
+
+	if req.GracePeriod > 0 {
+		state := gracefulKillState{
+			ContainerID: req.ContainerId,
+			Pid:         pid,
+			Deadline:    time.Now().Add(time.Duration(req.GracePeriod) * time.Second),
+		}
+		if err := s.stateStore.save(gracefulKillStateKind, req.ContainerId, state); err != nil {
+			log.Error(err, "error while persisting the pending kill")
+		}
+		go s.killAfterGracePeriod(log, state)
+	}
+
 	return &empty.Empty{}, nil
 }
 
+// gracefulKillState is the persisted state of a container to be killed after the grace period,
+// so it could still be killed if chaos-daemon restarts during the grace period
+type gracefulKillState struct {
+	ContainerID string    `json:"containerID"`
+	Pid         uint32    `json:"pid"`
+	Deadline    time.Time `json:"deadline"`
+}
+
+// killAfterGracePeriod kills the container with SIGKILL if its main process is still
+// the one which received the termination signal after the grace period
+func (s *DaemonServer) killAfterGracePeriod(log logr.Logger, state gracefulKillState) {
+	time.Sleep(time.Until(state.Deadline))
+	defer func() {
+		if err := s.stateStore.delete(gracefulKillStateKind, state.ContainerID); err != nil {
+			log.Error(err, "error while deleting the pending kill")
+		}
+	}()
+
+	ctx := context.Background()
+	current, err := s.crClient.GetPidFromContainerID(ctx, state.ContainerID)
+	if err != nil || current != state.Pid {
+		// the container has already exited
+		return
+	}
+
+	log.Info("container is still running after grace period, kill it", "containerID", state.ContainerID, "deadline", state.Deadline)
+	if err := s.crClient.ContainerKillByContainerID(ctx, state.ContainerID); err != nil {
+		log.Error(err, "error while killing container after grace period")
+	}
+}
+
+// resumeGracefulKills waits for the grace period of the containers persisted before chaos-daemon
+// restarts, and kills them if they are still running
+func (s *DaemonServer) resumeGracefulKills() {
+	states, err := loadStates[gracefulKillState](s.stateStore, gracefulKillStateKind)
+	if err != nil {
+		s.rootLogger.Error(err, "error while loading pending kills")
+		return
+	}
+
+	for _, state := range states {
+		s.rootLogger.Info("resume pending kill", "containerID", state.ContainerID, "deadline", state.Deadline)
+		go s.killAfterGracePeriod(s.rootLogger, state)
+	}
+}
+
+// signalIgnored returns whether the signal would be ignored by the process with the status, which
+// is the content of /proc/<pid>/status. The init process of a pid namespace only receives the
+// signals it has installed a handler for, and the other processes ignore the signals whose default
+// action is to ignore if no handler is installed.
+func signalIgnored(status string, sig syscall.Signal) (bool, error) {
+	if sig == syscall.SIGKILL || sig == syscall.SIGSTOP {
+		// they can't be caught or ignored, and are always delivered from the ancestor pid namespace
+		return false, nil
+	}
+
+	var (
+		ignoredMask, caughtMask uint64
+		init                    bool
+	)
+	for _, line := range strings.Split(status, "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		var err error
+		switch key {
+		case "SigIgn":
+			ignoredMask, err = strconv.ParseUint(value, 16, 64)
+		case "SigCgt":
+			caughtMask, err = strconv.ParseUint(value, 16, 64)
+		case "NSpid":
+			// the pids of the process in the nested pid namespaces, the last one is in its own namespace
+			pids := strings.Fields(value)
+			init = len(pids) > 0 && pids[len(pids)-1] == "1"
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "parse %s of process status", key)
+		}
+	}
+
+	bit := uint64(1) << (uint(sig) - 1)
+	if ignoredMask&bit != 0 {
+		return true, nil
+	}
+	if caughtMask&bit != 0 {
+		return false, nil
+	}
+	if init {
+		return true, nil
+	}
+	switch sig {
+	case syscall.SIGCHLD, syscall.SIGURG, syscall.SIGWINCH:
+		return true, nil
+	}
+	return false, nil
+}
+
 func (s *DaemonServer) ContainerGetPid(ctx context.Context, req *pb.ContainerRequest) (*pb.ContainerResponse, error) {
 	log := s.getLoggerFromContext(ctx)
 
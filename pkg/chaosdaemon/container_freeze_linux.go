@@ -0,0 +1,100 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"syscall"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/cgroups"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
+)
+
+// FreezeContainer stops all the processes of the container, with the cgroup freezer or SIGSTOP
+func (s *DaemonServer) FreezeContainer(ctx context.Context, req *pb.FreezeContainerRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	log.Info("freeze container", "request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting pid from container")
+		return nil, err
+	}
+
+	switch req.Method {
+	case pb.FreezeContainerRequest_CGROUP:
+		err = cgroups.FreezePID(int(pid))
+	case pb.FreezeContainerRequest_SIGSTOP:
+		err = signalContainerProcesses(pid, syscall.SIGSTOP, log)
+	default:
+		err = errors.Errorf("unknown freeze method %s", req.Method)
+	}
+	if err != nil {
+		log.Error(err, "error while freezing container")
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// UnfreezeContainer resumes the processes stopped by FreezeContainer
+func (s *DaemonServer) UnfreezeContainer(ctx context.Context, req *pb.FreezeContainerRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	log.Info("unfreeze container", "request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting pid from container")
+		return nil, err
+	}
+
+	switch req.Method {
+	case pb.FreezeContainerRequest_CGROUP:
+		err = cgroups.ThawPID(int(pid))
+	case pb.FreezeContainerRequest_SIGSTOP:
+		err = signalContainerProcesses(pid, syscall.SIGCONT, log)
+	default:
+		err = errors.Errorf("unknown freeze method %s", req.Method)
+	}
+	if err != nil {
+		log.Error(err, "error while unfreezing container")
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// signalContainerProcesses sends the signal to the main process of the container and all its descendants
+func signalContainerProcesses(pid uint32, sig syscall.Signal, log logr.Logger) error {
+	childPids, err := util.GetChildProcesses(pid, log)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range append([]uint32{pid}, childPids...) {
+		if err := syscall.Kill(int(p), sig); err != nil && err != syscall.ESRCH {
+			return errors.Wrapf(err, "send %s to pid %d", sig, p)
+		}
+	}
+	return nil
+}
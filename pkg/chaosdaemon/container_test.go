@@ -17,6 +17,8 @@ package chaosdaemon
 
 import (
 	"context"
+	"syscall"
+	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -72,3 +74,34 @@ var _ = Describe("container kill", func() {
 		})
 	})
 })
+
+func Test_signalIgnored(t *testing.T) {
+	g := NewWithT(t)
+
+	// SIGTERM is caught, SIGHUP is ignored
+	status := "Name:\tfoo\nNSpid:\t9527\t1\nSigIgn:\t0000000000000001\nSigCgt:\t0000000000004000\n"
+	for sig, expected := range map[syscall.Signal]bool{
+		syscall.SIGTERM: false,
+		syscall.SIGHUP:  true,
+		syscall.SIGINT:  true,
+		syscall.SIGKILL: false,
+	} {
+		ignored, err := signalIgnored(status, sig)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ignored).To(Equal(expected), sig.String())
+	}
+
+	// the process is not the init process of its pid namespace
+	status = "Name:\tfoo\nNSpid:\t9527\t7\nSigIgn:\t0000000000000000\nSigCgt:\t0000000000000000\n"
+	for sig, expected := range map[syscall.Signal]bool{
+		syscall.SIGTERM:  false,
+		syscall.SIGWINCH: true,
+	} {
+		ignored, err := signalIgnored(status, sig)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ignored).To(Equal(expected), sig.String())
+	}
+
+	_, err := signalIgnored("SigCgt:\tfoo\n", syscall.SIGTERM)
+	g.Expect(err).To(HaveOccurred())
+}
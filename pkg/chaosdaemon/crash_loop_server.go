@@ -0,0 +1,193 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const (
+	// the labels set by kubelet on every container of a pod
+	podUIDLabel        = "io.kubernetes.pod.uid"
+	containerNameLabel = "io.kubernetes.container.name"
+
+	// crashLoopPollInterval is the interval to look for the restarted container in a crash loop
+	crashLoopPollInterval = time.Second
+
+	// crashLoopStateKind is the kind of the persisted states of the crash loops
+	crashLoopStateKind = "crash-loop"
+)
+
+// crashLoopState is the persisted state of a crash loop, so the loop could be resumed after
+// chaos-daemon restarts
+type crashLoopState struct {
+	PodUID        string `json:"podUID"`
+	ContainerName string `json:"containerName"`
+	IntervalMs    int64  `json:"intervalMs"`
+}
+
+// StartCrashLoop starts a background loop, which kills the container again once it has been
+// running for the interval after every restart, until StopCrashLoop is called.
+// The container is identified by the pod uid and the container name, because a restarted
+// container gets a new container id.
+func (s *DaemonServer) StartCrashLoop(ctx context.Context, req *pb.CrashLoopRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	log.Info("start crash loop", "request", req)
+
+	if req.IntervalMs <= 0 {
+		err := errors.Errorf("invalid crash loop interval %dms", req.IntervalMs)
+		log.Error(err, "crash loop interval is not expected")
+		return nil, err
+	}
+
+	state := crashLoopState{
+		PodUID:        req.PodUid,
+		ContainerName: req.ContainerName,
+		IntervalMs:    req.IntervalMs,
+	}
+	if err := s.stateStore.save(crashLoopStateKind, crashLoopKey(req.PodUid, req.ContainerName), state); err != nil {
+		log.Error(err, "error while persisting crash loop")
+		return nil, err
+	}
+	s.startCrashLoop(log, state)
+
+	return &empty.Empty{}, nil
+}
+
+// startCrashLoop starts the loop in the background, it does nothing if the loop is running
+func (s *DaemonServer) startCrashLoop(log logr.Logger, state crashLoopState) {
+	loopCtx, cancel := context.WithCancel(context.Background())
+	if _, loaded := s.crashLoops.LoadOrStore(crashLoopKey(state.PodUID, state.ContainerName), cancel); loaded {
+		// the loop has been started by a previous request
+		cancel()
+		return
+	}
+
+	interval := time.Duration(state.IntervalMs) * time.Millisecond
+	go s.runCrashLoop(loopCtx, log, state.PodUID, state.ContainerName, interval)
+}
+
+// StopCrashLoop stops the loop started by StartCrashLoop
+func (s *DaemonServer) StopCrashLoop(ctx context.Context, req *pb.CrashLoopRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	log.Info("stop crash loop", "request", req)
+
+	key := crashLoopKey(req.PodUid, req.ContainerName)
+	if cancel, loaded := s.crashLoops.LoadAndDelete(key); loaded {
+		cancel.(context.CancelFunc)()
+	}
+	if err := s.stateStore.delete(crashLoopStateKind, key); err != nil {
+		log.Error(err, "error while deleting persisted crash loop")
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// resumeCrashLoops restarts the crash loops persisted before chaos-daemon restarts. The loops
+// keep running until StopCrashLoop is called, even if the pod has gone, like before the restart.
+func (s *DaemonServer) resumeCrashLoops() {
+	states, err := loadStates[crashLoopState](s.stateStore, crashLoopStateKind)
+	if err != nil {
+		s.rootLogger.Error(err, "error while loading crash loop states")
+		return
+	}
+
+	for _, state := range states {
+		s.rootLogger.Info("resume crash loop", "podUID", state.PodUID, "containerName", state.ContainerName)
+		s.startCrashLoop(s.rootLogger, state)
+	}
+}
+
+func (s *DaemonServer) runCrashLoop(ctx context.Context, log logr.Logger, podUID string, containerName string, interval time.Duration) {
+	ticker := time.NewTicker(crashLoopPollInterval)
+	defer ticker.Stop()
+
+	var (
+		current string
+		since   time.Time
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		containerID, err := s.findRunningContainer(ctx, podUID, containerName)
+		if err != nil {
+			log.Error(err, "error while finding the running container", "podUID", podUID, "containerName", containerName)
+			continue
+		}
+		if containerID == "" {
+			current = ""
+			continue
+		}
+		if containerID != current {
+			current, since = containerID, time.Now()
+			continue
+		}
+		if time.Since(since) < interval {
+			continue
+		}
+
+		log.Info("kill the restarted container", "containerID", containerID)
+		if err := s.crClient.ContainerKillByContainerID(ctx, containerID); err != nil {
+			log.Error(err, "error while killing container", "containerID", containerID)
+			continue
+		}
+		current = ""
+	}
+}
+
+// findRunningContainer returns the id of the running container with the name in the pod,
+// or an empty string if the container is not running
+func (s *DaemonServer) findRunningContainer(ctx context.Context, podUID string, containerName string) (string, error) {
+	containerIDs, err := s.crClient.ListContainerIDs(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, containerID := range containerIDs {
+		labels, err := s.crClient.GetLabelsFromContainerID(ctx, containerID)
+		if err != nil {
+			continue
+		}
+		if labels[podUIDLabel] != podUID || labels[containerNameLabel] != containerName {
+			continue
+		}
+		if _, err := s.crClient.GetPidFromContainerID(ctx, containerID); err != nil {
+			// the container has exited
+			continue
+		}
+		return containerID, nil
+	}
+
+	return "", nil
+}
+
+func crashLoopKey(podUID string, containerName string) string {
+	return podUID + "/" + containerName
+}
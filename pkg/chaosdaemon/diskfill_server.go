@@ -0,0 +1,98 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func (s *DaemonServer) ApplyDiskFill(ctx context.Context, req *pb.ApplyDiskFillRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("applying disk fill", "request", req)
+
+	args := []string{"fill-disk", req.Path}
+	if req.UsagePercent > 0 {
+		args = append(args, "--usage-percent", strconv.Itoa(int(req.UsagePercent)))
+	} else {
+		args = append(args, "--size", strconv.FormatInt(req.Size, 10))
+	}
+
+	if err := s.runDiskFillHelper(ctx, req.ContainerId, req.EnterNS, args); err != nil {
+		log.Error(err, "fill disk", "path", req.Path)
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *DaemonServer) RecoverDiskFill(ctx context.Context, req *pb.RecoverDiskFillRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("recovering disk fill", "request", req)
+
+	// The filled file is located by its path only, so it could be cleaned
+	// even if chaos-daemon has restarted after the injection.
+	if err := s.runDiskFillHelper(ctx, req.ContainerId, req.EnterNS, []string{"clean-disk-fill", req.Path}); err != nil {
+		log.Error(err, "clean disk fill", "path", req.Path)
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// runDiskFillHelper runs the chaos-daemon-helper inside the mount namespace
+// of the container through the background process manager, and waits for it
+// to exit.
+func (s *DaemonServer) runDiskFillHelper(ctx context.Context, containerId string, enterNS bool, args []string) error {
+	pid, err := s.crClient.GetPidFromContainerID(ctx, containerId)
+	if err != nil {
+		return errors.Wrap(err, "getting PID")
+	}
+
+	processBuilder := bpm.DefaultProcessBuilder(chaosDaemonHelperCommand, args...).
+		SetContext(ctx).
+		SetIdentifier(fmt.Sprintf("disk-fill-%s", containerId))
+	if enterNS {
+		processBuilder = processBuilder.SetNS(pid, bpm.MountNS).EnableLocalMnt()
+	}
+
+	cmd := processBuilder.Build(ctx)
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	proc, err := s.backgroundProcessManager.StartProcess(ctx, cmd)
+	if err != nil {
+		return errors.Wrapf(err, "start process `%s`", cmd)
+	}
+
+	select {
+	case <-proc.Stopped():
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "wait for disk fill helper")
+	}
+
+	if !cmd.ProcessState.Success() {
+		return errors.Errorf("`%s` exited with %s: %s", cmd, cmd.ProcessState, stderr.String())
+	}
+	return nil
+}
@@ -53,55 +53,55 @@ func (s *DaemonServer) SetDNSServer(ctx context.Context,
 			return nil, ErrInvalidDNSServer
 		}
 
-		// backup the /etc/resolv.conf
-		processBuilder := bpm.DefaultProcessBuilder("sh", "-c", fmt.Sprintf("ls %s.chaos.bak || cp %s %s.chaos.bak", DNSServerConfFile, DNSServerConfFile, DNSServerConfFile)).SetContext(ctx)
-		if req.EnterNS {
-			processBuilder = processBuilder.SetNS(pid, bpm.MountNS)
-		}
-
-		cmd := processBuilder.Build(ctx)
-		output, err := cmd.CombinedOutput()
-		if err != nil {
-			log.Error(err, "execute command error", "command", cmd.String(), "output", output)
-			return nil, util.EncodeOutputToError(output, err)
-		}
-		if len(output) != 0 {
-			log.Info("command output", "output", string(output))
-		}
-
-		// add chaos dns server to the first line of /etc/resolv.conf
-		// Note: can not replace the /etc/resolv.conf like `mv resolv_conf_dnschaos_temp resolv.conf`, will execute with error `Device or resource busy`
-		processBuilder = bpm.DefaultProcessBuilder("sh", "-c", fmt.Sprintf("cp %s /etc/resolv_conf_dnschaos_temp && sed -i 's/.*nameserver.*/nameserver %s/' /etc/resolv_conf_dnschaos_temp && cat /etc/resolv_conf_dnschaos_temp > %s && rm /etc/resolv_conf_dnschaos_temp", DNSServerConfFile, req.DnsServer, DNSServerConfFile)).SetContext(ctx)
-		if req.EnterNS {
-			processBuilder = processBuilder.SetNS(pid, bpm.MountNS)
-		}
-
-		cmd = processBuilder.Build(ctx)
-		output, err = cmd.CombinedOutput()
-		if err != nil {
-			log.Error(err, "execute command error", "command", cmd.String(), "output", output)
-			return nil, util.EncodeOutputToError(output, err)
-		}
-		if len(output) != 0 {
-			log.Info("command output", "output", string(output))
+		if err := s.setResolvConfNameserver(ctx, pid, req.EnterNS, req.DnsServer); err != nil {
+			return nil, err
 		}
 	} else {
 		// recover the dns server's address
-		processBuilder := bpm.DefaultProcessBuilder("sh", "-c", fmt.Sprintf("ls %s.chaos.bak && cat %s.chaos.bak > %s || true", DNSServerConfFile, DNSServerConfFile, DNSServerConfFile)).SetContext(ctx)
-		if req.EnterNS {
-			processBuilder = processBuilder.SetNS(pid, bpm.MountNS)
-		}
-
-		cmd := processBuilder.Build(ctx)
-		output, err := cmd.CombinedOutput()
-		if err != nil {
-			log.Error(err, "execute command error", "command", cmd.String(), "output", output)
-			return nil, util.EncodeOutputToError(output, err)
-		}
-		if len(output) != 0 {
-			log.Info("command output", "output", string(output))
+		if err := s.recoverResolvConf(ctx, pid, req.EnterNS); err != nil {
+			return nil, err
 		}
 	}
 
 	return &empty.Empty{}, nil
 }
+
+// setResolvConfNameserver backs up the /etc/resolv.conf of the container, and
+// replaces all the nameservers in it with the dnsServer
+func (s *DaemonServer) setResolvConfNameserver(ctx context.Context, pid uint32, enterNS bool, dnsServer string) error {
+	// backup the /etc/resolv.conf
+	if _, err := s.execInMountNS(ctx, pid, enterNS, fmt.Sprintf("ls %s.chaos.bak || cp %s %s.chaos.bak", DNSServerConfFile, DNSServerConfFile, DNSServerConfFile)); err != nil {
+		return err
+	}
+
+	// add chaos dns server to the first line of /etc/resolv.conf
+	// Note: can not replace the /etc/resolv.conf like `mv resolv_conf_dnschaos_temp resolv.conf`, will execute with error `Device or resource busy`
+	_, err := s.execInMountNS(ctx, pid, enterNS, fmt.Sprintf("cp %s /etc/resolv_conf_dnschaos_temp && sed -i 's/.*nameserver.*/nameserver %s/' /etc/resolv_conf_dnschaos_temp && cat /etc/resolv_conf_dnschaos_temp > %s && rm /etc/resolv_conf_dnschaos_temp", DNSServerConfFile, dnsServer, DNSServerConfFile))
+	return err
+}
+
+// recoverResolvConf recovers the /etc/resolv.conf of the container from the backup
+func (s *DaemonServer) recoverResolvConf(ctx context.Context, pid uint32, enterNS bool) error {
+	_, err := s.execInMountNS(ctx, pid, enterNS, fmt.Sprintf("ls %s.chaos.bak && cat %s.chaos.bak > %s || true", DNSServerConfFile, DNSServerConfFile, DNSServerConfFile))
+	return err
+}
+
+func (s *DaemonServer) execInMountNS(ctx context.Context, pid uint32, enterNS bool, command string) ([]byte, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	processBuilder := bpm.DefaultProcessBuilder("sh", "-c", command).SetContext(ctx)
+	if enterNS {
+		processBuilder = processBuilder.SetNS(pid, bpm.MountNS)
+	}
+
+	cmd := processBuilder.Build(ctx)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Error(err, "execute command error", "command", cmd.String(), "output", output)
+		return nil, util.EncodeOutputToError(output, err)
+	}
+	if len(output) != 0 {
+		log.Info("command output", "output", string(output))
+	}
+	return output, nil
+}
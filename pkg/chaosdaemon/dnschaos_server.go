@@ -0,0 +1,424 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/helper"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
+	"github.com/chaos-mesh/chaos-mesh/pkg/dnschaos"
+)
+
+const (
+	// chaosDNSServerListen is the address of the chaos dns server inside the network namespace
+	// of the container, a random port is chosen to avoid conflicting with the container
+	chaosDNSServerListen = "127.0.0.1:0"
+	// chaosDNSServerStartTimeout is the max time to wait for the chaos dns server to listen
+	chaosDNSServerStartTimeout = 2 * time.Second
+	// chaosDNSMark is the firewall mark of the queries forwarded by the chaos dns server, which
+	// are not redirected again
+	chaosDNSMark = 0x646e73
+	// chaosDNSChainPrefix is the prefix of the iptables chain in the nat table, which redirects
+	// the DNS queries to the chaos dns server
+	chaosDNSChainPrefix = "CHAOS-DNS-"
+	// ip6tablesCmd redirects the DNS queries over IPv6
+	ip6tablesCmd = "ip6tables"
+	// dnsChaosStateKind is the kind of the persisted states of the chaos dns servers
+	dnsChaosStateKind = "dns-chaos"
+)
+
+// dnsChaosState is the persisted state of a chaos dns server, so the server could be started again after
+// chaos-daemon restarts. Otherwise the redirect would be left without the server, and all the DNS queries
+// from the container would fail.
+type dnsChaosState struct {
+	ContainerID string `json:"containerID"`
+	EnterNS     bool   `json:"enterNS"`
+	Upstream    string `json:"upstream"`
+	Rules       string `json:"rules"`
+	// Chain is the iptables chain of the redirect, it's kept after resuming so the controller could still
+	// recover the redirect with the instance it recorded
+	Chain string `json:"chain"`
+	// UID is the uid of the running chaos dns server in bpm
+	UID string `json:"uid"`
+}
+
+func (s *DaemonServer) ApplyDNSChaos(ctx context.Context, req *pb.ApplyDNSChaosRequest) (*pb.ApplyDNSChaosResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("applying dns chaos", "request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting PID")
+	}
+
+	rules, err := convertDNSChaosRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+	rulesJSON, err := json.Marshal(rules)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal dns chaos rules")
+	}
+
+	upstream, err := s.getUpstreamDNSServer(ctx, pid, req.EnterNS)
+	if err != nil {
+		return nil, err
+	}
+
+	state := dnsChaosState{
+		ContainerID: req.ContainerId,
+		EnterNS:     req.EnterNS,
+		Upstream:    upstream,
+		Rules:       string(rulesJSON),
+	}
+	proc, err := s.startDNSChaos(ctx, pid, &state)
+	if err != nil {
+		return nil, err
+	}
+	instance := strconv.Itoa(proc.Pair.Pid)
+
+	if err := s.stateStore.save(dnsChaosStateKind, state.ContainerID, state); err != nil {
+		log.Error(err, "error while persisting dns chaos")
+		if rerr := buildDNSRedirect(ctx, req.EnterNS, pid, state.Chain).remove(); rerr != nil {
+			log.Error(rerr, "remove dns redirect", "request", req)
+		}
+		if kerr := s.backgroundProcessManager.KillBackgroundProcess(ctx, proc.Uid); kerr != nil {
+			log.Error(kerr, "kill chaos dns server", "request", req)
+		}
+		return nil, err
+	}
+
+	return &pb.ApplyDNSChaosResponse{
+		Instance:    instance,
+		StartTime:   proc.Pair.CreateTime,
+		InstanceUid: proc.Uid,
+	}, nil
+}
+
+func (s *DaemonServer) RecoverDNSChaos(ctx context.Context, req *pb.RecoverDNSChaosRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("recovering dns chaos", "request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting PID")
+	}
+
+	if req.Instance != "" {
+		dnsRedirect := buildDNSRedirect(ctx, req.EnterNS, pid, chaosDNSChainPrefix+req.Instance)
+		if err := dnsRedirect.remove(); err != nil {
+			return nil, err
+		}
+	}
+
+	// the chaos dns server may have been started again after chaos-daemon restarts
+	states, err := loadStates[dnsChaosState](s.stateStore, dnsChaosStateKind)
+	if err != nil {
+		return nil, err
+	}
+	if state, ok := states[req.ContainerId]; ok {
+		if state.Chain != chaosDNSChainPrefix+req.Instance {
+			if err := buildDNSRedirect(ctx, req.EnterNS, pid, state.Chain).remove(); err != nil {
+				return nil, err
+			}
+		}
+		if _, running := s.backgroundProcessManager.GetPipes(state.UID); running {
+			req.InstanceUid = state.UID
+		}
+	}
+
+	uid := req.InstanceUid
+	if uid == "" && req.Instance != "" {
+		instancePid, err := strconv.Atoi(req.Instance)
+		if err != nil {
+			return nil, err
+		}
+		uid, _ = s.backgroundProcessManager.GetUID(bpm.ProcessPair{Pid: instancePid, CreateTime: req.StartTime})
+	}
+	if uid != "" {
+		if err := s.backgroundProcessManager.KillBackgroundProcess(ctx, uid); err != nil {
+			return nil, errors.Wrapf(err, "kill chaos dns server %s", uid)
+		}
+	}
+	if err := s.stateStore.delete(dnsChaosStateKind, req.ContainerId); err != nil {
+		log.Error(err, "error while deleting persisted dns chaos")
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// startDNSChaos starts the chaos dns server in the network namespace of the process, and redirects the DNS
+// queries to it with the chain of the state, a new chain is named after the server if it's empty. The uid of
+// the server is recorded in the state.
+func (s *DaemonServer) startDNSChaos(ctx context.Context, pid uint32, state *dnsChaosState) (*bpm.Process, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	processBuilder := bpm.DefaultProcessBuilder(chaosDaemonHelperCommand, "dns-server",
+		"--listen", chaosDNSServerListen,
+		"--upstream", state.Upstream,
+		"--rules", state.Rules,
+		"--mark", strconv.Itoa(chaosDNSMark)).
+		SetIdentifier(fmt.Sprintf("dns-chaos-%s", state.ContainerID))
+	if state.EnterNS {
+		processBuilder = processBuilder.SetNS(pid, bpm.NetNS)
+	}
+
+	cmd := processBuilder.Build(ctx)
+	proc, err := s.backgroundProcessManager.StartProcess(ctx, cmd)
+	if err != nil {
+		return nil, errors.Wrapf(err, "start process `%s`", cmd)
+	}
+
+	listens, err := waitForDNSServer(proc)
+	if err != nil {
+		if kerr := s.backgroundProcessManager.KillBackgroundProcess(ctx, proc.Uid); kerr != nil {
+			log.Error(kerr, "kill chaos dns server", "containerID", state.ContainerID)
+		}
+		return nil, err
+	}
+	_, port, err := net.SplitHostPort(listens[0])
+	if err != nil {
+		if kerr := s.backgroundProcessManager.KillBackgroundProcess(ctx, proc.Uid); kerr != nil {
+			log.Error(kerr, "kill chaos dns server", "containerID", state.ContainerID)
+		}
+		return nil, errors.Wrapf(err, "parse chaos dns server address %s", listens[0])
+	}
+
+	if state.Chain == "" {
+		state.Chain = chaosDNSChainPrefix + strconv.Itoa(proc.Pair.Pid)
+	}
+	dnsRedirect := buildDNSRedirect(ctx, state.EnterNS, pid, state.Chain)
+	// the redirect to the previous server is replaced
+	err = dnsRedirect.remove()
+	// the queries over IPv6 are redirected only if the chaos dns server listens on the IPv6 loopback,
+	// which means IPv6 is enabled in the network namespace
+	if err == nil {
+		err = dnsRedirect.apply(port, len(listens) > 1)
+	}
+	if err != nil {
+		if rerr := dnsRedirect.remove(); rerr != nil {
+			log.Error(rerr, "remove dns redirect", "containerID", state.ContainerID)
+		}
+		if kerr := s.backgroundProcessManager.KillBackgroundProcess(ctx, proc.Uid); kerr != nil {
+			log.Error(kerr, "kill chaos dns server", "containerID", state.ContainerID)
+		}
+		return nil, err
+	}
+
+	state.UID = proc.Uid
+	return proc, nil
+}
+
+// resumeDNSChaos starts the chaos dns servers persisted before chaos-daemon restarts again, because the
+// servers have exited with chaos-daemon while the redirects are left in the network namespaces. The redirect
+// is removed if the server could not be started, so the DNS queries are not blackholed.
+func (s *DaemonServer) resumeDNSChaos(ctx context.Context) {
+	states, err := loadStates[dnsChaosState](s.stateStore, dnsChaosStateKind)
+	if err != nil {
+		s.rootLogger.Error(err, "error while loading dns chaos states")
+		return
+	}
+
+	for _, state := range states {
+		s.rootLogger.Info("resume chaos dns server", "containerID", state.ContainerID)
+
+		pid, err := s.crClient.GetPidFromContainerID(ctx, state.ContainerID)
+		if err != nil {
+			// the container has gone, and the redirect has gone with its network namespace
+			s.rootLogger.Info("container of dns chaos has gone", "containerID", state.ContainerID)
+			if err := s.stateStore.delete(dnsChaosStateKind, state.ContainerID); err != nil {
+				s.rootLogger.Error(err, "error while deleting dns chaos state", "containerID", state.ContainerID)
+			}
+			continue
+		}
+
+		if _, err := s.startDNSChaos(ctx, pid, &state); err != nil {
+			s.rootLogger.Error(err, "error while resuming chaos dns server, remove its redirect", "containerID", state.ContainerID)
+			if err := buildDNSRedirect(ctx, state.EnterNS, pid, state.Chain).remove(); err != nil {
+				s.rootLogger.Error(err, "error while removing dns redirect", "containerID", state.ContainerID)
+			}
+			if err := s.stateStore.delete(dnsChaosStateKind, state.ContainerID); err != nil {
+				s.rootLogger.Error(err, "error while deleting dns chaos state", "containerID", state.ContainerID)
+			}
+			continue
+		}
+		if err := s.stateStore.save(dnsChaosStateKind, state.ContainerID, state); err != nil {
+			s.rootLogger.Error(err, "error while persisting dns chaos", "containerID", state.ContainerID)
+		}
+	}
+}
+
+// getUpstreamDNSServer returns the address of the first nameserver in the
+// /etc/resolv.conf of the container
+func (s *DaemonServer) getUpstreamDNSServer(ctx context.Context, pid uint32, enterNS bool) (string, error) {
+	output, err := s.execInMountNS(ctx, pid, enterNS, fmt.Sprintf("cat %s", DNSServerConfFile))
+	if err != nil {
+		return "", err
+	}
+
+	nameservers := dnschaos.ParseNameservers(string(output))
+	if len(nameservers) == 0 {
+		return "", errors.Errorf("no nameserver found in %s", DNSServerConfFile)
+	}
+	return net.JoinHostPort(nameservers[0], "53"), nil
+}
+
+// waitForDNSServer waits for the chaos dns server to print the ready line,
+// which means it's listening, and returns the addresses it's listening on.
+// The IPv4 address always comes first.
+func waitForDNSServer(proc *bpm.Process) ([]string, error) {
+	type result struct {
+		listens []string
+		err     error
+	}
+	ready := make(chan result, 1)
+	go func() {
+		line, err := bufio.NewReader(proc.Pipes.Stdout).ReadString('\n')
+		if err != nil {
+			ready <- result{err: errors.Wrap(err, "read chaos dns server output")}
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != helper.DNSServerReady {
+			ready <- result{err: errors.Errorf("unexpected chaos dns server output: %s", line)}
+			return
+		}
+		ready <- result{listens: fields[1:]}
+	}()
+
+	select {
+	case r := <-ready:
+		return r.listens, r.err
+	case <-proc.Stopped():
+		return nil, errors.New("chaos dns server exited unexpectedly")
+	case <-time.After(chaosDNSServerStartTimeout):
+		return nil, errors.New("chaos dns server startup takes too long")
+	}
+}
+
+// dnsRedirect redirects the DNS queries sent from the network namespace to the
+// chaos dns server with a chain in the nat table of both iptables and
+// ip6tables, except the queries forwarded by the chaos dns server itself.
+type dnsRedirect struct {
+	ctx     context.Context
+	enterNS bool
+	pid     uint32
+	chain   string
+}
+
+func buildDNSRedirect(ctx context.Context, enterNS bool, pid uint32, chain string) *dnsRedirect {
+	return &dnsRedirect{
+		ctx:     ctx,
+		enterNS: enterNS,
+		pid:     pid,
+		chain:   chain,
+	}
+}
+
+// apply redirects the queries over IPv4 to the port, and the queries over IPv6
+// as well if ipv6 is true
+func (r *dnsRedirect) apply(port string, ipv6 bool) error {
+	commands := []string{iptablesCmd}
+	if ipv6 {
+		commands = append(commands, ip6tablesCmd)
+	}
+
+	mark := strconv.Itoa(chaosDNSMark)
+	rules := [][]string{
+		{"-N", r.chain},
+		{"-A", r.chain, "-m", "mark", "--mark", mark, "-j", "RETURN"},
+		{"-A", r.chain, "-p", "udp", "--dport", "53", "-j", "REDIRECT", "--to-ports", port},
+		{"-A", r.chain, "-p", "tcp", "--dport", "53", "-j", "REDIRECT", "--to-ports", port},
+		{"-I", "OUTPUT", "-j", r.chain},
+	}
+	for _, command := range commands {
+		for _, rule := range rules {
+			if _, err := r.run(command, rule...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// remove deletes the chain and the rule jumping to it from both iptables and
+// ip6tables, it does nothing if the chain doesn't exist
+func (r *dnsRedirect) remove() error {
+	for _, command := range []string{iptablesCmd, ip6tablesCmd} {
+		if _, err := r.run(command, "-S", r.chain); err != nil {
+			continue
+		}
+
+		if _, err := r.run(command, "-C", "OUTPUT", "-j", r.chain); err == nil {
+			if _, err := r.run(command, "-D", "OUTPUT", "-j", r.chain); err != nil {
+				return err
+			}
+		}
+		if _, err := r.run(command, "-F", r.chain); err != nil {
+			return err
+		}
+		if _, err := r.run(command, "-X", r.chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *dnsRedirect) run(command string, args ...string) ([]byte, error) {
+	processBuilder := bpm.DefaultProcessBuilder(command, append([]string{"-w", "-t", "nat"}, args...)...).SetContext(r.ctx)
+	if r.enterNS {
+		processBuilder = processBuilder.SetNS(r.pid, bpm.NetNS)
+	}
+	out, err := processBuilder.Build(r.ctx).CombinedOutput()
+	if err != nil {
+		return out, util.EncodeOutputToError(out, err)
+	}
+	return out, nil
+}
+
+func convertDNSChaosRules(pbRules []*pb.DNSChaosRule) ([]dnschaos.Rule, error) {
+	rules := make([]dnschaos.Rule, 0, len(pbRules))
+	for _, pbRule := range pbRules {
+		rule := dnschaos.Rule{
+			Pattern: pbRule.Pattern,
+			Action:  dnschaos.Action(pbRule.Action),
+			Delay:   time.Duration(pbRule.Delay),
+		}
+		for _, answer := range pbRule.Answers {
+			ip := net.ParseIP(answer)
+			if ip == nil {
+				return nil, errors.Errorf("invalid answer %s for pattern %s", answer, pbRule.Pattern)
+			}
+			rule.Answers = append(rule.Answers, ip)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
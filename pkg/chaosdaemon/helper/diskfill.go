@@ -0,0 +1,121 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
+)
+
+const fillChunkSize = 4 * 1024 * 1024
+
+var (
+	fillSize         int64
+	fillUsagePercent int
+)
+
+var FillDiskCmd = &cobra.Command{
+	Use:   "fill-disk [path]",
+	Short: "allocate a file to fill the filesystem",
+	Long: `Allocate a file at the path to fill the filesystem which contains it.
+Either --size or --usage-percent should be specified. With --size, the file
+will be allocated to the given bytes. With --usage-percent, the file will be
+allocated until the usage of the filesystem reaches the given percentage.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			cmd.Help()
+			os.Exit(1)
+		}
+
+		if err := fillDisk(args[0], fillSize, fillUsagePercent); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+var CleanDiskFillCmd = &cobra.Command{
+	Use:   "clean-disk-fill [path]",
+	Short: "remove the file allocated by fill-disk",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			cmd.Help()
+			os.Exit(1)
+		}
+
+		if err := os.Remove(args[0]); err != nil && !errors.Is(err, os.ErrNotExist) {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	FillDiskCmd.Flags().Int64Var(&fillSize, "size", 0, "bytes to allocate")
+	FillDiskCmd.Flags().IntVar(&fillUsagePercent, "usage-percent", 0, "target usage of the filesystem in percent")
+}
+
+func fillDisk(path string, size int64, usagePercent int) error {
+	if usagePercent > 0 {
+		var err error
+		size, err = sizeToUsagePercent(path, usagePercent)
+		if err != nil {
+			return err
+		}
+	}
+
+	if size <= 0 {
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "create file %s", path)
+	}
+	defer f.Close()
+
+	err = allocate(f, size)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, unix.EOPNOTSUPP) {
+		return errors.Wrapf(err, "fallocate %d bytes for %s", size, path)
+	}
+
+	// the filesystem doesn't support fallocate, fill it with zero instead
+	buf := make([]byte, fillChunkSize)
+	for written := int64(0); written < size; {
+		chunk := buf
+		if size-written < int64(len(chunk)) {
+			chunk = chunk[:size-written]
+		}
+		n, err := f.Write(chunk)
+		written += int64(n)
+		if err != nil {
+			if errors.Is(err, unix.ENOSPC) {
+				// the filesystem is already full, which is what we want
+				break
+			}
+			return errors.Wrapf(err, "write %s", path)
+		}
+	}
+
+	return errors.Wrapf(f.Sync(), "sync %s", path)
+}
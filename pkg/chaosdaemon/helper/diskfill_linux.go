@@ -0,0 +1,48 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+func allocate(f *os.File, size int64) error {
+	return unix.Fallocate(int(f.Fd()), 0, 0, size)
+}
+
+// sizeToUsagePercent returns the bytes needed to make the usage of the
+// filesystem which contains the path reach the percentage
+func sizeToUsagePercent(path string, usagePercent int) (int64, error) {
+	var stat unix.Statfs_t
+	dir := filepath.Dir(path)
+	if err := unix.Statfs(dir, &stat); err != nil {
+		return 0, errors.Wrapf(err, "statfs %s", dir)
+	}
+
+	total := int64(stat.Blocks) * stat.Bsize
+	used := total - int64(stat.Bfree)*stat.Bsize
+	available := int64(stat.Bavail) * stat.Bsize
+
+	size := total*int64(usagePercent)/100 - used
+	if size > available {
+		size = available
+	}
+	return size, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestFillDiskWithSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fill")
+	if err := fillDisk(path, 1024*1024, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Size() != 1024*1024 {
+		t.Errorf("expected size %d, got %d", 1024*1024, info.Size())
+	}
+}
+
+func TestSizeToUsagePercent(t *testing.T) {
+	dir := t.TempDir()
+	var stat unix.Statfs_t
+	if err := unix.Statfs(dir, &stat); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	size, err := sizeToUsagePercent(filepath.Join(dir, "fill"), 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if available := int64(stat.Bavail) * stat.Bsize; size != available {
+		t.Errorf("expected size %d, got %d", available, size)
+	}
+}
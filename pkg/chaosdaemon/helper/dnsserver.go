@@ -0,0 +1,124 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/dnschaos"
+	"github.com/chaos-mesh/chaos-mesh/pkg/log"
+)
+
+// DNSServerReady is printed to the stdout by dns-server once it's listening,
+// followed by the addresses it's listening on
+const DNSServerReady = "ready"
+
+var (
+	dnsServerListen   string
+	dnsServerUpstream string
+	dnsServerRules    string
+	dnsServerMark     int
+)
+
+var DNSServerCmd = &cobra.Command{
+	Use:   "dns-server",
+	Short: "serve DNS queries with chaos in the current network namespace",
+	Long: `Listen on the address over both UDP and TCP, inject chaos into the queries
+matching the rules, and forward the other queries to the upstream server. The
+rules are encoded in JSON. If the port is 0, a random port is chosen for both
+UDP and TCP. If the address is the IPv4 loopback, the same port on the IPv6
+loopback is also listened on when IPv6 is enabled.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := serveDNS(dnsServerListen, dnsServerUpstream, dnsServerRules, dnsServerMark); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	DNSServerCmd.Flags().StringVar(&dnsServerListen, "listen", "127.0.0.1:53", "address to listen on")
+	DNSServerCmd.Flags().StringVar(&dnsServerUpstream, "upstream", "", "address of the upstream DNS server")
+	DNSServerCmd.Flags().StringVar(&dnsServerRules, "rules", "[]", "chaos rules in JSON")
+	DNSServerCmd.Flags().IntVar(&dnsServerMark, "mark", 0, "firewall mark of the queries forwarded to the upstream server")
+}
+
+func serveDNS(listen string, upstream string, rulesJSON string, mark int) error {
+	var rules []dnschaos.Rule
+	if err := json.Unmarshal([]byte(rulesJSON), &rules); err != nil {
+		return errors.Wrap(err, "parse rules")
+	}
+
+	// the stdout is used to notify the caller, so log to the stderr
+	handler := dnschaos.NewHandler(rules, upstream, mark, log.NewZapLoggerWithWriter(os.Stderr))
+
+	// listen before printing ready, so the caller could redirect the queries
+	// once it reads the ready line
+	servers, listen, err := listenDNS(listen, handler)
+	if err != nil {
+		return err
+	}
+	addresses := []string{listen}
+	host, port, err := net.SplitHostPort(listen)
+	if err != nil {
+		return errors.Wrapf(err, "parse address %s", listen)
+	}
+	if net.ParseIP(host).Equal(net.IPv4(127, 0, 0, 1)) {
+		// the IPv6 loopback is unavailable if IPv6 is disabled in the network namespace
+		if servers6, listen6, err := listenDNS(net.JoinHostPort("::1", port), handler); err == nil {
+			servers = append(servers, servers6...)
+			addresses = append(addresses, listen6)
+		}
+	}
+	fmt.Println(DNSServerReady, strings.Join(addresses, " "))
+
+	errCh := make(chan error, len(servers))
+	for _, server := range servers {
+		server := server
+		go func() {
+			errCh <- server.ActivateAndServe()
+		}()
+	}
+	return <-errCh
+}
+
+// listenDNS listens on the address over both UDP and TCP, and returns the
+// address it's listening on
+func listenDNS(listen string, handler dns.Handler) ([]*dns.Server, string, error) {
+	packetConn, err := net.ListenPacket("udp", listen)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "listen udp %s", listen)
+	}
+	// listen on the same port over TCP, in case a random port is chosen
+	listen = packetConn.LocalAddr().String()
+	listener, err := net.Listen("tcp", listen)
+	if err != nil {
+		packetConn.Close()
+		return nil, "", errors.Wrapf(err, "listen tcp %s", listen)
+	}
+	return []*dns.Server{
+		{PacketConn: packetConn, Handler: handler},
+		{Listener: listener, Handler: handler},
+	}, listen, nil
+}
@@ -96,30 +96,15 @@ func (s *DaemonServer) ApplyIOChaos(ctx context.Context, in *pb.ApplyIOChaosRequ
 	ch := channel.Line(proc.Pipes.Stdout, proc.Pipes.Stdin)
 	client := jrpc2.NewClient(ch, nil)
 
-	var result string
 	log.Info("Waiting for toda to start")
-	var rpcError error
-	maxWaitTime := time.Millisecond * 2000
-	timeOut, cancel := context.WithTimeout(ctx, maxWaitTime)
-	defer cancel()
-	if err := client.CallResult(timeOut, "update", []any{actions}, &result); err != nil {
-		log.Error(err, "update RPC call failed")
-
-		if kerr := s.killIOChaos(ctx, proc.Uid); kerr != nil {
-			log.Error(kerr, "kill toda", "request", in)
-		}
-
-		return nil, errors.Wrap(err, "toda update RPC failed")
-	}
-	rpcError = client.CallResult(timeOut, "get_status", []any{"ping"}, &result)
-	if rpcError != nil || result != "ok" {
-		log.Info("Starting toda takes too long or encounter an error")
+	if err := updateToda(ctx, client, actions); err != nil {
+		log.Error(err, "failed to update toda")
 
 		if kerr := s.killIOChaos(ctx, proc.Uid); kerr != nil {
 			log.Error(kerr, "kill toda", "request", in)
 		}
 
-		return nil, errors.Errorf("toda startup takes too long or an error occurs: %s", result)
+		return nil, err
 	}
 
 	return &pb.ApplyIOChaosResponse{
@@ -129,6 +114,25 @@ func (s *DaemonServer) ApplyIOChaos(ctx context.Context, in *pb.ApplyIOChaosRequ
 	}, nil
 }
 
+// updateToda sends the actions to toda, and waits for toda to be ready. Every field of the actions is passed through
+// as is, so the actions such as shortRW only take effect with a toda release which implements them. An older toda
+// rejects the unknown action in the update RPC.
+func updateToda(ctx context.Context, client *jrpc2.Client, actions []v1alpha1.IOChaosAction) error {
+	maxWaitTime := time.Millisecond * 2000
+	timeOut, cancel := context.WithTimeout(ctx, maxWaitTime)
+	defer cancel()
+
+	var result string
+	if err := client.CallResult(timeOut, "update", []any{actions}, &result); err != nil {
+		return errors.Wrap(err, "toda update RPC failed")
+	}
+	err := client.CallResult(timeOut, "get_status", []any{"ping"}, &result)
+	if err != nil || result != "ok" {
+		return errors.Errorf("toda startup takes too long or an error occurs: %s", result)
+	}
+	return nil
+}
+
 func (s *DaemonServer) killIOChaos(ctx context.Context, uid string) error {
 	log := s.getLoggerFromContext(ctx)
 
@@ -0,0 +1,79 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/creachadair/jrpc2"
+	"github.com/creachadair/jrpc2/handler"
+	"github.com/creachadair/jrpc2/server"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func Test_updateToda(t *testing.T) {
+	g := NewWithT(t)
+
+	var params []json.RawMessage
+	local := server.NewLocal(handler.Map{
+		"update": func(ctx context.Context, req *jrpc2.Request) (any, error) {
+			return "ok", req.UnmarshalParams(&params)
+		},
+		"get_status": func(ctx context.Context, req *jrpc2.Request) (any, error) {
+			return "ok", nil
+		},
+	}, nil)
+	defer local.Close()
+
+	err := updateToda(context.Background(), local.Client, []v1alpha1.IOChaosAction{{
+		Type:        v1alpha1.IoShortRW,
+		Filter:      v1alpha1.Filter{Path: "/var/run/data/**/*", Methods: []v1alpha1.IoMethod{v1alpha1.Read}, Percent: 50},
+		ShortRWSpec: &v1alpha1.ShortRWSpec{Ratio: "0.5"},
+	}})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(params).To(HaveLen(1))
+	g.Expect(string(params[0])).To(MatchJSON(`[{
+		"type": "shortRW",
+		"path": "/var/run/data/**/*",
+		"methods": ["read"],
+		"percent": 50,
+		"shortRW": {"ratio": "0.5"}
+	}]`))
+}
+
+func Test_updateTodaRejected(t *testing.T) {
+	g := NewWithT(t)
+
+	local := server.NewLocal(handler.Map{
+		"update": func(ctx context.Context, req *jrpc2.Request) (any, error) {
+			return nil, errors.New("unknown variant `shortRW`")
+		},
+	}, nil)
+	defer local.Close()
+
+	err := updateToda(context.Background(), local.Client, []v1alpha1.IOChaosAction{{
+		Type:        v1alpha1.IoShortRW,
+		ShortRWSpec: &v1alpha1.ShortRWSpec{Size: 1},
+	}})
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(err.Error()).Should(ContainSubstring("toda update RPC failed"))
+}
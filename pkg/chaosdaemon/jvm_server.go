@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -32,6 +33,11 @@ import (
 const (
 	bmInstallCommand = "bminstall.sh -b -Dorg.jboss.byteman.transform.all -Dorg.jboss.byteman.verbose -Dorg.jboss.byteman.compileToBytecode -p %d %d"
 	bmSubmitCommand  = "bmsubmit.sh -p %d -%s %s"
+
+	// jvmRuleHitsPropertyPrefix is the prefix of the system properties which record
+	// the hit counts of the rules, the byteman agent only lists the system properties
+	// starting with "org.jboss.byteman."
+	jvmRuleHitsPropertyPrefix = "org.jboss.byteman.chaos_mesh.hits."
 )
 
 func (s *DaemonServer) InstallJVMRules(ctx context.Context,
@@ -138,7 +144,7 @@ func (s *DaemonServer) InstallJVMRules(ctx context.Context,
 	}
 
 	// submit rules
-	filename, err := writeDataIntoFile(req.Rule, "rule.btm")
+	filename, err := writeDataIntoFile(instrumentJVMRules(req.Rule), "rule.btm")
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +176,7 @@ func (s *DaemonServer) UninstallJVMRules(ctx context.Context,
 		return nil, err
 	}
 
-	filename, err := writeDataIntoFile(req.Rule, "rule.btm")
+	filename, err := writeDataIntoFile(instrumentJVMRules(req.Rule), "rule.btm")
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +203,122 @@ func (s *DaemonServer) UninstallJVMRules(ctx context.Context,
 	return &empty.Empty{}, nil
 }
 
+func (s *DaemonServer) GetJVMRules(ctx context.Context,
+	req *pb.GetJVMRulesRequest) (*pb.GetJVMRulesResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("GetJVMRules", "request", req)
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "GetPidFromContainerID")
+		return nil, err
+	}
+
+	submit := func(option string) (string, error) {
+		bmSubmitCmd := fmt.Sprintf(bmSubmitCommand, req.Port, option, "")
+		processBuilder := bpm.DefaultProcessBuilder("sh", "-c", bmSubmitCmd).SetContext(ctx)
+		if req.EnterNS {
+			processBuilder = processBuilder.SetNS(pid, bpm.NetNS)
+		}
+		output, err := processBuilder.Build(ctx).CombinedOutput()
+		if err != nil {
+			log.Error(err, string(output))
+			return "", errors.Wrap(err, string(output))
+		}
+		return string(output), nil
+	}
+
+	// list the rules and how they are applied
+	rules, err := submit("l")
+	if err != nil {
+		return nil, err
+	}
+	// list the system properties, which contain the hit counts
+	properties, err := submit("y")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetJVMRulesResponse{
+		Rules: parseJVMRules(rules, properties),
+	}, nil
+}
+
+// instrumentJVMRules makes every rule record its hit count in a system property
+// before taking its own actions
+func instrumentJVMRules(rules string) string {
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	lines := strings.Split(rules, "\n")
+	name := ""
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "RULE ") {
+			name = strings.TrimSpace(strings.TrimPrefix(trimmed, "RULE "))
+			continue
+		}
+		if trimmed != "DO" && !strings.HasPrefix(trimmed, "DO ") && !strings.HasPrefix(trimmed, "DO\t") {
+			continue
+		}
+
+		key := escaper.Replace(jvmRuleHitsPropertyPrefix + name)
+		action := fmt.Sprintf("System.setProperty(\"%s\", String.valueOf(incrementCounter(\"%s\")));", key, key)
+		indent := line[:strings.Index(line, "DO")]
+		lines[i] = strings.TrimRight(fmt.Sprintf("%sDO %s %s", indent, action, strings.TrimSpace(trimmed[2:])), " ")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// parseJVMRules parses the output of listing the rules and the system properties
+// by the byteman agent. A rule is transformed once it's injected into a loaded
+// class, and the first failure of compiling or executing the rule is reported
+// as the error.
+func parseJVMRules(rules string, properties string) []*pb.JVMRuleStatus {
+	statuses := []*pb.JVMRuleStatus{}
+	var current *pb.JVMRuleStatus
+	for _, line := range strings.Split(rules, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "RULE "):
+			current = &pb.JVMRuleStatus{
+				Name: strings.TrimSpace(strings.TrimPrefix(line, "RULE ")),
+			}
+			statuses = append(statuses, current)
+		case current == nil:
+			continue
+		case line == "Transformed in:":
+			current.Transformed = true
+		case strings.HasPrefix(line, "failed") || strings.HasPrefix(line, "threw "):
+			if len(current.Error) == 0 {
+				current.Error = line
+			}
+		}
+	}
+
+	hits := make(map[string]int64)
+	for _, line := range strings.Split(properties, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, jvmRuleHitsPropertyPrefix) {
+			continue
+		}
+		property := strings.TrimPrefix(line, jvmRuleHitsPropertyPrefix)
+		index := strings.LastIndex(property, "=")
+		if index < 0 {
+			continue
+		}
+		count, err := strconv.ParseInt(strings.TrimSpace(property[index+1:]), 10, 64)
+		if err != nil {
+			continue
+		}
+		hits[property[:index]] = count
+	}
+	for _, status := range statuses {
+		status.Hits = hits[status.Name]
+	}
+
+	return statuses
+}
+
 func writeDataIntoFile(data string, filename string) (string, error) {
 	tmpfile, err := os.CreateTemp("", filename)
 	if err != nil {
@@ -0,0 +1,88 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_InstrumentJVMRules(t *testing.T) {
+	g := NewWithT(t)
+
+	rules := instrumentJVMRules("\nRULE test\nCLASS Main\nMETHOD sayhello\nAT ENTRY\nIF true\nDO\n\tThread.sleep(5000L);\nENDRULE\n\nRULE test2\nCLASS Main\nMETHOD print\nAT ENTRY\nIF true\nDO return\nENDRULE\n")
+	g.Expect(rules).To(Equal("\nRULE test\nCLASS Main\nMETHOD sayhello\nAT ENTRY\nIF true\n" +
+		"DO System.setProperty(\"org.jboss.byteman.chaos_mesh.hits.test\", String.valueOf(incrementCounter(\"org.jboss.byteman.chaos_mesh.hits.test\")));\n" +
+		"\tThread.sleep(5000L);\nENDRULE\n\nRULE test2\nCLASS Main\nMETHOD print\nAT ENTRY\nIF true\n" +
+		"DO System.setProperty(\"org.jboss.byteman.chaos_mesh.hits.test2\", String.valueOf(incrementCounter(\"org.jboss.byteman.chaos_mesh.hits.test2\"))); return\n" +
+		"ENDRULE\n"))
+}
+
+func Test_ParseJVMRules(t *testing.T) {
+	g := NewWithT(t)
+
+	rules := `# File /tmp/rule.btm1234 line 2
+RULE test
+CLASS Main
+METHOD sayhello
+AT ENTRY
+IF true
+DO Thread.sleep(5000L);
+ENDRULE
+Transformed in:
+loader: jdk.internal.loader.ClassLoaders$AppClassLoader@277050dc
+trigger method: Main.sayhello() void
+compiled successfully
+# File /tmp/rule.btm1234 line 11
+RULE test2
+CLASS Main
+METHOD notExist
+AT ENTRY
+IF true
+DO return
+ENDRULE
+# File /tmp/rule.btm1234 line 19
+RULE test3
+CLASS Main
+METHOD print
+AT ENTRY
+IF true
+DO return 1
+ENDRULE
+Transformed in:
+loader: jdk.internal.loader.ClassLoaders$AppClassLoader@277050dc
+trigger method: Main.print() void
+failed to compile
+`
+	properties := `org.jboss.byteman.transform.all=
+org.jboss.byteman.chaos_mesh.hits.test=42
+org.jboss.byteman.chaos_mesh.hits.test3=invalid
+`
+
+	statuses := parseJVMRules(rules, properties)
+	g.Expect(statuses).To(HaveLen(3))
+	g.Expect(statuses[0].Name).To(Equal("test"))
+	g.Expect(statuses[0].Transformed).To(BeTrue())
+	g.Expect(statuses[0].Hits).To(Equal(int64(42)))
+	g.Expect(statuses[0].Error).To(BeEmpty())
+	g.Expect(statuses[1].Name).To(Equal("test2"))
+	g.Expect(statuses[1].Transformed).To(BeFalse())
+	g.Expect(statuses[2].Name).To(Equal("test3"))
+	g.Expect(statuses[2].Transformed).To(BeTrue())
+	g.Expect(statuses[2].Hits).To(Equal(int64(0)))
+	g.Expect(statuses[2].Error).To(Equal("failed to compile"))
+}
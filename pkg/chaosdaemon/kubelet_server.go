@@ -0,0 +1,117 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
+)
+
+const (
+	kubeletServiceName = "kubelet"
+
+	// kubeletRecoverUnit is the transient systemd unit on the node which starts the kubelet again
+	// after the duration, so the node recovers by itself even if the chaos-daemon is not available,
+	// e.g. it cannot be restarted by the stopped kubelet.
+	kubeletRecoverUnit = "chaos-mesh-kubelet-recover"
+)
+
+// StopKubelet stops the kubelet service on the node, and schedules a systemd timer
+// to start it again after the duration
+func (s *DaemonServer) StopKubelet(ctx context.Context, req *pb.StopKubeletRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	log.Info("stop kubelet", "request", req)
+
+	if req.DurationMs <= 0 {
+		err := errors.Errorf("invalid duration %dms", req.DurationMs)
+		log.Error(err, "duration is not expected")
+		return nil, err
+	}
+
+	// the timer left by the last injection should be replaced
+	if err := runOnHost(ctx, "systemctl", "stop", kubeletRecoverUnit+".timer"); err != nil {
+		log.Info("no recover timer is stopped", "output", err.Error())
+	}
+
+	duration := time.Duration(req.DurationMs) * time.Millisecond
+	if err := runOnHost(ctx, kubeletRecoverTimerCommand(duration)...); err != nil {
+		log.Error(err, "schedule kubelet recovery")
+		return nil, err
+	}
+
+	if err := runOnHost(ctx, "systemctl", "stop", kubeletServiceName); err != nil {
+		log.Error(err, "stop kubelet")
+		if err := runOnHost(ctx, "systemctl", "stop", kubeletRecoverUnit+".timer"); err != nil {
+			log.Error(err, "cancel kubelet recovery")
+		}
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// RecoverKubelet starts the kubelet service stopped by StopKubelet, and cancels the scheduled timer
+func (s *DaemonServer) RecoverKubelet(ctx context.Context, req *pb.RecoverKubeletRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	log.Info("recover kubelet", "request", req)
+
+	if err := runOnHost(ctx, "systemctl", "start", kubeletServiceName); err != nil {
+		log.Error(err, "start kubelet")
+		return nil, err
+	}
+
+	// the timer doesn't exist if it has been triggered
+	if err := runOnHost(ctx, "systemctl", "stop", kubeletRecoverUnit+".timer"); err != nil {
+		log.Info("no recover timer is stopped", "output", err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// kubeletRecoverTimerCommand returns the command to start the kubelet after the duration with a transient timer
+func kubeletRecoverTimerCommand(duration time.Duration) []string {
+	return []string{
+		"systemd-run",
+		"--unit=" + kubeletRecoverUnit,
+		"--collect",
+		fmt.Sprintf("--on-active=%dms", duration.Milliseconds()),
+		"--timer-property=AccuracySec=1s",
+		"systemctl", "start", kubeletServiceName,
+	}
+}
+
+// runOnHost runs the command in the mount namespace of the node
+func runOnHost(ctx context.Context, args ...string) error {
+	out, err := bpm.DefaultProcessBuilder(args[0], args[1:]...).
+		SetContext(ctx).
+		SetNS(1, bpm.MountNS).
+		Build(ctx).
+		CombinedOutput()
+	if err != nil {
+		return util.EncodeOutputToError(out, err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_KubeletRecoverTimerCommand(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(kubeletRecoverTimerCommand(90 * time.Second)).To(Equal([]string{
+		"systemd-run",
+		"--unit=chaos-mesh-kubelet-recover",
+		"--collect",
+		"--on-active=90000ms",
+		"--timer-property=AccuracySec=1s",
+		"systemctl", "start", "kubelet",
+	}))
+}
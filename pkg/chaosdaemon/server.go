@@ -55,6 +55,9 @@ type Config struct {
 	Host           string
 	CrClientConfig *crclients.CrClientConfig
 	Profiling      bool
+	// StateDir is the directory to persist the state of long-running chaos, such as drifting clocks, it should be
+	// kept across the restarts of chaos-daemon. The state is not persisted if it's empty.
+	StateDir string
 
 	tlsConfig
 }
@@ -85,8 +88,14 @@ type DaemonServer struct {
 	// tproxyLocker is a set of tproxy processes to lock stdin/stdout/stderr
 	tproxyLocker *sync.Map
 
+	// crashLoops is a set of the cancel functions of the running crash loops
+	crashLoops *sync.Map
+
 	IPSetLocker     *locker.Locker
 	timeChaosServer TimeChaosServer
+
+	// stateStore persists the state of long-running chaos, to resume them after restart
+	stateStore *stateStore
 }
 
 func (s *DaemonServer) getLoggerFromContext(ctx context.Context) logr.Logger {
@@ -104,21 +113,31 @@ func newDaemonServer(clientConfig *crclients.CrClientConfig, reg prometheus.Regi
 
 // NewDaemonServerWithCRClient returns DaemonServer with container runtime client
 func NewDaemonServerWithCRClient(crClient crclients.ContainerRuntimeInfoClient, reg prometheus.Registerer, log logr.Logger) *DaemonServer {
+	store := newStateStore("")
 	return &DaemonServer{
 		IPSetLocker:              locker.New(),
 		crClient:                 crClient,
 		backgroundProcessManager: bpm.StartBackgroundProcessManager(reg, log),
 		tproxyLocker:             new(sync.Map),
+		crashLoops:               new(sync.Map),
+		stateStore:               store,
 		rootLogger:               log,
 		timeChaosServer: TimeChaosServer{
 			podContainerNameProcessMap: tasks.NewPodProcessMap(),
 			manager:                    tasks.NewTaskManager(logr.New(log.GetSink()).WithName("TimeChaos")),
 			nameLocker:                 tasks.NewLockMap[tasks.PodContainerName](),
 			logger:                     logr.New(log.GetSink()).WithName("TimeChaos"),
+			drifters:                   make(map[tasks.TaskID]context.CancelFunc),
+			stateStore:                 store,
 		},
 	}
 }
 
+func (s *DaemonServer) setStateStore(store *stateStore) {
+	s.stateStore = store
+	s.timeChaosServer.stateStore = store
+}
+
 func newGRPCServer(daemonServer *DaemonServer, reg prometheus.Registerer, tlsConf tlsConfig) (*grpc.Server, error) {
 	grpcMetrics := grpc_prometheus.NewServerMetrics()
 	grpcMetrics.EnableHandlingTimeHistogram(
@@ -212,6 +231,7 @@ func BuildServer(conf *Config, reg RegisterGatherer, log logr.Logger) (*Server,
 	if err != nil {
 		return nil, errors.Wrap(err, "create daemon server")
 	}
+	server.daemonServer.setStateStore(newStateStore(conf.StateDir))
 
 	server.httpServer = newHTTPServerBuilder().Addr(conf.HttpAddr()).Metrics(reg).Profiling(conf.Profiling).Build()
 	server.grpcServer, err = newGRPCServer(server.daemonServer, reg, conf.tlsConfig)
@@ -224,6 +244,8 @@ func BuildServer(conf *Config, reg RegisterGatherer, log logr.Logger) (*Server,
 
 // Start starts chaos-daemon.
 func (s *Server) Start() error {
+	s.daemonServer.resumeStates(context.Background())
+
 	grpcBindAddr := s.conf.GrpcAddr()
 	grpcListener, err := net.Listen("tcp", grpcBindAddr)
 	if err != nil {
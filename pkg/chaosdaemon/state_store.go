@@ -0,0 +1,123 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const stateFileSuffix = ".json"
+
+// stateStore persists the state of long-running chaos, which is driven by chaos-daemon itself rather than by
+// the requests from the controller, so that it could be resumed after chaos-daemon restarts. Every state is
+// stored as a JSON file in the directory of its kind. Nothing is persisted if the directory is empty.
+type stateStore struct {
+	dir string
+}
+
+func newStateStore(dir string) *stateStore {
+	return &stateStore{dir: dir}
+}
+
+func (s *stateStore) path(kind string, key string) string {
+	return filepath.Join(s.dir, kind, url.PathEscape(key)+stateFileSuffix)
+}
+
+// save writes the state with the key, the existing one is replaced
+func (s *stateStore) save(kind string, key string, state any) error {
+	if s.dir == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errors.Wrapf(err, "marshal %s state %s", kind, key)
+	}
+	if err := os.MkdirAll(filepath.Join(s.dir, kind), 0700); err != nil {
+		return errors.Wrapf(err, "create state directory of %s", kind)
+	}
+
+	// write to a temporary file and rename it, to avoid leaving a partial state if chaos-daemon exits
+	path := s.path(kind, key)
+	if err := os.WriteFile(path+".tmp", data, 0600); err != nil {
+		return errors.Wrapf(err, "write %s state %s", kind, key)
+	}
+	return errors.Wrapf(os.Rename(path+".tmp", path), "write %s state %s", kind, key)
+}
+
+// delete removes the state with the key, it does nothing if the state doesn't exist
+func (s *stateStore) delete(kind string, key string) error {
+	if s.dir == "" {
+		return nil
+	}
+
+	err := os.Remove(s.path(kind, key))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "delete %s state %s", kind, key)
+	}
+	return nil
+}
+
+// loadStates returns all states of the kind by their keys
+func loadStates[T any](s *stateStore, kind string) (map[string]T, error) {
+	states := make(map[string]T)
+	if s.dir == "" {
+		return states, nil
+	}
+
+	entries, err := os.ReadDir(filepath.Join(s.dir, kind))
+	if os.IsNotExist(err) {
+		return states, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "read state directory of %s", kind)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), stateFileSuffix) {
+			continue
+		}
+		key, err := url.PathUnescape(strings.TrimSuffix(entry.Name(), stateFileSuffix))
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse key of %s state %s", kind, entry.Name())
+		}
+		data, err := os.ReadFile(filepath.Join(s.dir, kind, entry.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "read %s state %s", kind, key)
+		}
+		var state T
+		if err := json.Unmarshal(data, &state); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal %s state %s", kind, key)
+		}
+		states[key] = state
+	}
+	return states, nil
+}
+
+// resumeStates resumes the long-running chaos persisted before chaos-daemon restarts
+func (s *DaemonServer) resumeStates(ctx context.Context) {
+	s.resumeDrifts(ctx)
+	s.resumeCrashLoops()
+	s.resumeGracefulKills()
+	s.resumeDNSChaos(ctx)
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_stateStore(t *testing.T) {
+	type state struct {
+		Name  string    `json:"name"`
+		Start time.Time `json:"start"`
+	}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("save, load and delete states", func(t *testing.T) {
+		g := NewWithT(t)
+		store := newStateStore(t.TempDir())
+
+		g.Expect(store.save("kind", "pod-uid:container/name", state{Name: "a", Start: start})).To(Succeed())
+		g.Expect(store.save("kind", "b", state{Name: "b"})).To(Succeed())
+		g.Expect(store.save("kind", "b", state{Name: "b", Start: start})).To(Succeed())
+		g.Expect(store.save("other", "c", state{Name: "c"})).To(Succeed())
+
+		states, err := loadStates[state](store, "kind")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(states).To(Equal(map[string]state{
+			"pod-uid:container/name": {Name: "a", Start: start},
+			"b":                      {Name: "b", Start: start},
+		}))
+
+		g.Expect(store.delete("kind", "pod-uid:container/name")).To(Succeed())
+		g.Expect(store.delete("kind", "not-exist")).To(Succeed())
+		states, err = loadStates[state](store, "kind")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(states).To(HaveLen(1))
+		g.Expect(states).To(HaveKey("b"))
+	})
+
+	t.Run("nothing is persisted without directory", func(t *testing.T) {
+		g := NewWithT(t)
+		store := newStateStore("")
+
+		g.Expect(store.save("kind", "a", state{Name: "a"})).To(Succeed())
+		states, err := loadStates[state](store, "kind")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(states).To(BeEmpty())
+		g.Expect(store.delete("kind", "a")).To(Succeed())
+	})
+}
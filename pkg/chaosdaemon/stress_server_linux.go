@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/cgroups"
@@ -125,11 +126,7 @@ func (s *DaemonServer) ExecCPUStressors(ctx context.Context,
 		return nil, err
 	}
 
-	processBuilder := bpm.DefaultProcessBuilder("stress-ng", strings.Fields(req.CpuStressors)...).
-		EnablePause()
-	if req.EnterNS {
-		processBuilder = processBuilder.SetNS(pid, bpm.PidNS)
-	}
+	processBuilder := stressNGProcessBuilder(pid, strings.Fields(req.CpuStressors), req.EnterNS)
 	cmd := processBuilder.Build(ctx)
 
 	proc, err := s.backgroundProcessManager.StartProcess(ctx, cmd)
@@ -169,6 +166,35 @@ func (s *DaemonServer) ExecCPUStressors(ctx context.Context,
 	return proc, nil
 }
 
+// stressNGProcessBuilder builds the stress-ng process in the namespaces of the target. Besides the pid namespace,
+// the stressors working on files enter the mount namespace to write their temporary files into the filesystem of
+// the target, and the socket stressors enter the network namespace to stress the network stack of the target.
+func stressNGProcessBuilder(pid uint32, args []string, enterNS bool) *bpm.CommandBuilder {
+	processBuilder := bpm.DefaultProcessBuilder("stress-ng", args...).
+		EnablePause()
+	if !enterNS {
+		return processBuilder
+	}
+
+	processBuilder = processBuilder.SetNS(pid, bpm.PidNS)
+	var enterMnt, enterNet bool
+	for _, arg := range args {
+		switch arg {
+		case "--hdd", "--iomix", "--temp-path":
+			enterMnt = true
+		case "--sock":
+			enterNet = true
+		}
+	}
+	if enterMnt {
+		processBuilder = processBuilder.SetNS(pid, bpm.MountNS).EnableLocalMnt()
+	}
+	if enterNet {
+		processBuilder = processBuilder.SetNS(pid, bpm.NetNS)
+	}
+	return processBuilder
+}
+
 func (s *DaemonServer) ExecMemoryStressors(ctx context.Context,
 	req *pb.ExecStressRequest) (*bpm.Process, error) {
 	log := s.getLoggerFromContext(ctx)
@@ -185,7 +211,21 @@ func (s *DaemonServer) ExecMemoryStressors(ctx context.Context,
 		return nil, err
 	}
 
-	processBuilder := bpm.DefaultProcessBuilder("memStress", strings.Fields(req.MemoryStressors)...).
+	args := strings.Fields(req.MemoryStressors)
+	if req.MemoryLimitPercent > 0 {
+		limit, err := cgroups.GetMemoryLimitForPID(int(pid))
+		if err != nil {
+			return nil, err
+		}
+		if limit == 0 {
+			return nil, errors.Errorf("container %s has no memory limit", req.Target)
+		}
+		size := memoryStressSizePerWorker(limit, int(req.MemoryLimitPercent), args)
+		log.Info("calculate memory stress size from memory limit", "limit", limit, "percent", req.MemoryLimitPercent, "size", size)
+		args = append(args, "--size", strconv.FormatUint(size, 10))
+	}
+
+	processBuilder := bpm.DefaultProcessBuilder("memStress", args...).
 		EnablePause()
 
 	if req.OomScoreAdj != 0 {
@@ -231,3 +271,17 @@ func (s *DaemonServer) ExecMemoryStressors(ctx context.Context,
 
 	return proc, nil
 }
+
+// memoryStressSizePerWorker divides the given percentage of the memory limit
+// among the workers of memStress, as its `--size` is the bytes consumed per worker
+func memoryStressSizePerWorker(limit uint64, percent int, args []string) uint64 {
+	workers := 1
+	for i, arg := range args {
+		if arg == "--workers" && i+1 < len(args) {
+			if n, err := strconv.Atoi(args[i+1]); err == nil && n > 0 {
+				workers = n
+			}
+		}
+	}
+	return limit * uint64(percent) / 100 / uint64(workers)
+}
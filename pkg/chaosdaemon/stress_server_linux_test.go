@@ -0,0 +1,81 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_stressNGProcessBuilder(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		enterNS bool
+		nsArgs  []string
+	}{
+		{
+			name:    "cpu stressors only enter the pid namespace",
+			args:    "--cpu 1 --cpu-load 50",
+			enterNS: true,
+			nsArgs:  []string{"-p", "/proc/9527/ns/pid"},
+		}, {
+			name:    "hdd stressors enter the mount namespace",
+			args:    "--hdd 1 --hdd-bytes 1GB",
+			enterNS: true,
+			nsArgs:  []string{"-l", "-m", "/proc/9527/ns/mnt", "-p", "/proc/9527/ns/pid"},
+		}, {
+			name:    "iomix stressors enter the mount namespace",
+			args:    "--iomix 1 --temp-path /data",
+			enterNS: true,
+			nsArgs:  []string{"-l", "-m", "/proc/9527/ns/mnt", "-p", "/proc/9527/ns/pid"},
+		}, {
+			name:    "socket stressors enter the network namespace",
+			args:    "--sock 2 --sock-port 5000",
+			enterNS: true,
+			nsArgs:  []string{"-n", "/proc/9527/ns/net", "-p", "/proc/9527/ns/pid"},
+		}, {
+			name:    "mixed stressors enter all the required namespaces",
+			args:    "--hdd 1 --sock 1 --fork 1",
+			enterNS: true,
+			nsArgs:  []string{"-l", "-n", "/proc/9527/ns/net", "-m", "/proc/9527/ns/mnt", "-p", "/proc/9527/ns/pid"},
+		}, {
+			name:    "no namespace is entered without enterNS",
+			args:    "--hdd 1 --sock 1",
+			enterNS: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			built := stressNGProcessBuilder(9527, strings.Fields(tt.args), tt.enterNS).Build(context.Background()).Args
+
+			// the command is started by pause, and then by nsexec if any namespace is entered
+			g.Expect(built[0]).To(Equal("/usr/local/bin/pause"))
+			if len(tt.nsArgs) == 0 {
+				g.Expect(built[1:]).To(Equal(append([]string{"stress-ng"}, strings.Fields(tt.args)...)))
+				return
+			}
+			g.Expect(built[1]).To(Equal("/usr/local/bin/nsexec"))
+			g.Expect(built[2 : 2+len(tt.nsArgs)]).To(Equal(tt.nsArgs))
+			g.Expect(built[2+len(tt.nsArgs):]).To(Equal(append([]string{"--", "stress-ng"}, strings.Fields(tt.args)...)))
+		})
+	}
+}
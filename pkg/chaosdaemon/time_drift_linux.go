@@ -0,0 +1,169 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tasks"
+	timeskew "github.com/chaos-mesh/chaos-mesh/pkg/time"
+)
+
+const (
+	// driftInterval is the interval to step the clock of a drifting time skew
+	driftInterval = time.Second
+
+	// driftStateKind is the kind of the persisted states of the drifting time skews
+	driftStateKind = "time-drift"
+)
+
+// driftState is the persisted state of a drifting time skew, the offset at any moment is recomputed from it, so
+// the drift could be resumed after chaos-daemon restarts.
+type driftState struct {
+	UID              tasks.TaskID           `json:"uid"`
+	PodContainerName tasks.PodContainerName `json:"podContainerName"`
+	ContainerID      string                 `json:"containerID"`
+	Sec              int64                  `json:"sec"`
+	Nsec             int64                  `json:"nsec"`
+	ClkIdsMask       uint64                 `json:"clkIdsMask"`
+	DriftRate        int64                  `json:"driftRate"`
+	StartTime        time.Time              `json:"startTime"`
+}
+
+// offsetAt returns the time offset at the given moment, which is the base offset plus the drift accumulated since
+// the start time
+func (state *driftState) offsetAt(now time.Time) time.Duration {
+	offset := time.Duration(state.Sec)*time.Second + time.Duration(state.Nsec)
+	if elapsed := now.Sub(state.StartTime); elapsed > 0 {
+		offset += driftOffset(elapsed, state.DriftRate)
+	}
+	return offset
+}
+
+// configAt returns the time skew config at the given moment
+func (state *driftState) configAt(now time.Time) timeskew.Config {
+	offset := state.offsetAt(now)
+	return timeskew.NewConfig(int64(offset/time.Second), int64(offset%time.Second), state.ClkIdsMask)
+}
+
+// drifting returns true if the clock of the task is drifting
+func (s *TimeChaosServer) drifting(uid tasks.TaskID) bool {
+	s.driftersLock.Lock()
+	defer s.driftersLock.Unlock()
+
+	_, ok := s.drifters[uid]
+	return ok
+}
+
+// startDrift steps the time offset of the task every driftInterval, so that the clock of the target process runs
+// away from the real one with the drift rate in parts per million. The state is persisted to resume the drift
+// after chaos-daemon restarts.
+func (s *TimeChaosServer) startDrift(state driftState) {
+	s.driftersLock.Lock()
+	defer s.driftersLock.Unlock()
+
+	if _, ok := s.drifters[state.UID]; ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.drifters[state.UID] = cancel
+	if err := s.stateStore.save(driftStateKind, state.UID, state); err != nil {
+		s.logger.Error(err, "error while persisting drift state, the drift won't be resumed after restart", "uid", state.UID)
+	}
+
+	go func() {
+		ticker := time.NewTicker(driftInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if err := s.stepDrift(ctx, state.UID, state.PodContainerName, state.configAt(now)); err != nil {
+					// the target process may have gone, stop drifting to avoid retrying forever
+					s.logger.Error(err, "error while stepping drifting clock, stop drifting", "uid", state.UID, "podContainerName", state.PodContainerName)
+					s.stopDrift(state.UID)
+					return
+				}
+			}
+		}
+	}()
+}
+
+func (s *TimeChaosServer) stepDrift(ctx context.Context, uid tasks.TaskID, id tasks.PodContainerName, config timeskew.Config) error {
+	unlock := s.nameLocker.Lock(id)
+	defer unlock()
+
+	// the task may have been recovered while waiting for the lock
+	if ctx.Err() != nil {
+		return nil
+	}
+	return s.manager.Update(uid, id, &config)
+}
+
+// stopDrift stops stepping the clock of the task, it does nothing if the task is not drifting
+func (s *TimeChaosServer) stopDrift(uid tasks.TaskID) {
+	s.driftersLock.Lock()
+	defer s.driftersLock.Unlock()
+
+	if cancel, ok := s.drifters[uid]; ok {
+		cancel()
+		delete(s.drifters, uid)
+	}
+	if err := s.stateStore.delete(driftStateKind, uid); err != nil {
+		s.logger.Error(err, "error while deleting drift state", "uid", uid)
+	}
+}
+
+// resumeDrifts applies the persisted drifting time skews again after chaos-daemon restarts, with the offsets
+// accumulated while chaos-daemon was not running
+func (s *DaemonServer) resumeDrifts(ctx context.Context) {
+	logger := s.timeChaosServer.logger
+
+	states, err := loadStates[driftState](s.stateStore, driftStateKind)
+	if err != nil {
+		logger.Error(err, "error while loading drift states")
+		return
+	}
+
+	for _, state := range states {
+		logger.Info("resume drifting clock", "uid", state.UID, "podContainerName", state.PodContainerName)
+
+		pid, err := s.crClient.GetPidFromContainerID(ctx, state.ContainerID)
+		if err != nil {
+			// the container has gone, there is nothing to resume
+			logger.Error(err, "error while getting pid, drop the drift", "uid", state.UID, "containerID", state.ContainerID)
+			s.timeChaosServer.stopDrift(state.UID)
+			continue
+		}
+
+		s.timeChaosServer.SetPodContainerNameProcess(state.PodContainerName, tasks.SysPID(pid))
+		if err := s.timeChaosServer.SetTimeOffset(state.UID, state.PodContainerName, state.configAt(time.Now())); err != nil {
+			logger.Error(err, "error while applying time skew, drop the drift", "uid", state.UID)
+			s.timeChaosServer.stopDrift(state.UID)
+			continue
+		}
+		s.timeChaosServer.startDrift(state)
+	}
+}
+
+// driftOffset returns the offset accumulated in elapsed time with driftRate in parts per million
+func driftOffset(elapsed time.Duration, driftRate int64) time.Duration {
+	return time.Duration(float64(elapsed) * float64(driftRate) / 1e6)
+}
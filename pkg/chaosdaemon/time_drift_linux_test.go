@@ -0,0 +1,38 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_driftStateOffsetAt(t *testing.T) {
+	g := NewWithT(t)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := driftState{Sec: 10, Nsec: 500, DriftRate: 1000, StartTime: start}
+
+	// the drift is accumulated since the recorded start, including the time when chaos-daemon was not running
+	g.Expect(state.offsetAt(start.Add(100 * time.Second))).To(Equal(10*time.Second + 500 + 100*time.Millisecond))
+	g.Expect(state.offsetAt(start)).To(Equal(10*time.Second + 500))
+	g.Expect(state.offsetAt(start.Add(-time.Second))).To(Equal(10*time.Second + 500))
+
+	state.DriftRate = -1000
+	g.Expect(state.offsetAt(start.Add(100 * time.Second))).To(Equal(10*time.Second + 500 - 100*time.Millisecond))
+}
@@ -19,6 +19,7 @@ package chaosdaemon
 
 import (
 	"context"
+	"sync"
 
 	"github.com/go-logr/logr"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -33,6 +34,13 @@ type TimeChaosServer struct {
 
 	nameLocker tasks.LockMap[tasks.PodContainerName]
 	logger     logr.Logger
+
+	drifters     map[tasks.TaskID]context.CancelFunc
+	driftersLock sync.Mutex
+	stateStore   *stateStore
+}
+
+func (s *DaemonServer) resumeDrifts(ctx context.Context) {
 }
 
 func (s *TimeChaosServer) SetPodContainerNameProcess(idName tasks.PodContainerName, sysID tasks.SysPID) {
@@ -17,6 +17,8 @@ package chaosdaemon
 
 import (
 	"context"
+	"sync"
+	gotime "time"
 
 	"github.com/go-logr/logr"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -34,6 +36,10 @@ type TimeChaosServer struct {
 
 	nameLocker tasks.LockMap[tasks.PodContainerName]
 	logger     logr.Logger
+
+	drifters     map[tasks.TaskID]context.CancelFunc
+	driftersLock sync.Mutex
+	stateStore   *stateStore
 }
 
 func (s *TimeChaosServer) SetPodContainerNameProcess(idName tasks.PodContainerName, sysID tasks.SysPID) {
@@ -83,13 +89,37 @@ func (s *DaemonServer) SetTimeOffset(ctx context.Context, req *pb.TimeRequest) (
 		return nil, err
 	}
 
+	if req.DriftRate != 0 && s.timeChaosServer.drifting(req.Uid) {
+		// the drift has been started by a previous request, or resumed after restart
+		return &empty.Empty{}, nil
+	}
+
+	// the drift starts from the time recorded by the controller, so the offset of a drift which is applied again
+	// keeps the accumulated drift
+	state := driftState{
+		UID:              req.Uid,
+		PodContainerName: tasks.PodContainerName(req.PodContainerName),
+		ContainerID:      req.ContainerId,
+		Sec:              req.Sec,
+		Nsec:             req.Nsec,
+		ClkIdsMask:       req.ClkIdsMask,
+		DriftRate:        req.DriftRate,
+		StartTime:        gotime.Now(),
+	}
+	if req.DriftStart != 0 {
+		state.StartTime = gotime.Unix(0, req.DriftStart)
+	}
+
 	s.timeChaosServer.SetPodContainerNameProcess(tasks.PodContainerName(req.PodContainerName), tasks.SysPID(pid))
-	err = s.timeChaosServer.SetTimeOffset(req.Uid, tasks.PodContainerName(req.PodContainerName),
-		time.NewConfig(req.Sec, req.Nsec, req.ClkIdsMask))
+	err = s.timeChaosServer.SetTimeOffset(req.Uid, tasks.PodContainerName(req.PodContainerName), state.configAt(gotime.Now()))
 	if err != nil {
 		logger.Error(err, "error while applying chaos")
 		return nil, err
 	}
+
+	if req.DriftRate != 0 {
+		s.timeChaosServer.startDrift(state)
+	}
 	return &empty.Empty{}, nil
 }
 
@@ -106,6 +136,7 @@ func (s *DaemonServer) RecoverTimeOffset(ctx context.Context, req *pb.TimeReques
 
 	nameID := tasks.PodContainerName(req.PodContainerName)
 
+	s.timeChaosServer.stopDrift(req.Uid)
 	s.timeChaosServer.SetPodContainerNameProcess(nameID, tasks.SysPID(pid))
 
 	unlock := s.timeChaosServer.nameLocker.Lock(nameID)
@@ -40,6 +40,8 @@ type Interface interface {
 	Kernelchaos() KernelChaosInformer
 	// Networkchaos returns a NetworkChaosInformer.
 	Networkchaos() NetworkChaosInformer
+	// Nodechaos returns a NodeChaosInformer.
+	Nodechaos() NodeChaosInformer
 	// Podchaos returns a PodChaosInformer.
 	Podchaos() PodChaosInformer
 	// Podhttpchaos returns a PodHttpChaosInformer.
@@ -110,6 +112,11 @@ func (v *version) Networkchaos() NetworkChaosInformer {
 	return &networkChaosInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// Nodechaos returns a NodeChaosInformer.
+func (v *version) Nodechaos() NodeChaosInformer {
+	return &nodeChaosInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Podchaos returns a PodChaosInformer.
 func (v *version) Podchaos() PodChaosInformer {
 	return &podChaosInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
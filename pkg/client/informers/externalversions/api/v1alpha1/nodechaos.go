@@ -0,0 +1,100 @@
+// Copyright Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+	time "time"
+
+	chaosmeshapiv1alpha1 "github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	internalinterfaces "github.com/chaos-mesh/chaos-mesh/pkg/client/informers/externalversions/internalinterfaces"
+	apiv1alpha1 "github.com/chaos-mesh/chaos-mesh/pkg/client/listers/api/v1alpha1"
+	versioned "github.com/chaos-mesh/chaos-mesh/pkg/client/versioned"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NodeChaosInformer provides access to a shared informer and lister for
+// Nodechaos.
+type NodeChaosInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() apiv1alpha1.NodeChaosLister
+}
+
+type nodeChaosInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewNodeChaosInformer constructs a new informer for NodeChaos type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewNodeChaosInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredNodeChaosInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredNodeChaosInformer constructs a new informer for NodeChaos type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredNodeChaosInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApiV1alpha1().Nodechaos(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApiV1alpha1().Nodechaos(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApiV1alpha1().Nodechaos(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApiV1alpha1().Nodechaos(namespace).Watch(ctx, options)
+			},
+		}, client),
+		&chaosmeshapiv1alpha1.NodeChaos{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *nodeChaosInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredNodeChaosInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *nodeChaosInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&chaosmeshapiv1alpha1.NodeChaos{}, f.defaultInformer)
+}
+
+func (f *nodeChaosInformer) Lister() apiv1alpha1.NodeChaosLister {
+	return apiv1alpha1.NewNodeChaosLister(f.Informer().GetIndexer())
+}
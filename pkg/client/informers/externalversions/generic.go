@@ -69,6 +69,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Api().V1alpha1().Kernelchaos().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("networkchaos"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Api().V1alpha1().Networkchaos().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("nodechaos"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Api().V1alpha1().Nodechaos().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("podchaos"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Api().V1alpha1().Podchaos().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("podhttpchaos"):
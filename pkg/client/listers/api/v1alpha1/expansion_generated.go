@@ -88,6 +88,14 @@ type NetworkChaosListerExpansion interface{}
 // NetworkChaosNamespaceLister.
 type NetworkChaosNamespaceListerExpansion interface{}
 
+// NodeChaosListerExpansion allows custom methods to be added to
+// NodeChaosLister.
+type NodeChaosListerExpansion interface{}
+
+// NodeChaosNamespaceListerExpansion allows custom methods to be added to
+// NodeChaosNamespaceLister.
+type NodeChaosNamespaceListerExpansion interface{}
+
 // PodChaosListerExpansion allows custom methods to be added to
 // PodChaosLister.
 type PodChaosListerExpansion interface{}
@@ -0,0 +1,68 @@
+// Copyright Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NodeChaosLister helps list Nodechaos.
+// All objects returned here must be treated as read-only.
+type NodeChaosLister interface {
+	// List lists all Nodechaos in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha1.NodeChaos, err error)
+	// Nodechaos returns an object that can list and get Nodechaos.
+	Nodechaos(namespace string) NodeChaosNamespaceLister
+	NodeChaosListerExpansion
+}
+
+// nodeChaosLister implements the NodeChaosLister interface.
+type nodeChaosLister struct {
+	listers.ResourceIndexer[*apiv1alpha1.NodeChaos]
+}
+
+// NewNodeChaosLister returns a new NodeChaosLister.
+func NewNodeChaosLister(indexer cache.Indexer) NodeChaosLister {
+	return &nodeChaosLister{listers.New[*apiv1alpha1.NodeChaos](indexer, apiv1alpha1.Resource("nodechaos"))}
+}
+
+// Nodechaos returns an object that can list and get Nodechaos.
+func (s *nodeChaosLister) Nodechaos(namespace string) NodeChaosNamespaceLister {
+	return nodeChaosNamespaceLister{listers.NewNamespaced[*apiv1alpha1.NodeChaos](s.ResourceIndexer, namespace)}
+}
+
+// NodeChaosNamespaceLister helps list and get Nodechaos.
+// All objects returned here must be treated as read-only.
+type NodeChaosNamespaceLister interface {
+	// List lists all Nodechaos in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha1.NodeChaos, err error)
+	// Get retrieves the NodeChaos from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha1.NodeChaos, error)
+	NodeChaosNamespaceListerExpansion
+}
+
+// nodeChaosNamespaceLister implements the NodeChaosNamespaceLister
+// interface.
+type nodeChaosNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1alpha1.NodeChaos]
+}
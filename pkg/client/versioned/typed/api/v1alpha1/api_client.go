@@ -35,6 +35,7 @@ type ApiV1alpha1Interface interface {
 	JvmchaosGetter
 	KernelchaosGetter
 	NetworkchaosGetter
+	NodechaosGetter
 	PodchaosGetter
 	PodhttpchaosGetter
 	PodiochaosGetter
@@ -84,6 +85,10 @@ func (c *ApiV1alpha1Client) Networkchaos(namespace string) NetworkChaosInterface
 	return newNetworkchaos(c, namespace)
 }
 
+func (c *ApiV1alpha1Client) Nodechaos(namespace string) NodeChaosInterface {
+	return newNodechaos(c, namespace)
+}
+
 func (c *ApiV1alpha1Client) Podchaos(namespace string) PodChaosInterface {
 	return newPodchaos(c, namespace)
 }
@@ -62,6 +62,10 @@ func (c *FakeApiV1alpha1) Networkchaos(namespace string) v1alpha1.NetworkChaosIn
 	return newFakeNetworkchaos(c, namespace)
 }
 
+func (c *FakeApiV1alpha1) Nodechaos(namespace string) v1alpha1.NodeChaosInterface {
+	return newFakeNodechaos(c, namespace)
+}
+
 func (c *FakeApiV1alpha1) Podchaos(namespace string) v1alpha1.PodChaosInterface {
 	return newFakePodchaos(c, namespace)
 }
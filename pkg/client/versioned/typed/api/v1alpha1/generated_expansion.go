@@ -34,6 +34,8 @@ type KernelChaosExpansion interface{}
 
 type NetworkChaosExpansion interface{}
 
+type NodeChaosExpansion interface{}
+
 type PodChaosExpansion interface{}
 
 type PodHttpChaosExpansion interface{}
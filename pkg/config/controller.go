@@ -88,7 +88,8 @@ type ChaosControllerConfig struct {
 	// It only works with ClusterScoped is false;
 	TargetNamespace string `envconfig:"TARGET_NAMESPACE" default:""`
 
-	// DNSServiceName is the name of DNS service, which is used for DNS chaos
+	// DNSServiceName is the name of DNS service, which is used for DNS chaos.
+	// If it's empty, all the DNS chaos is injected by chaos-daemon.
 	DNSServiceName string `envconfig:"CHAOS_DNS_SERVICE_NAME" default:""`
 	DNSServicePort int    `envconfig:"CHAOS_DNS_SERVICE_PORT" default:""`
 
@@ -113,6 +114,13 @@ type ChaosControllerConfig struct {
 	LocalHelmChartPath string `envconfig:"LOCAL_HELM_CHART_PATH" default:""`
 
 	MaxEvents int `envconfig:"MAX_EVENTS" default:"100"`
+
+	// WorkflowHTTPRequestAllowedHosts and WorkflowHTTPRequestDeniedHosts restrict the hosts which the HTTPRequest
+	// nodes of Workflow could send requests to, each item is a host name, a wildcard like *.example.com, an address,
+	// or a CIDR. The loopback and link-local addresses, including the metadata services of the cloud providers, are
+	// denied by default. The CIDRs of pods and services should be added to deny the requests to the cluster.
+	WorkflowHTTPRequestAllowedHosts []string `envconfig:"WORKFLOW_HTTP_REQUEST_ALLOWED_HOSTS"`
+	WorkflowHTTPRequestDeniedHosts  []string `envconfig:"WORKFLOW_HTTP_REQUEST_DENIED_HOSTS" default:"127.0.0.0/8,::1,169.254.0.0/16,fe80::/10,fd00:ec2::254"`
 }
 
 // EnvironChaosController returns the settings from the environment.
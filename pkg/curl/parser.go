@@ -16,6 +16,7 @@
 package curl
 
 import (
+	"net/http"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -76,6 +77,12 @@ func ParseWorkflowTaskTemplate(template *v1alpha1.Template) (*RequestForm, error
 	if !IsValidRenderedTask(template) {
 		return nil, errors.New("invalid request, this task is not rendered by curl-render")
 	}
+	if template.Type == v1alpha1.TypeHTTPRequest {
+		return &RequestForm{
+			CommandFlags: parseHTTPRequest(template.HTTPRequest),
+			Name:         template.Name,
+		}, nil
+	}
 	parsedFlags, err := parseCommands(template.Task.Container.Command)
 	if err != nil {
 		return nil, err
@@ -87,7 +94,46 @@ func ParseWorkflowTaskTemplate(template *v1alpha1.Template) (*RequestForm, error
 }
 
 func IsValidRenderedTask(template *v1alpha1.Template) bool {
-	return template.Type == v1alpha1.TypeTask && strings.HasSuffix(template.Task.Container.Name, nameSuffix)
+	if template.Type == v1alpha1.TypeHTTPRequest {
+		return template.HTTPRequest != nil
+	}
+	return template.Type == v1alpha1.TypeTask &&
+		template.Task != nil &&
+		template.Task.Container != nil &&
+		strings.HasSuffix(template.Task.Container.Name, nameSuffix)
+}
+
+// parseHTTPRequest converts the HTTPRequest template back to the flags, the Content-Type header of JSON is
+// represented by JsonContent as parseCommands does.
+func parseHTTPRequest(request *v1alpha1.HTTPRequestNodeSpec) CommandFlags {
+	isJson := false
+	var header Header
+	for k, values := range request.Headers {
+		for _, v := range values {
+			if http.CanonicalHeaderKey(k) == HeaderContentType && v == ApplicationJson {
+				isJson = true
+				continue
+			}
+			if header == nil {
+				header = Header{}
+			}
+			header[k] = append(header[k], v)
+		}
+	}
+
+	method := request.Method
+	if len(method) == 0 {
+		method = http.MethodGet
+	}
+
+	return CommandFlags{
+		Method:         method,
+		URL:            request.URL,
+		Header:         header,
+		Body:           request.Body,
+		FollowLocation: request.FollowLocation,
+		JsonContent:    isJson,
+	}
 }
 
 func parseHeader(headerKV string) (key, value string) {
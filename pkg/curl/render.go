@@ -86,3 +86,34 @@ func RenderWorkflowTaskTemplate(request RequestForm) (*v1alpha1.Template, error)
 		ConditionalBranches: nil,
 	}, nil
 }
+
+// RenderWorkflowHTTPRequestTemplate renders the request into a HTTPRequest template, which would be sent by the
+// workflow controller directly instead of a curl container.
+func RenderWorkflowHTTPRequestTemplate(request RequestForm) (*v1alpha1.Template, error) {
+	headers := http.Header{}
+	for k, v := range request.Header {
+		headers[k] = v
+	}
+	if request.JsonContent {
+		headers[HeaderContentType] = []string{ApplicationJson}
+	}
+	if len(headers) == 0 {
+		headers = nil
+	}
+	method := request.Method
+	if len(method) == 0 {
+		method = http.MethodGet
+	}
+	return &v1alpha1.Template{
+		Name: request.Name,
+		Type: v1alpha1.TypeHTTPRequest,
+		HTTPRequest: &v1alpha1.HTTPRequestNodeSpec{
+			URL:            request.URL,
+			Method:         method,
+			Headers:        headers,
+			Body:           request.Body,
+			FollowLocation: request.FollowLocation,
+		},
+		ConditionalBranches: nil,
+	}, nil
+}
@@ -94,5 +94,14 @@ func TestRoundTrip(t *testing.T) {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(parsedFlags).To(Equal(&test.flags), "rendered commands %+v", commands)
 		})
+		t.Run(test.name+" with http request template", func(t *testing.T) {
+			form := RequestForm{CommandFlags: test.flags, Name: "request"}
+			template, err := RenderWorkflowHTTPRequestTemplate(form)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(IsValidRenderedTask(template)).To(BeTrue())
+			parsedForm, err := ParseWorkflowTaskTemplate(template)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(parsedForm).To(Equal(&form), "rendered template %+v", template.HTTPRequest)
+		})
 	}
 }
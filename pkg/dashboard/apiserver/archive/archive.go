@@ -26,9 +26,12 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/clientpool"
 	config "github.com/chaos-mesh/chaos-mesh/pkg/config"
 	"github.com/chaos-mesh/chaos-mesh/pkg/dashboard/apiserver/types"
 	u "github.com/chaos-mesh/chaos-mesh/pkg/dashboard/apiserver/utils"
@@ -80,6 +83,8 @@ func Register(r *gin.RouterGroup, s *Service) {
 	endpoint.GET("/workflows", s.listWorkflow)
 	endpoint.GET("/workflows/:uid", s.detailWorkflow)
 	endpoint.DELETE("/workflows/:uid", s.deleteWorkflow)
+	endpoint.GET("/workflows/:uid/run", s.workflowRun)
+	endpoint.POST("/workflows/:uid/rerun", s.rerunWorkflow)
 	endpoint.DELETE("/workflows", s.batchDeleteWorkflow)
 }
 
@@ -527,6 +532,108 @@ func (s *Service) detailWorkflow(c *gin.Context) {
 	c.JSON(http.StatusOK, detail)
 }
 
+// @Summary Get the run of an archived workflow.
+// @Description Get the timing, outcome, status check results and injected targets of each node in the archived workflow.
+// @Tags archives
+// @Produce json
+// @Param uid path string true "the workflow uid"
+// @Success 200 {object} core.WorkflowRun
+// @Failure 404 {object} u.APIError
+// @Failure 500 {object} u.APIError
+// @Router /archives/workflows/{uid}/run [get]
+func (s *Service) workflowRun(c *gin.Context) {
+	entity, err := s.workflowStore.FindByUID(c.Request.Context(), c.Param("uid"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			u.SetAPIError(c, u.ErrNotFound.New("the archived workflow is not found"))
+		} else {
+			u.SetAPIError(c, u.ErrInternalServer.WrapWithNoMessage(err))
+		}
+		return
+	}
+
+	run, err := core.WorkflowEntity2WorkflowRun(entity)
+	if err != nil {
+		u.SetAPIError(c, u.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, run)
+}
+
+// @Summary Rerun an archived workflow.
+// @Description Create a new workflow with the spec of the archived one, the arguments could be overridden if it's instantiated from a WorkflowTemplate which is not changed since then.
+// @Tags archives
+// @Produce json
+// @Param uid path string true "the workflow uid"
+// @Param request body types.WorkflowRerun false "the options of the rerun"
+// @Success 200 {object} core.WorkflowDetail
+// @Failure 400 {object} u.APIError
+// @Failure 404 {object} u.APIError
+// @Failure 500 {object} u.APIError
+// @Router /archives/workflows/{uid}/rerun [post]
+func (s *Service) rerunWorkflow(c *gin.Context) {
+	options := types.WorkflowRerun{}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&options); err != nil {
+			u.SetAPIError(c, u.ErrBadRequest.Wrap(err, "failed to parse request body"))
+			return
+		}
+	}
+
+	entity, err := s.workflowStore.FindByUID(c.Request.Context(), c.Param("uid"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			u.SetAPIError(c, u.ErrNotFound.New("the archived workflow is not found"))
+		} else {
+			u.SetAPIError(c, u.ErrInternalServer.WrapWithNoMessage(err))
+		}
+		return
+	}
+
+	archived, err := core.WorkflowEntity2WorkflowCR(entity)
+	if err != nil {
+		u.SetAPIError(c, u.ErrInternalServer.WrapWithNoMessage(err))
+		return
+	}
+
+	kubeClient, err := clientpool.ExtractTokenAndGetClient(c.Request.Header)
+	if err != nil {
+		u.SetAPImachineryError(c, err)
+		return
+	}
+
+	// the WorkflowTemplate is instantiated again to override the arguments
+	var template *v1alpha1.WorkflowTemplate
+	if len(options.Arguments) > 0 && archived.Spec.WorkflowTemplateRef != nil {
+		template = &v1alpha1.WorkflowTemplate{}
+		err := kubeClient.Get(c.Request.Context(), client.ObjectKey{
+			Namespace: archived.Namespace,
+			Name:      archived.Spec.WorkflowTemplateRef.Name,
+		}, template)
+		if apierrors.IsNotFound(err) {
+			template = nil
+		} else if err != nil {
+			u.SetAPImachineryError(c, err)
+			return
+		}
+	}
+
+	workflow, err := core.RerunWorkflow(*archived, options.Name, options.Arguments, template)
+	if err != nil {
+		u.SetAPIError(c, u.ErrBadRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	result, err := core.NewKubeWorkflowRepository(kubeClient).Create(c.Request.Context(), workflow)
+	if err != nil {
+		u.SetAPImachineryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // @Summary Delete the specified archived workflow.
 // @Description Delete the specified archived workflow.
 // @Tags archives
@@ -34,6 +34,15 @@ type ArchiveDetail struct {
 	KubeObject core.KubeObjectDesc `json:"kube_object"`
 }
 
+// WorkflowRerun defines the options to rerun an archived workflow.
+type WorkflowRerun struct {
+	// Name is the name of the new workflow, it's generated from the archived one if it's empty.
+	Name string `json:"name,omitempty"`
+	// Arguments override the arguments of the workflow instantiated from a WorkflowTemplate, the WorkflowTemplate is
+	// instantiated again, so it should not be changed since the archived workflow was created.
+	Arguments []v1alpha1.WorkflowArgument `json:"arguments,omitempty"`
+}
+
 // Experiment defines the basic information of an experiment.
 type Experiment struct {
 	core.ObjectBase
@@ -17,12 +17,14 @@ package workflow
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"sort"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-logr/logr"
+	"gorm.io/gorm"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
@@ -40,9 +42,15 @@ func Register(r *gin.RouterGroup, s *Service) {
 	endpoint.GET("/:uid", s.getWorkflowDetailByUID)
 	endpoint.PUT("/:uid", s.updateWorkflow)
 	endpoint.DELETE("/:uid", s.deleteWorkflow)
+	endpoint.PUT("/pause/:uid", s.pauseWorkflow)
+	endpoint.PUT("/start/:uid", s.startWorkflow)
 	endpoint.POST("/render-task/http", s.renderHTTPTask)
 	endpoint.POST("/parse-task/http", s.parseHTTPTask)
 	endpoint.POST("/validate-task/http", s.isValidRenderedHTTPTask)
+	endpoint.GET("/compare", s.compareWorkflowRuns)
+	endpoint.GET("/approvals", s.listPendingApprovals)
+	endpoint.POST("/approvals/:namespace/:name/approve", s.approve)
+	endpoint.POST("/approvals/:namespace/:name/reject", s.reject)
 }
 
 // Service defines a handler service for workflows.
@@ -61,6 +69,7 @@ func NewService(conf *config.ChaosDashboardConfig, store core.WorkflowStore, log
 // @Tags workflows
 // @Produce json
 // @Param request body curl.RequestForm true "Origin HTTP Request"
+// @Param type query string false "the type of rendered template, given empty string means Task" Enums(Task, HTTPRequest)
 // @Success 200 {object} v1alpha1.Template
 // @Failure 400 {object} utils.APIError
 // @Failure 500 {object} utils.APIError
@@ -71,7 +80,18 @@ func (it *Service) renderHTTPTask(c *gin.Context) {
 		utils.SetAPIError(c, utils.ErrBadRequest.Wrap(err, "failed to parse request body"))
 		return
 	}
-	result, err := curl.RenderWorkflowTaskTemplate(requestBody)
+
+	render := curl.RenderWorkflowTaskTemplate
+	switch templateType := v1alpha1.TemplateType(c.Query("type")); templateType {
+	case "", v1alpha1.TypeTask:
+	case v1alpha1.TypeHTTPRequest:
+		render = curl.RenderWorkflowHTTPRequestTemplate
+	default:
+		utils.SetAPIError(c, utils.ErrBadRequest.New("unsupported template type %s", templateType))
+		return
+	}
+
+	result, err := render(requestBody)
 	if err != nil {
 		utils.SetAPIError(c, utils.ErrInternalServer.Wrap(err, "failed to parse request body"))
 		return
@@ -312,6 +332,63 @@ func (it *Service) deleteWorkflow(c *gin.Context) {
 	c.JSON(http.StatusOK, utils.ResponseSuccess)
 }
 
+// @Summary Pause the specified workflow.
+// @Description Pause the specified workflow. The running nodes are paused and no new nodes will be spawned until it's started again.
+// @Tags workflows
+// @Produce json
+// @Param uid path string true "uid"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /workflows/pause/{uid} [put]
+func (it *Service) pauseWorkflow(c *gin.Context) {
+	it.setWorkflowPaused(c, true)
+}
+
+// @Summary Start the paused workflow.
+// @Description Start the paused workflow, it continues from the nodes where it was paused.
+// @Tags workflows
+// @Produce json
+// @Param uid path string true "uid"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /workflows/start/{uid} [put]
+func (it *Service) startWorkflow(c *gin.Context) {
+	it.setWorkflowPaused(c, false)
+}
+
+func (it *Service) setWorkflowPaused(c *gin.Context, paused bool) {
+	uid := c.Param("uid")
+
+	entity, err := it.store.FindByUID(c.Request.Context(), uid)
+	if err != nil {
+		utils.SetAPImachineryError(c, err)
+		return
+	}
+
+	kubeClient, err := clientpool.ExtractTokenAndGetClient(c.Request.Header)
+	if err != nil {
+		_ = c.Error(utils.ErrBadRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	repo := core.NewKubeWorkflowRepository(kubeClient)
+
+	if paused {
+		err = repo.Pause(c.Request.Context(), entity.Namespace, entity.Name)
+	} else {
+		err = repo.Resume(c.Request.Context(), entity.Namespace, entity.Name)
+	}
+	if err != nil {
+		utils.SetAPImachineryError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, utils.ResponseSuccess)
+}
+
 // @Summary Update a workflow.
 // @Description Update a workflow.
 // @Tags workflows
@@ -371,3 +448,143 @@ func (it *Service) updateWorkflow(c *gin.Context) {
 
 	c.JSON(http.StatusOK, result)
 }
+
+// @Summary Compare two runs of workflows.
+// @Description Line up the nodes of two workflows by their templates, compare the timing, outcomes, status check results and injected targets.
+// @Tags workflows
+// @Produce json
+// @Param base query string true "the uid of the base workflow"
+// @Param target query string true "the uid of the workflow compared with the base one"
+// @Success 200 {object} core.WorkflowRunComparison
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /workflows/compare [get]
+func (it *Service) compareWorkflowRuns(c *gin.Context) {
+	baseUID, targetUID := c.Query("base"), c.Query("target")
+	if baseUID == "" || targetUID == "" {
+		utils.SetAPIError(c, utils.ErrBadRequest.New("base and target cannot be empty"))
+		return
+	}
+
+	var runs []core.WorkflowRun
+	for _, uid := range []string{baseUID, targetUID} {
+		entity, err := it.store.FindByUID(c.Request.Context(), uid)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				utils.SetAPIError(c, utils.ErrNotFound.New("workflow %s is not found", uid))
+			} else {
+				utils.SetAPIError(c, utils.ErrInternalServer.WrapWithNoMessage(err))
+			}
+			return
+		}
+
+		run, err := core.WorkflowEntity2WorkflowRun(entity)
+		if err != nil {
+			utils.SetAPIError(c, utils.ErrInternalServer.WrapWithNoMessage(err))
+			return
+		}
+		runs = append(runs, *run)
+	}
+
+	c.JSON(http.StatusOK, core.CompareWorkflowRuns(runs[0], runs[1]))
+}
+
+// @Summary List the pending approvals of workflows.
+// @Description List the approval nodes of workflows which are waiting for the decision.
+// @Tags workflows
+// @Produce json
+// @Param namespace query string false "namespace, given empty string means list from all namespace"
+// @Success 200 {array} core.PendingApproval
+// @Failure 400 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /workflows/approvals [get]
+func (it *Service) listPendingApprovals(c *gin.Context) {
+	namespace := c.Query("namespace")
+	if len(namespace) == 0 && !it.conf.ClusterScoped &&
+		len(it.conf.TargetNamespace) != 0 {
+		namespace = it.conf.TargetNamespace
+	}
+
+	kubeClient, err := clientpool.ExtractTokenAndGetClient(c.Request.Header)
+	if err != nil {
+		_ = c.Error(utils.ErrBadRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	repo := core.NewKubeWorkflowRepository(kubeClient)
+
+	result, err := repo.ListPendingApprovals(c.Request.Context(), namespace)
+	if err != nil {
+		utils.SetAPImachineryError(c, err)
+		return
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary Approve the pending approval node.
+// @Description Approve the pending approval node, the user of the token is recorded as the approver. It requires the security mode.
+// @Tags workflows
+// @Produce json
+// @Param namespace path string true "namespace"
+// @Param name path string true "the name of the approval node"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /workflows/approvals/{namespace}/{name}/approve [post]
+func (it *Service) approve(c *gin.Context) {
+	it.decideApproval(c, v1alpha1.ApprovalActionApprove)
+}
+
+// @Summary Reject the pending approval node.
+// @Description Reject the pending approval node, the user of the token is recorded as the approver. It requires the security mode.
+// @Tags workflows
+// @Produce json
+// @Param namespace path string true "namespace"
+// @Param name path string true "the name of the approval node"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.APIError
+// @Failure 404 {object} utils.APIError
+// @Failure 500 {object} utils.APIError
+// @Router /workflows/approvals/{namespace}/{name}/reject [post]
+func (it *Service) reject(c *gin.Context) {
+	it.decideApproval(c, v1alpha1.ApprovalActionReject)
+}
+
+func (it *Service) decideApproval(c *gin.Context, action v1alpha1.ApprovalAction) {
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+
+	// without security mode, the kube client is the service account of chaos-dashboard rather than the user,
+	// which would be recorded as the approver
+	if !it.conf.SecurityMode {
+		utils.SetAPIError(c, utils.ErrBadRequest.New("approval requires the security mode, so that the approver is the user of the token"))
+		return
+	}
+
+	kubeClient, err := clientpool.ExtractTokenAndGetClient(c.Request.Header)
+	if err != nil {
+		_ = c.Error(utils.ErrBadRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	repo := core.NewKubeWorkflowRepository(kubeClient)
+
+	err = repo.DecideApproval(c.Request.Context(), namespace, name, action)
+	if err != nil {
+		if errors.Is(err, core.ErrApprovalNotPending) {
+			utils.SetAPIError(c, utils.ErrBadRequest.WrapWithNoMessage(err))
+			return
+		}
+		utils.SetAPImachineryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, utils.ResponseSuccess)
+}
@@ -190,6 +190,8 @@ func convertInnerObjectToExperiment(obj v1alpha1.InnerObject) (*core.Experiment,
 		archive.Action = string(chaos.Spec.Action)
 	case *v1alpha1.BlockChaos:
 		archive.Action = string(chaos.Spec.Action)
+	case *v1alpha1.NodeChaos:
+		archive.Action = string(chaos.Spec.Action)
 	default:
 		return nil, errors.New("unsupported chaos type " + archive.Kind)
 	}
@@ -109,6 +109,8 @@ func (r *ScheduleCollector) createOrUpdateSchedule(req ctrl.Request, schedule v1
 		sch.Action = string(schedule.Spec.ScheduleItem.AzureChaos.Action)
 	case v1alpha1.ScheduleTypeBlockChaos:
 		sch.Action = string(schedule.Spec.ScheduleItem.BlockChaos.Action)
+	case v1alpha1.ScheduleTypeNodeChaos:
+		sch.Action = string(schedule.Spec.ScheduleItem.NodeChaos.Action)
 	case v1alpha1.ScheduleTypeTimeChaos, v1alpha1.ScheduleTypeKernelChaos, v1alpha1.ScheduleTypeStressChaos, v1alpha1.ScheduleTypeHTTPChaos, v1alpha1.ScheduleTypeWorkflow:
 		sch.Action = ""
 	default:
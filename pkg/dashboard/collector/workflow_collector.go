@@ -18,13 +18,16 @@ package collector
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
 	"gorm.io/gorm"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
@@ -41,9 +44,28 @@ type WorkflowCollector struct {
 func (it *WorkflowCollector) Setup(mgr ctrl.Manager, apiType client.Object) error {
 	it.apiType = apiType
 
-	return ctrl.NewControllerManagedBy(mgr).
+	// the nodes, status checks and chaos spawned by the workflow are watched to record the history of the run
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(apiType).
-		Complete(it)
+		Watches(&v1alpha1.WorkflowNode{}, handler.EnqueueRequestsFromMapFunc(workflowOfObject)).
+		Watches(&v1alpha1.StatusCheck{}, handler.EnqueueRequestsFromMapFunc(workflowOfObject))
+	for _, kind := range v1alpha1.AllKinds() {
+		builder = builder.Watches(kind.SpawnObject(), handler.EnqueueRequestsFromMapFunc(workflowOfObject))
+	}
+
+	return builder.Complete(it)
+}
+
+// workflowOfObject maps the object spawned by a workflow to the workflow.
+func workflowOfObject(_ context.Context, obj client.Object) []reconcile.Request {
+	workflowName, ok := obj.GetLabels()[v1alpha1.LabelWorkflow]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      workflowName,
+	}}}
 }
 
 func (it *WorkflowCollector) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -65,7 +87,7 @@ func (it *WorkflowCollector) Reconcile(ctx context.Context, request reconcile.Re
 		return ctrl.Result{}, nil
 	}
 
-	if err := it.persistentWorkflow(&workflow); err != nil {
+	if err := it.persistentWorkflow(ctx, &workflow); err != nil {
 		it.Log.Error(err, "failed to archive workflow")
 	}
 
@@ -76,7 +98,7 @@ func (it *WorkflowCollector) markAsArchived(ctx context.Context, namespace, name
 	return it.store.MarkAsArchived(ctx, namespace, name)
 }
 
-func (it *WorkflowCollector) persistentWorkflow(workflow *v1alpha1.Workflow) error {
+func (it *WorkflowCollector) persistentWorkflow(ctx context.Context, workflow *v1alpha1.Workflow) error {
 	newEntity, err := core.WorkflowCR2WorkflowEntity(workflow)
 	if err != nil {
 		return err
@@ -88,8 +110,23 @@ func (it *WorkflowCollector) persistentWorkflow(workflow *v1alpha1.Workflow) err
 		return err
 	}
 
+	nodes, err := core.SnapshotWorkflowNodes(ctx, it.kubeClient, *workflow, time.Now())
+	if err != nil {
+		it.Log.Error(err, "failed to snapshot workflow nodes", "UID", workflow.UID)
+		return err
+	}
+
 	if existedEntity != nil {
 		newEntity.ID = existedEntity.ID
+
+		previous, err := existedEntity.NodeRecords()
+		if err != nil {
+			it.Log.Error(err, "failed to decode archived workflow nodes", "UID", workflow.UID)
+		}
+		nodes = core.MergeNodeRecords(previous, nodes)
+	}
+	if err := newEntity.SetNodeRecords(nodes); err != nil {
+		return err
 	}
 
 	err = it.store.Save(context.Background(), newEntity)
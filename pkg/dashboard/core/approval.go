@@ -0,0 +1,121 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	wfcontrollers "github.com/chaos-mesh/chaos-mesh/pkg/workflow/controllers"
+)
+
+// ErrApprovalNotPending is returned when making decision on a node which is not a pending approval node.
+var ErrApprovalNotPending = errors.New("the workflow node is not a pending approval node")
+
+// PendingApproval is an approval node of workflow which is waiting for the decision.
+type PendingApproval struct {
+	Namespace string    `json:"namespace"`
+	Workflow  string    `json:"workflow"`
+	Node      string    `json:"node"`
+	Template  string    `json:"template"`
+	Message   string    `json:"message,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// TimeoutAt is the time when the default action is taken, it's empty if the approval node has no timeout.
+	TimeoutAt     *time.Time              `json:"timeout_at,omitempty"`
+	DefaultAction v1alpha1.ApprovalAction `json:"default_action,omitempty"`
+}
+
+// ListPendingApprovals lists the approval nodes in the namespace which are waiting for the decision,
+// given empty namespace means list from all namespaces.
+func (it *KubeWorkflowRepository) ListPendingApprovals(ctx context.Context, namespace string) ([]PendingApproval, error) {
+	workflowNodes := v1alpha1.WorkflowNodeList{}
+	err := it.kubeclient.List(ctx, &workflowNodes, &client.ListOptions{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]PendingApproval, 0)
+	for _, item := range workflowNodes.Items {
+		if !approvalPending(item) {
+			continue
+		}
+		result = append(result, convertPendingApproval(item))
+	}
+	return result, nil
+}
+
+// DecideApproval makes the decision on the pending approval node. The approver is recorded by the webhook
+// with the user of the kube client.
+func (it *KubeWorkflowRepository) DecideApproval(ctx context.Context, namespace, name string, action v1alpha1.ApprovalAction) error {
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeclient.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}, &node)
+	if err != nil {
+		return err
+	}
+
+	if !approvalPending(node) {
+		return errors.Wrapf(ErrApprovalNotPending, "workflow node %s/%s", namespace, name)
+	}
+
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	node.Annotations[v1alpha1.WorkflowAnnotationApproval] = string(action)
+	return it.kubeclient.Update(ctx, &node)
+}
+
+func approvalPending(node v1alpha1.WorkflowNode) bool {
+	if node.Spec.Type != v1alpha1.TypeApproval || wfcontrollers.WorkflowNodeFinished(node.Status) {
+		return false
+	}
+	_, decided := node.Annotations[v1alpha1.WorkflowAnnotationApproval]
+	return !decided
+}
+
+func convertPendingApproval(node v1alpha1.WorkflowNode) PendingApproval {
+	result := PendingApproval{
+		Namespace: node.Namespace,
+		Workflow:  node.Spec.WorkflowName,
+		Node:      node.Name,
+		Template:  node.Spec.TemplateName,
+		CreatedAt: node.CreationTimestamp.Time,
+	}
+	if node.Spec.StartTime != nil {
+		result.CreatedAt = node.Spec.StartTime.Time
+	}
+
+	if approval := node.Spec.Approval; approval != nil {
+		result.Message = approval.Message
+		result.DefaultAction = approval.TimeoutAction()
+		if approval.Timeout != nil {
+			if timeout, err := time.ParseDuration(*approval.Timeout); err == nil {
+				timeoutAt := result.CreatedAt.Add(timeout + wfcontrollers.WorkflowNodePausedDuration(node))
+				result.TimeoutAt = &timeoutAt
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestKubeWorkflowRepositoryApprovals(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	startTime := metav1.NewTime(time.Date(2026, 10, 1, 10, 0, 0, 0, time.Local))
+	timeout := "30m"
+	newNode := func(name string, templateType v1alpha1.TemplateType, annotations map[string]string, conditions ...v1alpha1.WorkflowNodeCondition) *v1alpha1.WorkflowNode {
+		return &v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Annotations: annotations},
+			Spec: v1alpha1.WorkflowNodeSpec{
+				TemplateName: "gate",
+				WorkflowName: "game-day",
+				Type:         templateType,
+				StartTime:    &startTime,
+				Approval:     &v1alpha1.ApprovalSpec{Message: "inject the next fault?", Timeout: &timeout},
+			},
+			Status: v1alpha1.WorkflowNodeStatus{Conditions: conditions},
+		}
+	}
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newNode("gate-pending", v1alpha1.TypeApproval, nil),
+		newNode("gate-decided", v1alpha1.TypeApproval, map[string]string{v1alpha1.WorkflowAnnotationApproval: "Approve"}),
+		newNode("gate-aborted", v1alpha1.TypeApproval, nil, v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionAborted, Status: corev1.ConditionTrue}),
+		newNode("suspend", v1alpha1.TypeSuspend, nil),
+	).Build()
+	repo := NewKubeWorkflowRepository(kubeClient)
+
+	approvals, err := repo.ListPendingApprovals(context.Background(), "default")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	timeoutAt := startTime.Add(30 * time.Minute)
+	g.Expect(approvals).Should(Equal([]PendingApproval{{
+		Namespace:     "default",
+		Workflow:      "game-day",
+		Node:          "gate-pending",
+		Template:      "gate",
+		Message:       "inject the next fault?",
+		CreatedAt:     startTime.Time,
+		TimeoutAt:     &timeoutAt,
+		DefaultAction: v1alpha1.ApprovalActionReject,
+	}}))
+
+	g.Expect(repo.DecideApproval(context.Background(), "default", "gate-pending", v1alpha1.ApprovalActionReject)).To(Succeed())
+	node := v1alpha1.WorkflowNode{}
+	g.Expect(kubeClient.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "gate-pending"}, &node)).To(Succeed())
+	g.Expect(node.Annotations[v1alpha1.WorkflowAnnotationApproval]).Should(Equal("Reject"))
+
+	for _, name := range []string{"gate-pending", "gate-decided", "gate-aborted", "suspend"} {
+		err := repo.DecideApproval(context.Background(), "default", name, v1alpha1.ApprovalActionApprove)
+		g.Expect(errors.Is(err, ErrApprovalNotPending)).Should(BeTrue(), name)
+	}
+}
@@ -18,6 +18,7 @@ package core
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,6 +39,8 @@ type WorkflowRepository interface {
 	Get(ctx context.Context, namespace, name string) (WorkflowDetail, error)
 	Delete(ctx context.Context, namespace, name string) error
 	Update(ctx context.Context, namespace, name string, workflow v1alpha1.Workflow) (WorkflowDetail, error)
+	Pause(ctx context.Context, namespace, name string) error
+	Resume(ctx context.Context, namespace, name string) error
 }
 
 type WorkflowStatus string
@@ -46,6 +49,7 @@ const (
 	WorkflowRunning WorkflowStatus = "running"
 	WorkflowSucceed WorkflowStatus = "finished"
 	WorkflowFailed  WorkflowStatus = "failed"
+	WorkflowPaused  WorkflowStatus = "paused"
 	WorkflowUnknown WorkflowStatus = "unknown"
 )
 
@@ -92,6 +96,9 @@ type Node struct {
 	Serial              []NodeNameWithTemplate `json:"serial,omitempty"`
 	Parallel            []NodeNameWithTemplate `json:"parallel,omitempty"`
 	ConditionalBranches []ConditionalBranch    `json:"conditional_branches,omitempty"`
+	Iterations          []NodeNameWithTemplate `json:"iterations,omitempty"`
+	DAG                 []DAGTaskNode          `json:"dag,omitempty"`
+	SubWorkflow         string                 `json:"sub_workflow,omitempty"`
 	Template            string                 `json:"template"`
 	UID                 string                 `json:"uid"`
 }
@@ -101,6 +108,14 @@ type NodeNameWithTemplate struct {
 	Template string `json:"template,omitempty"`
 }
 
+// DAGTaskNode describes a task of DAG node, with the names of tasks it depends on.
+type DAGTaskNode struct {
+	NodeNameWithTemplate `json:",inline,omitempty"`
+	Task                 string   `json:"task"`
+	Dependencies         []string `json:"dependencies,omitempty"`
+	Phase                string   `json:"phase,omitempty"`
+}
+
 type ConditionalBranch struct {
 	NodeNameWithTemplate `json:",inline,omitempty"`
 	Expression           string `json:"expression,omitempty"`
@@ -109,7 +124,7 @@ type ConditionalBranch struct {
 // NodeType represents the type of a workflow node.
 //
 // There are several types that can be referred to as NodeType:
-// ChaosNode, SerialNode, ParallelNode, SuspendNode, TaskNode, StatusCheckNode, ScheduleNode.
+// ChaosNode, SerialNode, ParallelNode, SuspendNode, TaskNode, StatusCheckNode, ScheduleNode, LoopNode, RetryNode, ApprovalNode, DAGNode, HTTPRequestNode, K8sApplyNode, K8sWaitNode, SubWorkflowNode.
 //
 // Const definitions can be found below this type.
 type NodeType string
@@ -135,6 +150,30 @@ const (
 
 	// ScheduleNode represents a node that will perform a scheduled chaos experiment.
 	ScheduleNode NodeType = "ScheduleNode"
+
+	// LoopNode represents a node that will perform the same template for several iterations.
+	LoopNode NodeType = "LoopNode"
+
+	// RetryNode represents a node that will retry the template when it fails.
+	RetryNode NodeType = "RetryNode"
+
+	// ApprovalNode represents a node that will wait for the decision of a human.
+	ApprovalNode NodeType = "ApprovalNode"
+
+	// DAGNode represents a node that will perform templates by the dependencies between them.
+	DAGNode NodeType = "DAGNode"
+
+	// HTTPRequestNode represents a node that will send an HTTP request.
+	HTTPRequestNode NodeType = "HTTPRequestNode"
+
+	// K8sApplyNode represents a node that will apply a Kubernetes resource.
+	K8sApplyNode NodeType = "K8sApplyNode"
+
+	// K8sWaitNode represents a node that will wait for the condition of a Kubernetes resource.
+	K8sWaitNode NodeType = "K8sWaitNode"
+
+	// SubWorkflowNode represents a node that will run another workflow as its child.
+	SubWorkflowNode NodeType = "SubWorkflowNode"
 )
 
 var nodeTypeTemplateTypeMapping = map[v1alpha1.TemplateType]NodeType{
@@ -144,6 +183,14 @@ var nodeTypeTemplateTypeMapping = map[v1alpha1.TemplateType]NodeType{
 	v1alpha1.TypeTask:        TaskNode,
 	v1alpha1.TypeStatusCheck: StatusCheckNode,
 	v1alpha1.TypeSchedule:    ScheduleNode,
+	v1alpha1.TypeLoop:        LoopNode,
+	v1alpha1.TypeRetry:       RetryNode,
+	v1alpha1.TypeApproval:    ApprovalNode,
+	v1alpha1.TypeDAG:         DAGNode,
+	v1alpha1.TypeHTTPRequest: HTTPRequestNode,
+	v1alpha1.TypeK8sApply:    K8sApplyNode,
+	v1alpha1.TypeK8sWait:     K8sWaitNode,
+	v1alpha1.TypeSubWorkflow: SubWorkflowNode,
 }
 
 type KubeWorkflowRepository struct {
@@ -252,6 +299,37 @@ func (it *KubeWorkflowRepository) Delete(ctx context.Context, namespace, name st
 	return it.kubeclient.Delete(ctx, &kubeWorkflow)
 }
 
+// Pause pauses the workflow, the running nodes stay where they are and no new nodes will be spawned until it's resumed.
+func (it *KubeWorkflowRepository) Pause(ctx context.Context, namespace, name string) error {
+	return it.patchPauseAnnotation(ctx, namespace, name, true)
+}
+
+// Resume resumes the paused workflow from the nodes where it was paused.
+func (it *KubeWorkflowRepository) Resume(ctx context.Context, namespace, name string) error {
+	return it.patchPauseAnnotation(ctx, namespace, name, false)
+}
+
+func (it *KubeWorkflowRepository) patchPauseAnnotation(ctx context.Context, namespace, name string, paused bool) error {
+	kubeWorkflow := v1alpha1.Workflow{}
+
+	err := it.kubeclient.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}, &kubeWorkflow)
+	if err != nil {
+		return err
+	}
+
+	mergePatch, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				v1alpha1.WorkflowAnnotationPause: strconv.FormatBool(paused),
+			},
+		},
+	})
+	return it.kubeclient.Patch(ctx, &kubeWorkflow, client.RawPatch(types.MergePatchType, mergePatch))
+}
+
 func convertWorkflow(kubeWorkflow v1alpha1.Workflow) WorkflowMeta {
 	result := WorkflowMeta{
 		Namespace: kubeWorkflow.Namespace,
@@ -273,15 +351,19 @@ func convertWorkflow(kubeWorkflow v1alpha1.Workflow) WorkflowMeta {
 	}
 
 	if wfcontrollers.WorkflowConditionEqualsTo(kubeWorkflow.Status, v1alpha1.WorkflowConditionAccomplished, corev1.ConditionTrue) {
-		result.Status = WorkflowSucceed
+		if wfcontrollers.WorkflowConditionEqualsTo(kubeWorkflow.Status, v1alpha1.WorkflowConditionFailed, corev1.ConditionTrue) {
+			result.Status = WorkflowFailed
+		} else {
+			result.Status = WorkflowSucceed
+		}
+	} else if wfcontrollers.WorkflowConditionEqualsTo(kubeWorkflow.Status, v1alpha1.WorkflowConditionPaused, corev1.ConditionTrue) {
+		result.Status = WorkflowPaused
 	} else if wfcontrollers.WorkflowConditionEqualsTo(kubeWorkflow.Status, v1alpha1.WorkflowConditionScheduled, corev1.ConditionTrue) {
 		result.Status = WorkflowRunning
 	} else {
 		result.Status = WorkflowUnknown
 	}
 
-	// TODO: status failed
-
 	return result
 }
 
@@ -317,6 +399,15 @@ func convertWorkflowDetail(kubeWorkflow v1alpha1.Workflow, kubeNodes []v1alpha1.
 	return result, nil
 }
 
+func workflowNodeState(status v1alpha1.WorkflowNodeStatus) NodeState {
+	if wfcontrollers.WorkflowNodeFinished(status) && wfcontrollers.WorkflowNodeFailed(status) {
+		return NodeFailed
+	} else if wfcontrollers.WorkflowNodeFinished(status) {
+		return NodeSucceed
+	}
+	return NodeRunning
+}
+
 func convertWorkflowNode(kubeWorkflowNode v1alpha1.WorkflowNode) (Node, error) {
 	templateType, err := mappingTemplateType(kubeWorkflowNode.Spec.Type)
 	if err != nil {
@@ -353,7 +444,7 @@ func convertWorkflowNode(kubeWorkflowNode v1alpha1.WorkflowNode) (Node, error) {
 		}
 		result.Parallel = composeParallelTaskAndNodes(kubeWorkflowNode.Spec.Children, nodes)
 
-	case v1alpha1.TypeTask:
+	case v1alpha1.TypeTask, v1alpha1.TypeHTTPRequest:
 		var nodes []string
 		for _, child := range kubeWorkflowNode.Status.FinishedChildren {
 			nodes = append(nodes, child.Name)
@@ -362,14 +453,28 @@ func convertWorkflowNode(kubeWorkflowNode v1alpha1.WorkflowNode) (Node, error) {
 			nodes = append(nodes, child.Name)
 		}
 		result.ConditionalBranches = composeTaskConditionalBranches(kubeWorkflowNode.Spec.ConditionalBranches, nodes)
-	}
 
-	if wfcontrollers.WorkflowNodeFinished(kubeWorkflowNode.Status) {
-		result.State = NodeSucceed
-	} else {
-		result.State = NodeRunning
+	case v1alpha1.TypeLoop, v1alpha1.TypeRetry:
+		var nodes []string
+		for _, child := range kubeWorkflowNode.Status.FinishedChildren {
+			nodes = append(nodes, child.Name)
+		}
+		for _, child := range kubeWorkflowNode.Status.ActiveChildren {
+			nodes = append(nodes, child.Name)
+		}
+		result.Iterations = composeIterationNodes(kubeWorkflowNode.Spec.Children, nodes)
+
+	case v1alpha1.TypeDAG:
+		result.DAG = composeDAGTaskNodes(kubeWorkflowNode.Spec.DAG, kubeWorkflowNode.Status.DAGTasks)
+
+	case v1alpha1.TypeSubWorkflow:
+		if kubeWorkflowNode.Status.SubWorkflow != nil {
+			result.SubWorkflow = kubeWorkflowNode.Status.SubWorkflow.Name
+		}
 	}
 
+	result.State = workflowNodeState(kubeWorkflowNode.Status)
+
 	return result, nil
 }
 
@@ -406,6 +511,52 @@ func composeParallelTaskAndNodes(children []string, nodes []string) []NodeNameWi
 	return result
 }
 
+// composeIterationNodes returns the iterations of loop node, or the attempts of retry node,
+// all of them are spawned from the only child template.
+func composeIterationNodes(children []string, nodes []string) []NodeNameWithTemplate {
+	if len(children) == 0 {
+		return nil
+	}
+	var result []NodeNameWithTemplate
+	for _, node := range nodes {
+		result = append(result, NodeNameWithTemplate{Name: node, Template: children[0]})
+	}
+	return result
+}
+
+// composeDAGTaskNodes returns the tasks of DAG node, the tasks not recorded in the status are waiting.
+func composeDAGTaskNodes(dag *v1alpha1.DAGSpec, statuses []v1alpha1.DAGTaskStatus) []DAGTaskNode {
+	if dag == nil {
+		return nil
+	}
+	statusByTask := make(map[string]v1alpha1.DAGTaskStatus)
+	for _, status := range statuses {
+		statusByTask[status.Task] = status
+	}
+	var result []DAGTaskNode
+	for _, task := range dag.Tasks {
+		var dependencies []string
+		for _, dependency := range task.Dependencies {
+			dependencies = append(dependencies, dependency.Task)
+		}
+		phase := v1alpha1.DAGTaskWaiting
+		status, ok := statusByTask[task.Name]
+		if ok {
+			phase = status.Phase
+		}
+		result = append(result, DAGTaskNode{
+			NodeNameWithTemplate: NodeNameWithTemplate{
+				Name:     status.Node,
+				Template: task.Template,
+			},
+			Task:         task.Name,
+			Dependencies: dependencies,
+			Phase:        string(phase),
+		})
+	}
+	return result
+}
+
 func composeTaskConditionalBranches(conditionalBranches []v1alpha1.ConditionalBranch, nodes []string) []ConditionalBranch {
 	var result []ConditionalBranch
 	for _, item := range conditionalBranches {
@@ -457,6 +608,8 @@ type WorkflowStore interface {
 type WorkflowEntity struct {
 	WorkflowMeta
 	Workflow string `gorm:"type:text;size:32768"`
+	// Nodes is the json encoded []NodeRecord of the workflow.
+	Nodes string `gorm:"type:text"`
 }
 
 func WorkflowCR2WorkflowEntity(workflow *v1alpha1.Workflow) (*WorkflowEntity, error) {
@@ -0,0 +1,440 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// lastAppliedConfigAnnotation is set by kubectl apply, it should not be inherited by a rerun.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// NodeRecord is the archived outcome of a single workflow node.
+type NodeRecord struct {
+	Name        string             `json:"name"`
+	Template    string             `json:"template"`
+	Type        NodeType           `json:"type"`
+	State       NodeState          `json:"state"`
+	Reason      string             `json:"reason,omitempty"`
+	StartTime   *time.Time         `json:"start_time,omitempty"`
+	EndTime     *time.Time         `json:"end_time,omitempty"`
+	StatusCheck *StatusCheckResult `json:"status_check,omitempty"`
+	// Targets are the ids of the records injected by the chaos, e.g. "namespace/pod".
+	Targets []string `json:"targets,omitempty"`
+}
+
+// Duration returns the time the node took, it's zero when the node has not finished yet.
+func (it NodeRecord) Duration() time.Duration {
+	if it.StartTime == nil || it.EndTime == nil {
+		return 0
+	}
+	return it.EndTime.Sub(*it.StartTime)
+}
+
+// StatusCheckResult summarizes the executions of the StatusCheck spawned by a node.
+type StatusCheckResult struct {
+	Count     int64  `json:"count"`
+	Successes int    `json:"successes"`
+	Failures  int    `json:"failures"`
+	Outcome   string `json:"outcome,omitempty"`
+}
+
+// WorkflowRun is a workflow with the records of its nodes.
+type WorkflowRun struct {
+	WorkflowMeta `json:",inline"`
+	Nodes        []NodeRecord `json:"nodes"`
+}
+
+// NodeComparison lines up the same node in two runs, Base or Target is nil if the node only exists in one run.
+type NodeComparison struct {
+	Template       string      `json:"template"`
+	Base           *NodeRecord `json:"base,omitempty"`
+	Target         *NodeRecord `json:"target,omitempty"`
+	StateChanged   bool        `json:"state_changed"`
+	DurationDelta  string      `json:"duration_delta,omitempty"`
+	AddedTargets   []string    `json:"added_targets,omitempty"`
+	RemovedTargets []string    `json:"removed_targets,omitempty"`
+}
+
+// WorkflowRunComparison is the node-by-node comparison of two workflow runs.
+type WorkflowRunComparison struct {
+	Base   WorkflowMeta     `json:"base"`
+	Target WorkflowMeta     `json:"target"`
+	Nodes  []NodeComparison `json:"nodes"`
+}
+
+// SnapshotWorkflowNodes collects the records of all nodes of the workflow from Kubernetes.
+//
+// The end time of a finished node is not recorded by the controller, so now is used as the end time,
+// callers should keep the end time seen first with MergeNodeRecords.
+func SnapshotWorkflowNodes(ctx context.Context, kubeClient client.Reader, workflow v1alpha1.Workflow, now time.Time) ([]NodeRecord, error) {
+	nodes := v1alpha1.WorkflowNodeList{}
+	if err := kubeClient.List(ctx, &nodes,
+		client.InNamespace(workflow.Namespace),
+		client.MatchingLabels{v1alpha1.LabelWorkflow: workflow.Name},
+	); err != nil {
+		return nil, err
+	}
+
+	statusChecks := v1alpha1.StatusCheckList{}
+	if err := kubeClient.List(ctx, &statusChecks,
+		client.InNamespace(workflow.Namespace),
+		client.MatchingLabels{v1alpha1.LabelWorkflow: workflow.Name},
+	); err != nil {
+		return nil, err
+	}
+	statusCheckByNode := make(map[string]v1alpha1.StatusCheck, len(statusChecks.Items))
+	for _, statusCheck := range statusChecks.Items {
+		statusCheckByNode[statusCheck.Labels[v1alpha1.LabelControlledBy]] = statusCheck
+	}
+
+	var result []NodeRecord
+	for _, node := range nodes.Items {
+		nodeType, err := mappingTemplateType(node.Spec.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		record := NodeRecord{
+			Name:     node.Name,
+			Template: node.Spec.TemplateName,
+			Type:     nodeType,
+			State:    workflowNodeState(node.Status),
+		}
+		if node.Spec.StartTime != nil {
+			startTime := node.Spec.StartTime.Time
+			record.StartTime = &startTime
+		}
+		if record.State != NodeRunning {
+			endTime := now
+			record.EndTime = &endTime
+		}
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == v1alpha1.ConditionFailed && condition.Status == corev1.ConditionTrue {
+				record.Reason = condition.Reason
+			}
+		}
+		if statusCheck, ok := statusCheckByNode[node.Name]; ok {
+			record.StatusCheck = summarizeStatusCheck(statusCheck)
+		}
+		if node.Status.ChaosResource != nil {
+			record.Targets, err = injectedTargets(ctx, kubeClient, node.Namespace, *node.Status.ChaosResource)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, record)
+	}
+
+	sortNodeRecords(result)
+	return result, nil
+}
+
+func summarizeStatusCheck(statusCheck v1alpha1.StatusCheck) *StatusCheckResult {
+	result := StatusCheckResult{
+		Count: statusCheck.Status.Count,
+	}
+	for _, record := range statusCheck.Status.Records {
+		switch record.Outcome {
+		case v1alpha1.StatusCheckOutcomeSuccess:
+			result.Successes++
+		case v1alpha1.StatusCheckOutcomeFailure:
+			result.Failures++
+		}
+	}
+	for _, condition := range statusCheck.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case v1alpha1.StatusCheckConditionFailureThresholdExceed:
+			result.Outcome = string(v1alpha1.StatusCheckOutcomeFailure)
+		case v1alpha1.StatusCheckConditionSuccessThresholdExceed:
+			if result.Outcome == "" {
+				result.Outcome = string(v1alpha1.StatusCheckOutcomeSuccess)
+			}
+		}
+	}
+	return &result
+}
+
+func injectedTargets(ctx context.Context, kubeClient client.Reader, namespace string, ref corev1.TypedLocalObjectReference) ([]string, error) {
+	kind, ok := v1alpha1.AllKinds()[ref.Kind]
+	if !ok {
+		return nil, nil
+	}
+	chaos, ok := kind.SpawnObject().(v1alpha1.StatefulObject)
+	if !ok {
+		return nil, nil
+	}
+	err := kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, chaos)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []string
+	for _, record := range chaos.GetStatus().Experiment.Records {
+		targets = append(targets, record.Id)
+	}
+	return targets, nil
+}
+
+// MergeNodeRecords merges the latest snapshot of nodes into the previous one. The chaos and status check
+// of a node could be removed after it finished, so the information seen before is kept.
+func MergeNodeRecords(previous, current []NodeRecord) []NodeRecord {
+	previousByName := make(map[string]NodeRecord, len(previous))
+	for _, record := range previous {
+		previousByName[record.Name] = record
+	}
+
+	var result []NodeRecord
+	seen := make(map[string]bool, len(current))
+	for _, record := range current {
+		seen[record.Name] = true
+		if old, ok := previousByName[record.Name]; ok {
+			if old.EndTime != nil && record.EndTime != nil {
+				record.EndTime = old.EndTime
+			}
+			if record.StatusCheck == nil {
+				record.StatusCheck = old.StatusCheck
+			}
+			record.Targets = unionStrings(old.Targets, record.Targets)
+		}
+		result = append(result, record)
+	}
+	for _, record := range previous {
+		if !seen[record.Name] {
+			result = append(result, record)
+		}
+	}
+
+	sortNodeRecords(result)
+	return result
+}
+
+// CompareWorkflowRuns lines up the nodes of two runs by their templates. When a template is instantiated more
+// than once, e.g. in a loop, the nodes are paired in the order of their start time.
+func CompareWorkflowRuns(base, target WorkflowRun) WorkflowRunComparison {
+	result := WorkflowRunComparison{
+		Base:   base.WorkflowMeta,
+		Target: target.WorkflowMeta,
+		Nodes:  []NodeComparison{},
+	}
+
+	targetNodes := make(map[string][]NodeRecord)
+	for _, record := range target.Nodes {
+		targetNodes[record.Template] = append(targetNodes[record.Template], record)
+	}
+
+	paired := make(map[string]bool)
+	for _, record := range base.Nodes {
+		baseRecord := record
+		comparison := NodeComparison{
+			Template:       record.Template,
+			Base:           &baseRecord,
+			StateChanged:   true,
+			RemovedTargets: baseRecord.Targets,
+		}
+		if candidates := targetNodes[record.Template]; len(candidates) > 0 {
+			targetRecord := candidates[0]
+			targetNodes[record.Template] = candidates[1:]
+			paired[targetRecord.Name] = true
+
+			comparison.Target = &targetRecord
+			comparison.StateChanged = baseRecord.State != targetRecord.State
+			if baseRecord.EndTime != nil && targetRecord.EndTime != nil {
+				comparison.DurationDelta = (targetRecord.Duration() - baseRecord.Duration()).String()
+			}
+			comparison.AddedTargets = subtractStrings(targetRecord.Targets, baseRecord.Targets)
+			comparison.RemovedTargets = subtractStrings(baseRecord.Targets, targetRecord.Targets)
+		}
+		result.Nodes = append(result.Nodes, comparison)
+	}
+
+	for _, record := range target.Nodes {
+		if paired[record.Name] {
+			continue
+		}
+		targetRecord := record
+		result.Nodes = append(result.Nodes, NodeComparison{
+			Template:     record.Template,
+			Target:       &targetRecord,
+			StateChanged: true,
+			AddedTargets: targetRecord.Targets,
+		})
+	}
+
+	return result
+}
+
+// RerunWorkflow builds a new workflow with the spec of the given one. The arguments override the ones with the same
+// name, they are only available for the workflow instantiated from a WorkflowTemplate. As the templates of the workflow
+// have been instantiated, the WorkflowTemplate is instantiated again with the new arguments, so the current one should
+// be given, and it should be the same version as the one the workflow was instantiated from.
+//
+// If name is empty, the name is generated by Kubernetes.
+func RerunWorkflow(workflow v1alpha1.Workflow, name string, arguments []v1alpha1.WorkflowArgument, template *v1alpha1.WorkflowTemplate) (v1alpha1.Workflow, error) {
+	spec := workflow.Spec.DeepCopy()
+
+	if len(arguments) > 0 {
+		if spec.WorkflowTemplateRef == nil {
+			return v1alpha1.Workflow{}, errors.Errorf("workflow %s/%s is not instantiated from a WorkflowTemplate, arguments could not be overridden", workflow.Namespace, workflow.Name)
+		}
+		version, ok := workflow.Annotations[v1alpha1.WorkflowAnnotationTemplateVersion]
+		if !ok {
+			return v1alpha1.Workflow{}, errors.Errorf("the version of WorkflowTemplate %s instantiated by workflow %s/%s is not recorded, arguments could not be overridden", spec.WorkflowTemplateRef.Name, workflow.Namespace, workflow.Name)
+		}
+		if template == nil || template.Name != spec.WorkflowTemplateRef.Name || template.ResourceVersion != version {
+			return v1alpha1.Workflow{}, errors.Errorf("WorkflowTemplate %s has been changed or deleted since workflow %s/%s was instantiated, arguments could not be overridden", spec.WorkflowTemplateRef.Name, workflow.Namespace, workflow.Name)
+		}
+
+		for _, argument := range arguments {
+			overridden := false
+			for i := range spec.Arguments {
+				if spec.Arguments[i].Name == argument.Name {
+					spec.Arguments[i].Value = argument.Value
+					overridden = true
+				}
+			}
+			if !overridden {
+				spec.Arguments = append(spec.Arguments, argument)
+			}
+		}
+
+		// instantiate the WorkflowTemplate again with the new arguments
+		spec.Entry = ""
+		spec.Templates = nil
+	}
+
+	annotations := make(map[string]string)
+	for key, value := range workflow.Annotations {
+		switch key {
+		case v1alpha1.WorkflowAnnotationAbort, v1alpha1.WorkflowAnnotationPause, v1alpha1.WorkflowAnnotationPausedAt,
+			v1alpha1.WorkflowAnnotationRerunOf, lastAppliedConfigAnnotation:
+			continue
+		}
+		annotations[key] = value
+	}
+	annotations[v1alpha1.WorkflowAnnotationRerunOf] = string(workflow.UID)
+
+	result := v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   workflow.Namespace,
+			Name:        name,
+			Labels:      workflow.DeepCopy().Labels,
+			Annotations: annotations,
+		},
+		Spec: *spec,
+	}
+	if name == "" {
+		result.GenerateName = workflow.Name + "-"
+	}
+
+	return result, nil
+}
+
+// WorkflowEntity2WorkflowRun decodes the node records archived in the entity.
+func WorkflowEntity2WorkflowRun(entity *WorkflowEntity) (*WorkflowRun, error) {
+	if entity == nil {
+		return nil, nil
+	}
+	nodes, err := entity.NodeRecords()
+	if err != nil {
+		return nil, err
+	}
+	return &WorkflowRun{
+		WorkflowMeta: entity.WorkflowMeta,
+		Nodes:        nodes,
+	}, nil
+}
+
+// NodeRecords decodes the node records archived in the entity.
+func (it *WorkflowEntity) NodeRecords() ([]NodeRecord, error) {
+	if it.Nodes == "" {
+		return nil, nil
+	}
+	var result []NodeRecord
+	if err := json.Unmarshal([]byte(it.Nodes), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SetNodeRecords encodes the node records into the entity.
+func (it *WorkflowEntity) SetNodeRecords(records []NodeRecord) error {
+	content, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	it.Nodes = string(content)
+	return nil
+}
+
+func sortNodeRecords(records []NodeRecord) {
+	sort.SliceStable(records, func(i, j int) bool {
+		left, right := records[i].StartTime, records[j].StartTime
+		if left == nil || right == nil || left.Equal(*right) {
+			if (left == nil) != (right == nil) {
+				return right == nil
+			}
+			return records[i].Name < records[j].Name
+		}
+		return left.Before(*right)
+	})
+}
+
+func unionStrings(left, right []string) []string {
+	var result []string
+	seen := make(map[string]bool, len(left)+len(right))
+	for _, item := range append(append([]string{}, left...), right...) {
+		if !seen[item] {
+			seen[item] = true
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// subtractStrings returns the items in left but not in right.
+func subtractStrings(left, right []string) []string {
+	exists := make(map[string]bool, len(right))
+	for _, item := range right {
+		exists[item] = true
+	}
+	var result []string
+	for _, item := range left {
+		if !exists[item] {
+			result = append(result, item)
+		}
+	}
+	return result
+}
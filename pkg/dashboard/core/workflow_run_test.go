@@ -0,0 +1,299 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestSnapshotWorkflowNodes(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	startTime := metav1.NewTime(time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC))
+	now := startTime.Add(5 * time.Minute)
+	labels := map[string]string{v1alpha1.LabelWorkflow: "game-day"}
+
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "kill-pod-abcde", Labels: labels},
+			Spec: v1alpha1.WorkflowNodeSpec{
+				TemplateName: "kill-pod",
+				WorkflowName: "game-day",
+				Type:         v1alpha1.TypePodChaos,
+				StartTime:    &startTime,
+			},
+			Status: v1alpha1.WorkflowNodeStatus{
+				ChaosResource: &corev1.TypedLocalObjectReference{Kind: v1alpha1.KindPodChaos, Name: "kill-pod-abcde"},
+			},
+		},
+		&v1alpha1.PodChaos{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "kill-pod-abcde"},
+			Status: v1alpha1.PodChaosStatus{ChaosStatus: v1alpha1.ChaosStatus{Experiment: v1alpha1.ExperimentStatus{
+				Records: []*v1alpha1.Record{{Id: "default/web-0"}, {Id: "default/web-1"}},
+			}}},
+		},
+		&v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "check-fghij", Labels: labels},
+			Spec: v1alpha1.WorkflowNodeSpec{
+				TemplateName: "check",
+				WorkflowName: "game-day",
+				Type:         v1alpha1.TypeStatusCheck,
+				StartTime:    &startTime,
+			},
+			Status: v1alpha1.WorkflowNodeStatus{Conditions: []v1alpha1.WorkflowNodeCondition{
+				{Type: v1alpha1.ConditionAccomplished, Status: corev1.ConditionTrue},
+				{Type: v1alpha1.ConditionFailed, Status: corev1.ConditionTrue, Reason: v1alpha1.StatusCheckFailed},
+			}},
+		},
+		&v1alpha1.StatusCheck{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "check-fghij",
+				Labels:    map[string]string{v1alpha1.LabelWorkflow: "game-day", v1alpha1.LabelControlledBy: "check-fghij"},
+			},
+			Status: v1alpha1.StatusCheckStatus{
+				Count: 3,
+				Records: []v1alpha1.StatusCheckRecord{
+					{Outcome: v1alpha1.StatusCheckOutcomeSuccess},
+					{Outcome: v1alpha1.StatusCheckOutcomeFailure},
+					{Outcome: v1alpha1.StatusCheckOutcomeFailure},
+				},
+				Conditions: []v1alpha1.StatusCheckCondition{
+					{Type: v1alpha1.StatusCheckConditionFailureThresholdExceed, Status: corev1.ConditionTrue},
+				},
+			},
+		},
+		&v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other-klmno", Labels: map[string]string{v1alpha1.LabelWorkflow: "other"}},
+			Spec:       v1alpha1.WorkflowNodeSpec{TemplateName: "other", WorkflowName: "other", Type: v1alpha1.TypeSuspend},
+		},
+	).Build()
+
+	workflow := v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "game-day"}}
+	records, err := SnapshotWorkflowNodes(context.Background(), kubeClient, workflow, now)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(HaveLen(2))
+
+	g.Expect(records[0].Name).To(Equal("check-fghij"))
+	g.Expect(records[0].Type).To(Equal(StatusCheckNode))
+	g.Expect(records[0].State).To(Equal(NodeFailed))
+	g.Expect(records[0].Reason).To(Equal(v1alpha1.StatusCheckFailed))
+	g.Expect(*records[0].EndTime).To(Equal(now))
+	g.Expect(records[0].StatusCheck).To(Equal(&StatusCheckResult{Count: 3, Successes: 1, Failures: 2, Outcome: "Failure"}))
+
+	g.Expect(records[1].Name).To(Equal("kill-pod-abcde"))
+	g.Expect(records[1].Type).To(Equal(ChaosNode))
+	g.Expect(records[1].State).To(Equal(NodeRunning))
+	g.Expect(records[1].EndTime).To(BeNil())
+	g.Expect(records[1].Targets).To(Equal([]string{"default/web-0", "default/web-1"}))
+}
+
+func TestMergeNodeRecords(t *testing.T) {
+	g := NewWithT(t)
+
+	startTime := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	firstSeenEnd := startTime.Add(time.Minute)
+	laterSeenEnd := startTime.Add(10 * time.Minute)
+
+	previous := []NodeRecord{
+		{Name: "kill-pod", StartTime: &startTime, EndTime: &firstSeenEnd, State: NodeSucceed, Targets: []string{"default/web-0"}},
+		{Name: "check", StartTime: &startTime, StatusCheck: &StatusCheckResult{Count: 1, Successes: 1}},
+		{Name: "removed", StartTime: &startTime},
+	}
+	current := []NodeRecord{
+		{Name: "kill-pod", StartTime: &startTime, EndTime: &laterSeenEnd, State: NodeSucceed},
+		{Name: "check", StartTime: &startTime, State: NodeRunning},
+	}
+
+	merged := MergeNodeRecords(previous, current)
+	g.Expect(merged).To(HaveLen(3))
+	g.Expect(merged[0].Name).To(Equal("check"))
+	g.Expect(merged[0].StatusCheck).To(Equal(&StatusCheckResult{Count: 1, Successes: 1}))
+	g.Expect(merged[1].Name).To(Equal("kill-pod"))
+	g.Expect(*merged[1].EndTime).To(Equal(firstSeenEnd))
+	g.Expect(merged[1].Targets).To(Equal([]string{"default/web-0"}))
+	g.Expect(merged[2].Name).To(Equal("removed"))
+}
+
+func TestCompareWorkflowRuns(t *testing.T) {
+	g := NewWithT(t)
+
+	at := func(minutes int) *time.Time {
+		result := time.Date(2026, 10, 1, 10, minutes, 0, 0, time.UTC)
+		return &result
+	}
+
+	base := WorkflowRun{
+		WorkflowMeta: WorkflowMeta{UID: "base"},
+		Nodes: []NodeRecord{
+			{Name: "kill-pod-1", Template: "kill-pod", State: NodeSucceed, StartTime: at(0), EndTime: at(1), Targets: []string{"default/web-0", "default/web-1"}},
+			{Name: "kill-pod-2", Template: "kill-pod", State: NodeSucceed, StartTime: at(1), EndTime: at(2)},
+			{Name: "check-1", Template: "check", State: NodeSucceed, StartTime: at(2), EndTime: at(3)},
+		},
+	}
+	target := WorkflowRun{
+		WorkflowMeta: WorkflowMeta{UID: "target"},
+		Nodes: []NodeRecord{
+			{Name: "kill-pod-3", Template: "kill-pod", State: NodeSucceed, StartTime: at(0), EndTime: at(3), Targets: []string{"default/web-1", "default/web-2"}},
+			{Name: "check-2", Template: "check", State: NodeFailed, StartTime: at(3), EndTime: at(4)},
+			{Name: "notify-1", Template: "notify", State: NodeRunning, StartTime: at(4)},
+		},
+	}
+
+	comparison := CompareWorkflowRuns(base, target)
+	g.Expect(comparison.Base.UID).To(Equal("base"))
+	g.Expect(comparison.Target.UID).To(Equal("target"))
+	g.Expect(comparison.Nodes).To(HaveLen(4))
+
+	g.Expect(comparison.Nodes[0].Base.Name).To(Equal("kill-pod-1"))
+	g.Expect(comparison.Nodes[0].Target.Name).To(Equal("kill-pod-3"))
+	g.Expect(comparison.Nodes[0].StateChanged).To(BeFalse())
+	g.Expect(comparison.Nodes[0].DurationDelta).To(Equal("2m0s"))
+	g.Expect(comparison.Nodes[0].AddedTargets).To(Equal([]string{"default/web-2"}))
+	g.Expect(comparison.Nodes[0].RemovedTargets).To(Equal([]string{"default/web-0"}))
+
+	g.Expect(comparison.Nodes[1].Base.Name).To(Equal("kill-pod-2"))
+	g.Expect(comparison.Nodes[1].Target).To(BeNil())
+	g.Expect(comparison.Nodes[1].StateChanged).To(BeTrue())
+
+	g.Expect(comparison.Nodes[2].Base.Name).To(Equal("check-1"))
+	g.Expect(comparison.Nodes[2].Target.Name).To(Equal("check-2"))
+	g.Expect(comparison.Nodes[2].StateChanged).To(BeTrue())
+	g.Expect(comparison.Nodes[2].DurationDelta).To(Equal("0s"))
+
+	g.Expect(comparison.Nodes[3].Base).To(BeNil())
+	g.Expect(comparison.Nodes[3].Target.Name).To(Equal("notify-1"))
+	g.Expect(comparison.Nodes[3].DurationDelta).To(BeEmpty())
+}
+
+func TestRerunWorkflow(t *testing.T) {
+	g := NewWithT(t)
+
+	entryNode := "entry-abcde"
+	archived := v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            "game-day",
+			UID:             "archived-uid",
+			ResourceVersion: "42",
+			Labels:          map[string]string{"team": "sre"},
+			Annotations: map[string]string{
+				"owner":                                    "sre",
+				v1alpha1.WorkflowAnnotationPause:           "true",
+				v1alpha1.WorkflowAnnotationPausedAt:        "2026-10-01T10:00:00Z",
+				v1alpha1.WorkflowAnnotationRerunOf:         "older-uid",
+				lastAppliedConfigAnnotation:                "{}",
+				v1alpha1.WorkflowAnnotationTemplateVersion: "7",
+			},
+		},
+		Spec: v1alpha1.WorkflowSpec{
+			Entry:               "entry",
+			Templates:           []v1alpha1.Template{{Name: "entry", Type: v1alpha1.TypeSuspend}},
+			WorkflowTemplateRef: &v1alpha1.WorkflowTemplateRef{Name: "game-day-template"},
+			Arguments:           []v1alpha1.WorkflowArgument{{Name: "duration", Value: "30s"}, {Name: "mode", Value: "one"}},
+		},
+		Status: v1alpha1.WorkflowStatus{EntryNode: &entryNode},
+	}
+	template := &v1alpha1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "game-day-template", ResourceVersion: "7"},
+	}
+
+	t.Run("same spec", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rerun, err := RerunWorkflow(archived, "", nil, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rerun.Namespace).To(Equal("default"))
+		g.Expect(rerun.Name).To(BeEmpty())
+		g.Expect(rerun.GenerateName).To(Equal("game-day-"))
+		g.Expect(rerun.ResourceVersion).To(BeEmpty())
+		g.Expect(rerun.Labels).To(Equal(map[string]string{"team": "sre"}))
+		g.Expect(rerun.Annotations).To(Equal(map[string]string{
+			"owner":                            "sre",
+			v1alpha1.WorkflowAnnotationRerunOf: "archived-uid",
+			v1alpha1.WorkflowAnnotationTemplateVersion: "7",
+		}))
+		g.Expect(rerun.Spec).To(Equal(archived.Spec))
+		g.Expect(rerun.Status).To(Equal(v1alpha1.WorkflowStatus{}))
+	})
+
+	t.Run("override arguments", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rerun, err := RerunWorkflow(archived, "game-day-2", []v1alpha1.WorkflowArgument{
+			{Name: "duration", Value: "1m"},
+			{Name: "replicas", Value: "2"},
+		}, template)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rerun.Name).To(Equal("game-day-2"))
+		g.Expect(rerun.GenerateName).To(BeEmpty())
+		g.Expect(rerun.Spec.Arguments).To(Equal([]v1alpha1.WorkflowArgument{
+			{Name: "duration", Value: "1m"},
+			{Name: "mode", Value: "one"},
+			{Name: "replicas", Value: "2"},
+		}))
+		g.Expect(rerun.Spec.Entry).To(BeEmpty())
+		g.Expect(rerun.Spec.Templates).To(BeNil())
+		g.Expect(archived.Spec.Arguments[0].Value).To(Equal("30s"))
+	})
+
+	t.Run("override arguments without WorkflowTemplate", func(t *testing.T) {
+		g := NewWithT(t)
+
+		plain := *archived.DeepCopy()
+		plain.Spec.WorkflowTemplateRef = nil
+		_, err := RerunWorkflow(plain, "", []v1alpha1.WorkflowArgument{{Name: "duration", Value: "1m"}}, nil)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("override arguments with changed WorkflowTemplate", func(t *testing.T) {
+		g := NewWithT(t)
+
+		arguments := []v1alpha1.WorkflowArgument{{Name: "duration", Value: "1m"}}
+		changed := template.DeepCopy()
+		changed.ResourceVersion = "8"
+		_, err := RerunWorkflow(archived, "", arguments, changed)
+		g.Expect(err).To(MatchError(ContainSubstring("has been changed or deleted")))
+
+		_, err = RerunWorkflow(archived, "", arguments, nil)
+		g.Expect(err).To(MatchError(ContainSubstring("has been changed or deleted")))
+
+		unrecorded := *archived.DeepCopy()
+		delete(unrecorded.Annotations, v1alpha1.WorkflowAnnotationTemplateVersion)
+		_, err = RerunWorkflow(unrecorded, "", arguments, template)
+		g.Expect(err).To(MatchError(ContainSubstring("is not recorded")))
+
+		// the archived templates are kept without overriding
+		rerun, err := RerunWorkflow(archived, "", nil, changed)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rerun.Spec).To(Equal(archived.Spec))
+	})
+
+	g.Expect(archived.Spec.Entry).To(Equal("entry"))
+}
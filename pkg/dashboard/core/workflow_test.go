@@ -16,11 +16,16 @@
 package core
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 )
@@ -186,6 +191,79 @@ func Test_convertWorkflow(t *testing.T) {
 				Entry:     "an-entry",
 				Status:    WorkflowSucceed,
 			},
+		}, {
+			name: "failed workflow",
+			args: args{
+				v1alpha1.Workflow{
+					TypeMeta: metav1.TypeMeta{},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-workflow-0",
+					},
+					Spec: v1alpha1.WorkflowSpec{
+						Entry: "an-entry",
+					},
+					Status: v1alpha1.WorkflowStatus{
+						Conditions: []v1alpha1.WorkflowCondition{
+							{
+								Type:   v1alpha1.WorkflowConditionAccomplished,
+								Status: corev1.ConditionTrue,
+								Reason: "",
+							},
+							{
+								Type:   v1alpha1.WorkflowConditionScheduled,
+								Status: corev1.ConditionTrue,
+								Reason: "",
+							},
+							{
+								Type:   v1alpha1.WorkflowConditionFailed,
+								Status: corev1.ConditionTrue,
+								Reason: v1alpha1.ExitHandlerFailed,
+							},
+						},
+					},
+				},
+			},
+			want: WorkflowMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-workflow-0",
+				Entry:     "an-entry",
+				Status:    WorkflowFailed,
+			},
+		}, {
+			name: "paused workflow",
+			args: args{
+				v1alpha1.Workflow{
+					TypeMeta: metav1.TypeMeta{},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-workflow-0",
+					},
+					Spec: v1alpha1.WorkflowSpec{
+						Entry: "an-entry",
+					},
+					Status: v1alpha1.WorkflowStatus{
+						Conditions: []v1alpha1.WorkflowCondition{
+							{
+								Type:   v1alpha1.WorkflowConditionScheduled,
+								Status: corev1.ConditionTrue,
+								Reason: "",
+							},
+							{
+								Type:   v1alpha1.WorkflowConditionPaused,
+								Status: corev1.ConditionTrue,
+								Reason: v1alpha1.WorkflowPaused,
+							},
+						},
+					},
+				},
+			},
+			want: WorkflowMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-workflow-0",
+				Entry:     "an-entry",
+				Status:    WorkflowPaused,
+			},
 		}, {
 			name: "converting UID",
 			args: args{
@@ -694,6 +772,184 @@ func Test_convertWorkflowNode(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "http request node",
+			args: args{
+				kubeWorkflowNode: v1alpha1.WorkflowNode{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "mocking-http-request-node-0",
+						Namespace: "mocked-namespace",
+					},
+					Spec: v1alpha1.WorkflowNodeSpec{
+						TemplateName: "mocking-http-request-node",
+						WorkflowName: "fake-workflow-0",
+						Type:         v1alpha1.TypeHTTPRequest,
+						HTTPRequest: &v1alpha1.HTTPRequestNodeSpec{
+							URL: "http://web-show.chaos-mesh.svc",
+						},
+						ConditionalBranches: []v1alpha1.ConditionalBranch{
+							{
+								Target:     "one-node",
+								Expression: "statusCode == 200",
+							},
+						},
+					},
+					Status: v1alpha1.WorkflowNodeStatus{
+						HTTPResponse: &v1alpha1.HTTPResponseStatus{StatusCode: 200},
+						ConditionalBranchesStatus: &v1alpha1.ConditionalBranchesStatus{
+							Branches: []v1alpha1.ConditionalBranchStatus{
+								{
+									Target:           "one-node",
+									EvaluationResult: corev1.ConditionTrue,
+								},
+							},
+						},
+						FinishedChildren: []corev1.LocalObjectReference{
+							{
+								Name: "one-node-0",
+							},
+						},
+						Conditions: []v1alpha1.WorkflowNodeCondition{
+							{
+								Type:   v1alpha1.ConditionAccomplished,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+			want: Node{
+				Name:  "mocking-http-request-node-0",
+				Type:  HTTPRequestNode,
+				State: NodeSucceed,
+				ConditionalBranches: []ConditionalBranch{
+					{
+						NodeNameWithTemplate: NodeNameWithTemplate{
+							Template: "one-node",
+							Name:     "one-node-0",
+						},
+						Expression: "statusCode == 200",
+					},
+				},
+				Template: "mocking-http-request-node",
+			},
+			wantErr: false,
+		},
+		{
+			name: "loop node",
+			args: args{
+				kubeWorkflowNode: v1alpha1.WorkflowNode{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "loop-node-0",
+					},
+					Spec: v1alpha1.WorkflowNodeSpec{
+						TemplateName: "loop-node",
+						WorkflowName: "fake-workflow-0",
+						Type:         v1alpha1.TypeLoop,
+						Children:     []string{"child-0"},
+					},
+					Status: v1alpha1.WorkflowNodeStatus{
+						FinishedChildren: []corev1.LocalObjectReference{{Name: "child-0-aaaaa"}},
+						ActiveChildren:   []corev1.LocalObjectReference{{Name: "child-0-bbbbb"}},
+					},
+				},
+			},
+			want: Node{
+				Name:  "loop-node-0",
+				Type:  LoopNode,
+				State: NodeRunning,
+				Iterations: []NodeNameWithTemplate{
+					{Name: "child-0-aaaaa", Template: "child-0"},
+					{Name: "child-0-bbbbb", Template: "child-0"},
+				},
+				Template: "loop-node",
+			},
+			wantErr: false,
+		},
+		{
+			name: "failed retry node",
+			args: args{
+				kubeWorkflowNode: v1alpha1.WorkflowNode{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "retry-node-0",
+					},
+					Spec: v1alpha1.WorkflowNodeSpec{
+						TemplateName: "retry-node",
+						WorkflowName: "fake-workflow-0",
+						Type:         v1alpha1.TypeRetry,
+						Children:     []string{"child-0"},
+					},
+					Status: v1alpha1.WorkflowNodeStatus{
+						FinishedChildren: []corev1.LocalObjectReference{{Name: "child-0-aaaaa"}, {Name: "child-0-bbbbb"}},
+						Conditions: []v1alpha1.WorkflowNodeCondition{
+							{Type: v1alpha1.ConditionAccomplished, Status: corev1.ConditionTrue},
+							{Type: v1alpha1.ConditionFailed, Status: corev1.ConditionTrue, Reason: v1alpha1.ChildNodeFailed},
+						},
+					},
+				},
+			},
+			want: Node{
+				Name:  "retry-node-0",
+				Type:  RetryNode,
+				State: NodeFailed,
+				Iterations: []NodeNameWithTemplate{
+					{Name: "child-0-aaaaa", Template: "child-0"},
+					{Name: "child-0-bbbbb", Template: "child-0"},
+				},
+				Template: "retry-node",
+			},
+			wantErr: false,
+		},
+		{
+			name: "running dag node",
+			args: args{
+				kubeWorkflowNode: v1alpha1.WorkflowNode{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "dag-node-0",
+					},
+					Spec: v1alpha1.WorkflowNodeSpec{
+						TemplateName: "dag-node",
+						WorkflowName: "fake-workflow-0",
+						Type:         v1alpha1.TypeDAG,
+						DAG: &v1alpha1.DAGSpec{
+							Tasks: []v1alpha1.DAGTask{
+								{Name: "a", Template: "child-0"},
+								{Name: "b", Template: "child-1", Dependencies: []v1alpha1.DAGDependency{{Task: "a"}}},
+							},
+						},
+					},
+					Status: v1alpha1.WorkflowNodeStatus{
+						ActiveChildren: []corev1.LocalObjectReference{{Name: "child-0-aaaaa"}},
+						DAGTasks: []v1alpha1.DAGTaskStatus{
+							{Task: "a", Node: "child-0-aaaaa", Phase: v1alpha1.DAGTaskRunning},
+						},
+					},
+				},
+			},
+			want: Node{
+				Name:  "dag-node-0",
+				Type:  DAGNode,
+				State: NodeRunning,
+				DAG: []DAGTaskNode{
+					{
+						NodeNameWithTemplate: NodeNameWithTemplate{Name: "child-0-aaaaa", Template: "child-0"},
+						Task:                 "a",
+						Phase:                "Running",
+					},
+					{
+						NodeNameWithTemplate: NodeNameWithTemplate{Template: "child-1"},
+						Task:                 "b",
+						Dependencies:         []string{"a"},
+						Phase:                "Waiting",
+					},
+				},
+				Template: "dag-node",
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -953,3 +1209,30 @@ func Test_composeTaskConditionalBranches(t *testing.T) {
 		})
 	}
 }
+
+func TestKubeWorkflowRepositoryPause(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "game-day",
+			Annotations: map[string]string{"foo": "bar"},
+		},
+	}).Build()
+	repo := NewKubeWorkflowRepository(kubeClient)
+	key := types.NamespacedName{Namespace: "default", Name: "game-day"}
+
+	g.Expect(repo.Pause(context.Background(), "default", "game-day")).To(Succeed())
+	workflow := v1alpha1.Workflow{}
+	g.Expect(kubeClient.Get(context.Background(), key, &workflow)).To(Succeed())
+	g.Expect(workflow.Annotations).Should(Equal(map[string]string{"foo": "bar", v1alpha1.WorkflowAnnotationPause: "true"}))
+
+	g.Expect(repo.Resume(context.Background(), "default", "game-day")).To(Succeed())
+	g.Expect(kubeClient.Get(context.Background(), key, &workflow)).To(Succeed())
+	g.Expect(workflow.Annotations[v1alpha1.WorkflowAnnotationPause]).Should(Equal("false"))
+
+	g.Expect(repo.Pause(context.Background(), "default", "not-found")).ShouldNot(Succeed())
+}
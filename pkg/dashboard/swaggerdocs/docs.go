@@ -400,6 +400,102 @@ const docTemplate = `{
                 }
             }
         },
+        "/archives/workflows/{uid}/rerun": {
+            "post": {
+                "description": "Create a new workflow with the spec of the archived one, the arguments could be overridden if it's instantiated from a WorkflowTemplate which is not changed since then.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "archives"
+                ],
+                "summary": "Rerun an archived workflow.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the workflow uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the options of the rerun",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_types.WorkflowRerun"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/archives/workflows/{uid}/run": {
+            "get": {
+                "description": "Get the timing, outcome, status check results and injected targets of each node in the archived workflow.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "archives"
+                ],
+                "summary": "Get the run of an archived workflow.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the workflow uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowRun"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    }
+                }
+            }
+        },
         "/archives/{uid}": {
             "get": {
                 "description": "Get the archived chaos experiment's detail by uid.",
@@ -2098,32 +2194,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/workflows/parse-task/http": {
-            "post": {
-                "description": "Parse the rendered task back to the original request",
+        "/workflows/approvals": {
+            "get": {
+                "description": "List the approval nodes of workflows which are waiting for the decision.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workflows"
                 ],
-                "summary": "Parse the rendered task back to the original request",
+                "summary": "List the pending approvals of workflows.",
                 "parameters": [
                     {
-                        "description": "Rendered Task",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Template"
-                        }
+                        "type": "string",
+                        "description": "namespace, given empty string means list from all namespace",
+                        "name": "namespace",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_curl.RequestForm"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.PendingApproval"
+                            }
                         }
                     },
                     "400": {
@@ -2141,32 +2237,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/workflows/render-task/http": {
+        "/workflows/approvals/{namespace}/{name}/approve": {
             "post": {
-                "description": "Render a task which sends HTTP request",
+                "description": "Approve the pending approval node, the user of the token is recorded as the approver. It requires the security mode.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workflows"
                 ],
-                "summary": "Render a task which sends HTTP request",
+                "summary": "Approve the pending approval node.",
                 "parameters": [
                     {
-                        "description": "Origin HTTP Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_curl.RequestForm"
-                        }
+                        "type": "string",
+                        "description": "namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the approval node",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Template"
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.Response"
                         }
                     },
                     "400": {
@@ -2175,6 +2276,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2184,32 +2291,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/workflows/validate-task/http": {
+        "/workflows/approvals/{namespace}/{name}/reject": {
             "post": {
-                "description": "Validate the given template is a valid rendered HTTP Task",
+                "description": "Reject the pending approval node, the user of the token is recorded as the approver. It requires the security mode.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workflows"
                 ],
-                "summary": "Validate the given template is a valid rendered HTTP Task",
+                "summary": "Reject the pending approval node.",
                 "parameters": [
                     {
-                        "description": "Rendered Task",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Template"
-                        }
+                        "type": "string",
+                        "description": "namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the approval node",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "boolean"
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.Response"
                         }
                     },
                     "400": {
@@ -2218,6 +2330,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2227,22 +2345,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/workflows/{uid}": {
+        "/workflows/compare": {
             "get": {
-                "description": "Get detailed information about the specified workflow. If that object is not existed in kubernetes, it will only return ths persisted data in the database.",
+                "description": "Line up the nodes of two workflows by their templates, compare the timing, outcomes, status check results and injected targets.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workflows"
                 ],
-                "summary": "Get detailed information about the specified workflow.",
+                "summary": "Compare two runs of workflows.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "uid",
-                        "name": "uid",
-                        "in": "path",
+                        "description": "the uid of the base workflow",
+                        "name": "base",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the uid of the workflow compared with the base one",
+                        "name": "target",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -2250,7 +2375,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowDetail"
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowRunComparison"
                         }
                     },
                     "400": {
@@ -2259,6 +2384,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2266,31 +2397,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a workflow.",
+            }
+        },
+        "/workflows/parse-task/http": {
+            "post": {
+                "description": "Parse the rendered task back to the original request",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workflows"
                 ],
-                "summary": "Update a workflow.",
+                "summary": "Parse the rendered task back to the original request",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "uid",
-                        "name": "uid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Request body",
+                        "description": "Rendered Task",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Workflow"
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Template"
                         }
                     }
                 ],
@@ -2298,7 +2424,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowDetail"
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_curl.RequestForm"
                         }
                     },
                     "400": {
@@ -2314,16 +2440,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete the specified workflow.",
+            }
+        },
+        "/workflows/pause/{uid}": {
+            "put": {
+                "description": "Pause the specified workflow. The running nodes are paused and no new nodes will be spawned until it's started again.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workflows"
                 ],
-                "summary": "Delete the specified workflow.",
+                "summary": "Pause the specified workflow.",
                 "parameters": [
                     {
                         "type": "string",
@@ -2360,34 +2488,311 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.AWSChaosAction": {
-            "type": "string",
-            "enum": [
-                "ec2-stop",
-                "ec2-restart",
-                "detach-volume"
-            ],
-            "x-enum-varnames": [
-                "Ec2Stop",
-                "Ec2Restart",
-                "DetachVolume"
-            ]
         },
-        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.AWSChaosSpec": {
-            "type": "object",
-            "properties": {
-                "action": {
-                    "description": "Action defines the specific aws chaos action.\nSupported action: ec2-stop / ec2-restart / detach-volume\nDefault action: ec2-stop\n+kubebuilder:validation:Enum=ec2-stop;ec2-restart;detach-volume",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.AWSChaosAction"
+        "/workflows/render-task/http": {
+            "post": {
+                "description": "Render a task which sends HTTP request",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Render a task which sends HTTP request",
+                "parameters": [
+                    {
+                        "description": "Origin HTTP Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_curl.RequestForm"
                         }
-                    ]
-                },
-                "awsRegion": {
+                    },
+                    {
+                        "enum": [
+                            "Task",
+                            "HTTPRequest"
+                        ],
+                        "type": "string",
+                        "description": "the type of rendered template, given empty string means Task",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Template"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workflows/start/{uid}": {
+            "put": {
+                "description": "Start the paused workflow, it continues from the nodes where it was paused.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Start the paused workflow.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workflows/validate-task/http": {
+            "post": {
+                "description": "Validate the given template is a valid rendered HTTP Task",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Validate the given template is a valid rendered HTTP Task",
+                "parameters": [
+                    {
+                        "description": "Rendered Task",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Template"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/workflows/{uid}": {
+            "get": {
+                "description": "Get detailed information about the specified workflow. If that object is not existed in kubernetes, it will only return ths persisted data in the database.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Get detailed information about the specified workflow.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a workflow.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Update a workflow.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Workflow"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete the specified workflow.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Delete the specified workflow.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.AWSChaosAction": {
+            "type": "string",
+            "enum": [
+                "ec2-stop",
+                "ec2-restart",
+                "detach-volume"
+            ],
+            "x-enum-varnames": [
+                "Ec2Stop",
+                "Ec2Restart",
+                "DetachVolume"
+            ]
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.AWSChaosSpec": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action defines the specific aws chaos action.\nSupported action: ec2-stop / ec2-restart / detach-volume\nDefault action: ec2-stop\n+kubebuilder:validation:Enum=ec2-stop;ec2-restart;detach-volume",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.AWSChaosAction"
+                        }
+                    ]
+                },
+                "awsRegion": {
                     "description": "AWSRegion defines the region of aws.",
                     "type": "string"
                 },
@@ -2421,6 +2826,38 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ApprovalAction": {
+            "type": "string",
+            "enum": [
+                "Approve",
+                "Reject"
+            ],
+            "x-enum-varnames": [
+                "ApprovalActionApprove",
+                "ApprovalActionReject"
+            ]
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ApprovalSpec": {
+            "type": "object",
+            "properties": {
+                "defaultAction": {
+                    "description": "DefaultAction is taken when the timeout exceeded.\n+optional\n+kubebuilder:validation:Enum=Approve;Reject\n+kubebuilder:default=Reject",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ApprovalAction"
+                        }
+                    ]
+                },
+                "message": {
+                    "description": "Message is shown to the approvers.\n+optional",
+                    "type": "string"
+                },
+                "timeout": {
+                    "description": "Timeout is the duration to wait for the decision, such as 30m. It waits until the decision is made if it's omitted.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.AttrOverrideSpec": {
             "type": "object",
             "properties": {
@@ -2576,17 +3013,21 @@ const docTemplate = `{
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.BlockChaosAction": {
             "type": "string",
             "enum": [
-                "delay"
+                "delay",
+                "error",
+                "limit"
             ],
             "x-enum-varnames": [
-                "BlockDelay"
+                "BlockDelay",
+                "BlockError",
+                "BlockLimit"
             ]
         },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.BlockChaosSpec": {
             "type": "object",
             "properties": {
                 "action": {
-                    "description": "Action defines the specific block chaos action.\nSupported action: delay\n+kubebuilder:validation:Enum=delay",
+                    "description": "Action defines the specific block chaos action.\nSupported action: delay / error / limit\n+kubebuilder:validation:Enum=delay;error;limit",
                     "allOf": [
                         {
                             "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.BlockChaosAction"
@@ -2612,6 +3053,22 @@ const docTemplate = `{
                     "description": "Duration represents the duration of the chaos action.\n+optional",
                     "type": "string"
                 },
+                "error": {
+                    "description": "Error defines the percentage of failed io requests.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.BlockErrorSpec"
+                        }
+                    ]
+                },
+                "limit": {
+                    "description": "Limit defines the bandwidth and IOPS caps.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.BlockLimitSpec"
+                        }
+                    ]
+                },
                 "mode": {
                     "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
                     "allOf": [
@@ -2660,6 +3117,40 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.BlockErrorSpec": {
+            "type": "object",
+            "properties": {
+                "percent": {
+                    "description": "Percent defines the percentage of io requests which fail with EIO.\nBy default, it's implemented by the fail_make_request fault injection of the kernel, which requires\nthe kernel to be built with CONFIG_FAIL_MAKE_REQUEST. It fails the requests to the device from every\nprocess on the node, not only the selected container. And the percentage is shared by all the devices\non the node, so it's refused to inject the device which is failing, or the other devices with a\ndifferent percentage at the same time.",
+                    "type": "integer"
+                },
+                "writeOnly": {
+                    "description": "WriteOnly fails only the write requests. It's implemented by the bio-failure of bpfki, which\nonly fails the requests submitted by the processes of the container, so it doesn't fail the\nwriteback of the page cache. It requires bpfki to be deployed with chaos-daemon.\n+optional",
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.BlockLimitSpec": {
+            "type": "object",
+            "properties": {
+                "readBytesPerSec": {
+                    "description": "ReadBytesPerSec limits the read bandwidth of the container, e.g. 10MB.\n+optional",
+                    "type": "string"
+                },
+                "readIOPS": {
+                    "description": "ReadIOPS limits the read io requests per second of the container.\n+optional\n+kubebuilder:validation:Minimum=0",
+                    "type": "integer"
+                },
+                "writeBytesPerSec": {
+                    "description": "WriteBytesPerSec limits the write bandwidth of the container, e.g. 10MB.\n+optional",
+                    "type": "string"
+                },
+                "writeIOPS": {
+                    "description": "WriteIOPS limits the write io requests per second of the container.\n+optional\n+kubebuilder:validation:Minimum=0",
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.CPUStressor": {
             "type": "object",
             "properties": {
@@ -2667,6 +3158,10 @@ const docTemplate = `{
                     "description": "Load specifies P percent loading per CPU worker. 0 is effectively a sleep (no load) and 100\nis full loading.\n+kubebuilder:validation:Minimum=0\n+kubebuilder:validation:Maximum=100\n+optional",
                     "type": "integer"
                 },
+                "method": {
+                    "description": "Method specifies the cpu stress method of stress-ng, default is sqrt.\nSee ` + "`" + `--cpu-method` + "`" + ` in ` + "`" + `man stress-ng` + "`" + ` for the supported methods.\n+optional",
+                    "type": "string"
+                },
                 "options": {
                     "description": "extend stress-ng options\n+optional",
                     "type": "array",
@@ -2680,6 +3175,19 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.CacheStressor": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "description": "Level specifies the level of CPU cache to thrash, default is 3.\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=3\n+optional",
+                    "type": "integer"
+                },
+                "workers": {
+                    "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ChaosOnlyScheduleSpec": {
             "type": "object",
             "properties": {
@@ -2771,6 +3279,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "nodeChaos": {
+                    "description": "+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NodeChaosSpec"
+                        }
+                    ]
+                },
                 "physicalmachineChaos": {
                     "description": "+optional",
                     "allOf": [
@@ -2858,22 +3374,123 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DAGDependency": {
+            "type": "object",
+            "properties": {
+                "outcome": {
+                    "description": "Outcome is the required outcome of the task depended on.\n+optional\n+kubebuilder:validation:Enum=Succeeded;Failed;Aborted\n+kubebuilder:default=Succeeded",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DAGTaskPhase"
+                        }
+                    ]
+                },
+                "task": {
+                    "description": "Task is the name of the task depended on.",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DAGSpec": {
+            "type": "object",
+            "properties": {
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DAGTask"
+                    }
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DAGTask": {
+            "type": "object",
+            "properties": {
+                "dependencies": {
+                    "description": "Dependencies are the tasks which should finish before this task starts.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DAGDependency"
+                    }
+                },
+                "name": {
+                    "description": "Name is the name of the task, it should be unique in the DAG.",
+                    "type": "string"
+                },
+                "template": {
+                    "description": "Template is the name of the template to run.",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DAGTaskPhase": {
+            "type": "string",
+            "enum": [
+                "Waiting",
+                "Running",
+                "Succeeded",
+                "Failed",
+                "Aborted",
+                "Skipped"
+            ],
+            "x-enum-varnames": [
+                "DAGTaskWaiting",
+                "DAGTaskRunning",
+                "DAGTaskSucceeded",
+                "DAGTaskFailed",
+                "DAGTaskAborted",
+                "DAGTaskSkipped"
+            ]
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DNSChaosAction": {
             "type": "string",
             "enum": [
                 "error",
-                "random"
+                "random",
+                "nxdomain",
+                "servfail",
+                "refused",
+                "delay",
+                "timeout",
+                "truncate",
+                "fixed"
             ],
             "x-enum-varnames": [
                 "ErrorAction",
-                "RandomAction"
+                "RandomAction",
+                "DNSNXDomainAction",
+                "DNSServFailAction",
+                "DNSRefusedAction",
+                "DNSDelayAction",
+                "DNSTimeoutAction",
+                "DNSTruncateAction",
+                "DNSFixedAction"
             ]
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DNSChaosRule": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "description": "Answers specifies the IPv4 or IPv6 addresses responded for the fixed action.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "delay": {
+                    "description": "Delay specifies the latency before the DNS request is resolved for the delay action,\nsuch as \"100ms\" or \"2s\".\n+optional",
+                    "type": "string"
+                },
+                "pattern": {
+                    "description": "Pattern is the domain name pattern, which has the same syntax as the patterns.",
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DNSChaosSpec": {
             "type": "object",
             "properties": {
                 "action": {
-                    "description": "Action defines the specific DNS chaos action.\nSupported action: error, random\nDefault action: error\n+kubebuilder:validation:Enum=error;random",
+                    "description": "Action defines the specific DNS chaos action.\nSupported action: error, random, nxdomain, servfail, refused, delay, timeout, truncate, fixed\nDefault action: error\n+kubebuilder:validation:Enum=error;random;nxdomain;servfail;refused;delay;timeout;truncate;fixed",
                     "allOf": [
                         {
                             "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DNSChaosAction"
@@ -2910,6 +3527,13 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "rules": {
+                    "description": "Rules specifies the domain name patterns together with the parameters of the action.\nThe delay action requires the delay of each rule, and the fixed action requires the\nanswers of each rule. Rules are not supported by the error and random actions.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DNSChaosRule"
+                    }
+                },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "allOf": [
@@ -2981,6 +3605,19 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DiskFillVolumeSpec": {
+            "type": "object",
+            "properties": {
+                "size": {
+                    "description": "Size is the amount of data to allocate, e.g. \"512Mi\", \"1Gi\".\n+optional",
+                    "type": "string"
+                },
+                "usagePercent": {
+                    "description": "UsagePercent is the target usage of the volume in percent of its\ncapacity. Data is allocated until the usage reaches it, and nothing\nis allocated if the usage is already higher.\n+optional\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=100",
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DiskPayloadSpec": {
             "type": "object",
             "properties": {
@@ -3022,7 +3659,7 @@ const docTemplate = `{
                     }
                 },
                 "failtype": {
-                    "description": "FailType indicates what to fail, can be set to '0' / '1' / '2'\nIf ` + "`" + `0` + "`" + `, indicates slab to fail (should_failslab)\nIf ` + "`" + `1` + "`" + `, indicates alloc_page to fail (should_fail_alloc_page)\nIf ` + "`" + `2` + "`" + `, indicates bio to fail (should_fail_bio)\nYou can read:\n  1. https://www.kernel.org/doc/html/latest/fault-injection/fault-injection.html\n  2. http://github.com/iovisor/bcc/blob/master/tools/inject_example.txt\nto learn more\n+kubebuilder:validation:Maximum=2\n+kubebuilder:validation:Minimum=0",
+                    "description": "FailType indicates what to fail, can be set to '0' / '1' / '2'\nIf ` + "`" + `0` + "`" + `, indicates slab to fail (should_failslab)\nIf ` + "`" + `1` + "`" + `, indicates alloc_page to fail (should_fail_alloc_page)\nIf ` + "`" + `2` + "`" + `, indicates bio to fail (should_fail_bio)\nYou can read:\n  1. https://www.kernel.org/doc/html/latest/fault-injection/fault-injection.html\n  2. http://github.com/iovisor/bcc/blob/master/tools/inject_example.txt\nto learn more\nIt's ignored if the Preset is set.\n+optional\n+kubebuilder:validation:Maximum=2\n+kubebuilder:validation:Minimum=0",
                     "type": "integer"
                 },
                 "headers": {
@@ -3032,6 +3669,14 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "preset": {
+                    "description": "Preset is a named kernel fault, which is compiled into the FailType, Headers and Callchain,\nso they should be omitted if the Preset is set.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.KernelFaultPreset"
+                        }
+                    ]
+                },
                 "probability": {
                     "description": "Probability indicates the fails with probability.\nIf you want 1%, please set this field with 1.\n+kubebuilder:validation:Minimum=0\n+kubebuilder:validation:Maximum=100",
                     "type": "integer"
@@ -3164,6 +3809,19 @@ const docTemplate = `{
                 "Random"
             ]
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ForkStressor": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "description": "Max specifies the number of child processes created by each worker at once, default is 1.\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=16000\n+optional",
+                    "type": "integer"
+                },
+                "workers": {
+                    "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Frame": {
             "type": "object",
             "properties": {
@@ -3181,6 +3839,17 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.FreezeMethod": {
+            "type": "string",
+            "enum": [
+                "cgroup",
+                "sigstop"
+            ],
+            "x-enum-varnames": [
+                "CgroupFreezeMethod",
+                "SigstopFreezeMethod"
+            ]
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.GCPChaosAction": {
             "type": "string",
             "enum": [
@@ -3238,6 +3907,27 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.HDDStressor": {
+            "type": "object",
+            "properties": {
+                "bytes": {
+                    "description": "Bytes specifies N bytes written per hdd worker, default is 1GB.\nOne can specify the size as % of free space on the file system or in units of B, KB/KiB,\nMB/MiB, GB/GiB, TB/TiB.\n+optional",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path specifies the directory inside the container to write the temporary files,\ndefault is the working directory of stress-ng.\n+optional",
+                    "type": "string"
+                },
+                "workers": {
+                    "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
+                    "type": "integer"
+                },
+                "writeSize": {
+                    "description": "WriteSize specifies the size of each write in bytes, default is 64KB.\nThe size should be in units of B, KB/KiB, MB/MiB.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.HTTPAbortSpec": {
             "type": "object",
             "properties": {
@@ -3403,26 +4093,74 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "method": {
-                    "description": "HTTP method",
+                    "description": "HTTP method",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Match path of Uri with wildcard matches",
+                    "type": "string"
+                },
+                "port": {
+                    "description": "The TCP port that the target service listens on",
+                    "type": "integer"
+                },
+                "proxy_ports": {
+                    "description": "Composed with one of the port of HTTP connection, we will only attack HTTP connection with port inside proxy_ports",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "target": {
+                    "description": "HTTP target: Request or Response",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.HTTPRequestNodeSpec": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "criteria": {
+                    "description": "Criteria defines the expected status code of the response, the node is failed if it's not satisfied.\nAny response is accepted if it's omitted. The node is always failed if the request could not be sent.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.HTTPCriteria"
+                        }
+                    ]
+                },
+                "followLocation": {
+                    "description": "FollowLocation follows the redirections of the response.\n+optional",
+                    "type": "boolean"
+                },
+                "headers": {
+                    "description": "+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/http.Header"
+                        }
+                    ]
+                },
+                "method": {
+                    "description": "+optional\n+kubebuilder:validation:Enum=GET;HEAD;POST;PUT;PATCH;DELETE;OPTIONS\n+kubebuilder:default=GET",
                     "type": "string"
                 },
-                "path": {
-                    "description": "Match path of Uri with wildcard matches",
+                "timeout": {
+                    "description": "Timeout of the request, such as 10s, the default value is 30s, and the max value is 1m.\n+optional",
                     "type": "string"
                 },
-                "port": {
-                    "description": "The TCP port that the target service listens on",
-                    "type": "integer"
-                },
-                "proxy_ports": {
-                    "description": "Composed with one of the port of HTTP connection, we will only attack HTTP connection with port inside proxy_ports",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "tls": {
+                    "description": "+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.HTTPRequestTLS"
+                        }
+                    ]
                 },
-                "target": {
-                    "description": "HTTP target: Request or Response",
+                "url": {
                     "type": "string"
                 }
             }
@@ -3444,6 +4182,23 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.HTTPRequestTLS": {
+            "type": "object",
+            "properties": {
+                "insecureSkipVerify": {
+                    "description": "+optional",
+                    "type": "boolean"
+                },
+                "secretName": {
+                    "description": "SecretName is the name of the secret in the namespace of the workflow, the CA is read from the key ca.crt,\nand the client certificate is read from the keys tls.crt and tls.key. The creator of the workflow should\nbe able to get the secret.\n+optional",
+                    "type": "string"
+                },
+                "serverName": {
+                    "description": "ServerName overrides the server name used to verify the certificate of the server.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.HTTPStatusCheck": {
             "type": "object",
             "properties": {
@@ -3480,7 +4235,7 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "action": {
-                    "description": "Action defines the specific pod chaos action.\nSupported action: latency / fault / attrOverride / mistake\n+kubebuilder:validation:Enum=latency;fault;attrOverride;mistake",
+                    "description": "Action defines the specific pod chaos action.\nSupported action: latency / fault / attrOverride / mistake / shortRW / diskFill\n+kubebuilder:validation:Enum=latency;fault;attrOverride;mistake;shortRW;diskFill",
                     "allOf": [
                         {
                             "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.IOChaosType"
@@ -3506,6 +4261,14 @@ const docTemplate = `{
                     "description": "Delay defines the value of I/O chaos action delay.\nA delay string is a possibly signed sequence of\ndecimal numbers, each with optional fraction and a unit suffix,\nsuch as \"300ms\".\nValid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\n+ui:form:when=action=='latency'\n+optional",
                     "type": "string"
                 },
+                "diskFill": {
+                    "description": "DiskFill defines how much data is allocated in the volume.\nThe allocated file is placed under the VolumePath, and will be removed\nwhen the chaos is recovered.\n+ui:form:when=action=='diskFill'\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DiskFillVolumeSpec"
+                        }
+                    ]
+                },
                 "duration": {
                     "description": "Duration represents the duration of the chaos action.\nIt is required when the action is ` + "`" + `PodFailureAction` + "`" + `.\nA duration string is a possibly signed sequence of\ndecimal numbers, each with optional fraction and a unit suffix,\nsuch as \"300ms\", \"-1.5h\" or \"2h45m\".\nValid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\n+optional",
                     "type": "string"
@@ -3557,6 +4320,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "shortRW": {
+                    "description": "ShortRW defines how the length of read or write results is truncated.\nIt only takes effect on ` + "`" + `read` + "`" + ` and ` + "`" + `write` + "`" + ` methods, which cover\nread/pread and write/pwrite of the target process.\nThe action is passed through to toda, and it's rejected by the webhook\nuntil the toda shipped with chaos-daemon implements it.\n+ui:form:when=action=='shortRW'\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ShortRWSpec"
+                        }
+                    ]
+                },
                 "value": {
                     "description": "Value is required when the mode is set to ` + "`" + `FixedMode` + "`" + ` / ` + "`" + `FixedPercentMode` + "`" + ` / ` + "`" + `RandomMaxPercentMode` + "`" + `.\nIf ` + "`" + `FixedMode` + "`" + `, provide an integer of pods to do chaos action.\nIf ` + "`" + `FixedPercentMode` + "`" + `, provide a number from 0-100 to specify the percent of pods the server can do chaos action.\nIF ` + "`" + `RandomMaxPercentMode` + "`" + `,  provide a number from 0-100 to specify the max percent of pods to do chaos action\n+optional",
                     "type": "string"
@@ -3573,15 +4344,36 @@ const docTemplate = `{
                 "latency",
                 "fault",
                 "attrOverride",
-                "mistake"
+                "mistake",
+                "shortRW",
+                "diskFill"
             ],
             "x-enum-varnames": [
                 "IoLatency",
                 "IoFaults",
                 "IoAttrOverride",
-                "IoMistake"
+                "IoMistake",
+                "IoShortRW",
+                "IoDiskFill"
             ]
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.IOMixStressor": {
+            "type": "object",
+            "properties": {
+                "bytes": {
+                    "description": "Bytes specifies N bytes written per iomix worker, default is 1GB.\nOne can specify the size as % of free space on the file system or in units of B, KB/KiB,\nMB/MiB, GB/GiB, TB/TiB.\n+optional",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path specifies the directory inside the container to write the temporary files,\ndefault is the working directory of stress-ng.\n+optional",
+                    "type": "string"
+                },
+                "workers": {
+                    "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.IoMethod": {
             "type": "string",
             "enum": [
@@ -3662,7 +4454,14 @@ const docTemplate = `{
                 "stress",
                 "gc",
                 "ruleData",
-                "mysql"
+                "mysql",
+                "threadPool",
+                "monitorHold",
+                "heapPressure",
+                "jdbc",
+                "kafka",
+                "redis",
+                "httpClient"
             ],
             "x-enum-varnames": [
                 "JVMLatencyAction",
@@ -3671,14 +4470,21 @@ const docTemplate = `{
                 "JVMStressAction",
                 "JVMGCAction",
                 "JVMRuleDataAction",
-                "JVMMySQLAction"
+                "JVMMySQLAction",
+                "JVMThreadPoolAction",
+                "JVMMonitorHoldAction",
+                "JVMHeapPressureAction",
+                "JVMJDBCAction",
+                "JVMKafkaAction",
+                "JVMRedisAction",
+                "JVMHTTPClientAction"
             ]
         },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.JVMChaosSpec": {
             "type": "object",
             "properties": {
                 "action": {
-                    "description": "Action defines the specific jvm chaos action.\nSupported action: latency;return;exception;stress;gc;ruleData\n+kubebuilder:validation:Enum=latency;return;exception;stress;gc;ruleData;mysql",
+                    "description": "Action defines the specific jvm chaos action.\nSupported action: latency;return;exception;stress;gc;ruleData;mysql;threadPool;monitorHold;heapPressure;jdbc;kafka;redis;httpClient\n+kubebuilder:validation:Enum=latency;return;exception;stress;gc;ruleData;mysql;threadPool;monitorHold;heapPressure;jdbc;kafka;redis;httpClient",
                     "allOf": [
                         {
                             "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.JVMChaosAction"
@@ -3709,11 +4515,27 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "exception": {
-                    "description": "the exception which needs to throw for action ` + "`" + `exception` + "`" + `\nor the exception message needs to throw in action ` + "`" + `mysql` + "`" + `\n+optional",
+                    "description": "the exception which needs to throw for action ` + "`" + `exception` + "`" + `\nor the exception message needs to throw in action ` + "`" + `mysql` + "`" + `, ` + "`" + `jdbc` + "`" + `, ` + "`" + `kafka` + "`" + `, ` + "`" + `redis` + "`" + ` and ` + "`" + `httpClient` + "`" + `\n+optional",
+                    "type": "string"
+                },
+                "heapOccupancy": {
+                    "description": "the target occupancy of the max heap in percent, only set it when action is heapPressure\n+optional\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=100",
+                    "type": "integer"
+                },
+                "host": {
+                    "description": "the match host of the requests\ndefault value is \"\", means match all hosts\n+optional",
+                    "type": "string"
+                },
+                "httpClient": {
+                    "description": "the http client to inject fault into, the value can be 'okhttp' or 'apache'\n+optional",
+                    "type": "string"
+                },
+                "kafkaClient": {
+                    "description": "the kafka client to inject fault into, the value can be 'producer' or 'consumer'\n+optional",
                     "type": "string"
                 },
                 "latency": {
-                    "description": "the latency duration for action 'latency', unit ms\nor the latency duration in action ` + "`" + `mysql` + "`" + `\n+optional",
+                    "description": "the latency duration for action 'latency', unit ms\nor the latency duration in action ` + "`" + `mysql` + "`" + `, ` + "`" + `jdbc` + "`" + `, ` + "`" + `kafka` + "`" + `, ` + "`" + `redis` + "`" + ` and ` + "`" + `httpClient` + "`" + `\nor the duration to hold the threads, the monitor or the heap in action ` + "`" + `threadPool` + "`" + `, ` + "`" + `monitorHold` + "`" + ` and ` + "`" + `heapPressure` + "`" + `\n+optional",
                     "type": "integer"
                 },
                 "memType": {
@@ -3721,7 +4543,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "method": {
-                    "description": "the method in Java class\n+optional",
+                    "description": "the method in Java class, it should be a synchronized method in action ` + "`" + `monitorHold` + "`" + `,\notherwise no monitor is held and only the latency is injected\n+optional",
                     "type": "string"
                 },
                 "mode": {
@@ -3748,6 +4570,14 @@ const docTemplate = `{
                     "description": "the port of agent server, default 9277\n+optional",
                     "type": "integer"
                 },
+                "redisClient": {
+                    "description": "the redis client to inject fault into, the value can be 'jedis' or 'lettuce'\n+optional",
+                    "type": "string"
+                },
+                "redisCommand": {
+                    "description": "the match redis command, for example 'GET'\ndefault value is \"\", means match all commands\n+optional",
+                    "type": "string"
+                },
                 "remoteCluster": {
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
@@ -3776,6 +4606,22 @@ const docTemplate = `{
                     "description": "the match table\ndefault value is \"\", means match all table",
                     "type": "string"
                 },
+                "threadCount": {
+                    "description": "the number of the threads to block, only set it when action is threadPool\n+optional",
+                    "type": "integer"
+                },
+                "threadNamePrefix": {
+                    "description": "the name prefix of the threads in the executor, only set it when action is threadPool\n+optional",
+                    "type": "string"
+                },
+                "topic": {
+                    "description": "the match topic\ndefault value is \"\", means match all topics\n+optional",
+                    "type": "string"
+                },
+                "transformTimeout": {
+                    "description": "the max time to wait for the byteman rules to be injected into the target classes,\nthe injection fails if any rule isn't injected in time, default 10s.\nSet it to 0s to skip waiting, e.g. if the target class is loaded lazily, then the\ninjection only fails if any rule fails to compile\n+optional",
+                    "type": "string"
+                },
                 "value": {
                     "description": "Value is required when the mode is set to ` + "`" + `FixedMode` + "`" + ` / ` + "`" + `FixedPercentMode` + "`" + ` / ` + "`" + `RandomMaxPercentMode` + "`" + `.\nIf ` + "`" + `FixedMode` + "`" + `, provide an integer of pods to do chaos action.\nIf ` + "`" + `FixedPercentMode` + "`" + `, provide a number from 0-100 to specify the percent of pods the server can do chaos action.\nIF ` + "`" + `RandomMaxPercentMode` + "`" + `,  provide a number from 0-100 to specify the max percent of pods to do chaos action\n+optional",
                     "type": "string"
@@ -3794,7 +4640,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "method": {
-                    "description": "the method in Java class\n+optional",
+                    "description": "the method in Java class, it should be a synchronized method in action ` + "`" + `monitorHold` + "`" + `,\notherwise no monitor is held and only the latency is injected\n+optional",
                     "type": "string"
                 },
                 "pid": {
@@ -3832,7 +4678,7 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "method": {
-                    "description": "the method in Java class\n+optional",
+                    "description": "the method in Java class, it should be a synchronized method in action ` + "`" + `monitorHold` + "`" + `,\notherwise no monitor is held and only the latency is injected\n+optional",
                     "type": "string"
                 },
                 "pid": {
@@ -3853,7 +4699,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "method": {
-                    "description": "the method in Java class\n+optional",
+                    "description": "the method in Java class, it should be a synchronized method in action ` + "`" + `monitorHold` + "`" + `,\notherwise no monitor is held and only the latency is injected\n+optional",
                     "type": "string"
                 },
                 "pid": {
@@ -3908,6 +4754,98 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sApplySpec": {
+            "type": "object",
+            "properties": {
+                "manifest": {
+                    "description": "Manifest is the YAML or JSON manifest of the resource, it's applied with server-side apply, so the resource is\ncreated if it does not exist.\n+optional",
+                    "type": "string"
+                },
+                "patch": {
+                    "description": "Patch is applied to an existing resource.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sPatch"
+                        }
+                    ]
+                },
+                "revert": {
+                    "description": "Revert restores the resource to the state before applying when the workflow is accomplished or deleted, the\nresource is deleted if it's created by this node. The resource is not reverted if it has been deleted or\nrecreated by others, otherwise the changes made by others after applying are overridden.\n+optional",
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sPatch": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Data is the YAML or JSON content of the patch.",
+                    "type": "string"
+                },
+                "target": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sResourceReference"
+                },
+                "type": {
+                    "description": "+optional\n+kubebuilder:validation:Enum=Merge;StrategicMerge;JSON\n+kubebuilder:default=StrategicMerge",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sPatchType"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sPatchType": {
+            "type": "string",
+            "enum": [
+                "Merge",
+                "StrategicMerge",
+                "JSON"
+            ],
+            "x-enum-varnames": [
+                "K8sPatchTypeMerge",
+                "K8sPatchTypeStrategicMerge",
+                "K8sPatchTypeJSON"
+            ]
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sResourceReference": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace of the resource, the namespace of the workflow is used if it's omitted for a namespaced resource.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sWaitSpec": {
+            "type": "object",
+            "properties": {
+                "interval": {
+                    "description": "Interval of polling the resource, such as 10s, the default value is 5s.\n+optional",
+                    "type": "string"
+                },
+                "jsonPath": {
+                    "description": "JSONPath is evaluated against the resource, such as {.status.succeeded}, the braces could be omitted.",
+                    "type": "string"
+                },
+                "target": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sResourceReference"
+                },
+                "value": {
+                    "description": "Value is the expected result of JSONPath. If it's omitted, the condition is met when the result is not empty.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.KafkaFillSpec": {
             "type": "object",
             "properties": {
@@ -4047,6 +4985,56 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.KernelFaultPreset": {
+            "type": "object",
+            "properties": {
+                "device": {
+                    "description": "Device is the ` + "`" + `major:minor` + "`" + ` number of the disk or the partition whose io requests fail, such as ` + "`" + `8:0` + "`" + `.\nIt's required by bio-failure, which requires linux 5.12 or later.\n+optional",
+                    "type": "string"
+                },
+                "syscall": {
+                    "description": "Syscall is the name of the syscall in whose call chain the allocations fail, such as ` + "`" + `mount` + "`" + `.\nIt's required by slab-failure and optional for page-alloc-failure.\nThe syscall is hooked at its entry of the architecture of the node, such as ` + "`" + `__x64_sys_\u003csyscall\u003e` + "`" + ` on\namd64 and ` + "`" + `__arm64_sys_\u003csyscall\u003e` + "`" + ` on arm64, the other architectures are not supported.\n+optional",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type is the type of the kernel fault.\nSupported type: slab-failure / page-alloc-failure / bio-failure\n+kubebuilder:validation:Enum=slab-failure;page-alloc-failure;bio-failure",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.KernelFaultPresetType"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.KernelFaultPresetType": {
+            "type": "string",
+            "enum": [
+                "slab-failure",
+                "page-alloc-failure",
+                "bio-failure"
+            ],
+            "x-enum-varnames": [
+                "SlabFailurePreset",
+                "PageAllocFailurePreset",
+                "BIOFailurePreset"
+            ]
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.LoopSpec": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Count is the number of iterations.\n+optional\n+kubebuilder:validation:Minimum=1",
+                    "type": "integer"
+                },
+                "withItems": {
+                    "description": "WithItems is the list of items to iterate over, each iteration takes one of them.\nThe references {{item}} and {{index}} in the templates under the loop node are replaced with the item\nand the index of the iteration, they are also passed to the Task nodes with the environment variables\nCHAOS_MESH_LOOP_ITEM and CHAOS_MESH_LOOP_INDEX.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.LossSpec": {
             "type": "object",
             "properties": {
@@ -4063,6 +5051,10 @@ const docTemplate = `{
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.MemoryStressor": {
             "type": "object",
             "properties": {
+                "limitPercent": {
+                    "description": "LimitPercent specifies the bytes consumed by all workers as a percentage of the memory\nlimit of the target container, which is memory.max in cgroup v2 or memory.limit_in_bytes\nin cgroup v1. It cannot be used together with Size, and the target container must have a\nmemory limit.\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=100\n+optional",
+                    "type": "integer"
+                },
                 "oomScoreAdj": {
                     "description": "OOMScoreAdj sets the oom_score_adj of the stress process. See ` + "`" + `man 5 proc` + "`" + ` to know more\nabout this option.\n+kubebuilder:validation:Minimum=-1000\n+kubebuilder:validation:Maximum=1000\n+kubebuilder:default=0\n+optional",
                     "type": "integer"
@@ -4078,6 +5070,10 @@ const docTemplate = `{
                     "description": "Size specifies N bytes consumed per vm worker, default is the total available memory.\nOne can specify the size as % of total available memory or in units of B, KB/KiB,\nMB/MiB, GB/GiB, TB/TiB.\n+optional",
                     "type": "string"
                 },
+                "triggerOOM": {
+                    "description": "TriggerOOM pushes the target container to be OOM-killed. The stress process allocates\nthe whole memory limit of the container unless LimitPercent is specified, and its\noom_score_adj is lowered so that the OOM killer picks the application process instead\nof the stressor. It cannot be used together with OOMScoreAdj.\n+optional",
+                    "type": "boolean"
+                },
                 "workers": {
                     "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
                     "type": "integer"
@@ -4478,42 +5474,154 @@ const docTemplate = `{
                     "description": "only impact traffic using this IP protocol, supported: tcp, udp, icmp, all",
                     "type": "string"
                 },
-                "percent": {
-                    "description": "percentage of packets to loss (10 is 10%)",
-                    "type": "string"
+                "percent": {
+                    "description": "percentage of packets to loss (10 is 10%)",
+                    "type": "string"
+                },
+                "source-port": {
+                    "description": "only impact egress traffic from these source ports, use a ',' to separate or to indicate the range, such as 80, 8001:8010.\nit can only be used in conjunction with -p tcp or -p udp",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NetworkPartitionSpec": {
+            "type": "object",
+            "properties": {
+                "accept-tcp-flags": {
+                    "description": "only the packet which match the tcp flag can be accepted, others will be dropped.\nonly set when the IPProtocol is tcp, used for partition.",
+                    "type": "string"
+                },
+                "device": {
+                    "description": "the network interface to impact",
+                    "type": "string"
+                },
+                "direction": {
+                    "description": "specifies the partition direction, values can be 'from', 'to'.\n'from' means packets coming from the 'IPAddress' or 'Hostname' and going to your server,\n'to' means packets originating from your server and going to the 'IPAddress' or 'Hostname'.",
+                    "type": "string"
+                },
+                "hostname": {
+                    "description": "only impact traffic to these hostnames",
+                    "type": "string"
+                },
+                "ip-address": {
+                    "description": "only impact egress traffic to these IP addresses",
+                    "type": "string"
+                },
+                "ip-protocol": {
+                    "description": "only impact egress traffic to these IP addresses",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NodeChaosAction": {
+            "type": "string",
+            "enum": [
+                "drain",
+                "taint",
+                "kubelet-stop"
+            ],
+            "x-enum-varnames": [
+                "NodeDrainAction",
+                "NodeTaintAction",
+                "KubeletStopAction"
+            ]
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NodeChaosSpec": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action defines the specific node chaos action.\nSupported action: drain / taint / kubelet-stop.\nOnly kubelet-stop makes the node NotReady.\n+kubebuilder:validation:Enum=drain;taint;kubelet-stop",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NodeChaosAction"
+                        }
+                    ]
+                },
+                "duration": {
+                    "description": "Duration represents the duration of the chaos action.\nIt is required when the action is ` + "`" + `KubeletStopAction` + "`" + `.\n+optional",
+                    "type": "string"
+                },
+                "gracePeriod": {
+                    "description": "GracePeriod is used in drain action. It represents the duration in seconds given to the evicted pods to terminate.\nThe default value is -1 that indicates using the termination grace period of the pods.\n+optional\n+kubebuilder:validation:Minimum=-1",
+                    "type": "integer"
+                },
+                "mode": {
+                    "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SelectorMode"
+                        }
+                    ]
+                },
+                "remoteCluster": {
+                    "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
+                    "type": "string"
+                },
+                "selector": {
+                    "description": "Selector is used to select nodes that are used to inject chaos action.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NodeSelectorSpec"
+                        }
+                    ]
+                },
+                "taint": {
+                    "description": "Taint is the taint added to the node. It is required when the action is ` + "`" + `NodeTaintAction` + "`" + `.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.Taint"
+                        }
+                    ]
                 },
-                "source-port": {
-                    "description": "only impact egress traffic from these source ports, use a ',' to separate or to indicate the range, such as 80, 8001:8010.\nit can only be used in conjunction with -p tcp or -p udp",
+                "value": {
+                    "description": "Value is required when the mode is set to ` + "`" + `FixedMode` + "`" + ` / ` + "`" + `FixedPercentMode` + "`" + ` / ` + "`" + `RandomMaxPercentMode` + "`" + `.\nIf ` + "`" + `FixedMode` + "`" + `, provide an integer of nodes to do chaos action.\nIf ` + "`" + `FixedPercentMode` + "`" + `, provide a number from 0-100 to specify the percent of nodes the server can do chaos action.\nIF ` + "`" + `RandomMaxPercentMode` + "`" + `,  provide a number from 0-100 to specify the max percent of nodes to do chaos action\n+optional",
                     "type": "string"
                 }
             }
         },
-        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NetworkPartitionSpec": {
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NodeOutput": {
             "type": "object",
             "properties": {
-                "accept-tcp-flags": {
-                    "description": "only the packet which match the tcp flag can be accepted, others will be dropped.\nonly set when the IPProtocol is tcp, used for partition.",
+                "file": {
+                    "description": "File is the absolute path of the file written by the container of Task, the content of the file is the value.\nThe file is collected as the termination message of the container, so at most one output of a Task\ncould come from file, and the content is limited to 4096 bytes.\n+optional",
                     "type": "string"
                 },
-                "device": {
-                    "description": "the network interface to impact",
+                "name": {
                     "type": "string"
                 },
-                "direction": {
-                    "description": "specifies the partition direction, values can be 'from', 'to'.\n'from' means packets coming from the 'IPAddress' or 'Hostname' and going to your server,\n'to' means packets originating from your server and going to the 'IPAddress' or 'Hostname'.",
-                    "type": "string"
+                "statusCheckResult": {
+                    "description": "StatusCheckResult takes the result of StatusCheck as the value, which is Success or Failure.\n+optional",
+                    "type": "boolean"
                 },
-                "hostname": {
-                    "description": "only impact traffic to these hostnames",
+                "stdoutField": {
+                    "description": "StdoutField is the dot-separated path of the field in the stdout of Task, the stdout should be a JSON object.\n+optional",
                     "type": "string"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NodeSelectorSpec": {
+            "type": "object",
+            "properties": {
+                "expressionSelectors": {
+                    "description": "a slice of label selector expressions that can be used to select nodes.\nA list of selectors based on set-based label expressions.\n+ui:form:ignore\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LabelSelectorRequirement"
+                    }
                 },
-                "ip-address": {
-                    "description": "only impact egress traffic to these IP addresses",
-                    "type": "string"
+                "labelSelectors": {
+                    "description": "Map of string keys and values that can be used to select nodes.\nA selector based on labels.\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "ip-protocol": {
-                    "description": "only impact egress traffic to these IP addresses",
-                    "type": "string"
+                "nodes": {
+                    "description": "Nodes is a set of node names.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -4992,24 +6100,45 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.PipeStressor": {
+            "type": "object",
+            "properties": {
+                "dataSize": {
+                    "description": "DataSize specifies the size in bytes of each write to the pipe, default is 512.\n+kubebuilder:validation:Minimum=4\n+kubebuilder:validation:Maximum=4096\n+optional",
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "Size specifies the size of the pipe buffer, default is decided by the kernel.\nThe size should be in units of B, KB/KiB, MB/MiB.\n+optional",
+                    "type": "string"
+                },
+                "workers": {
+                    "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.PodChaosAction": {
             "type": "string",
             "enum": [
                 "pod-kill",
                 "pod-failure",
-                "container-kill"
+                "container-kill",
+                "container-freeze",
+                "container-crash-loop"
             ],
             "x-enum-varnames": [
                 "PodKillAction",
                 "PodFailureAction",
-                "ContainerKillAction"
+                "ContainerKillAction",
+                "ContainerFreezeAction",
+                "ContainerCrashLoopAction"
             ]
         },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.PodChaosSpec": {
             "type": "object",
             "properties": {
                 "action": {
-                    "description": "Action defines the specific pod chaos action.\nSupported action: pod-kill / pod-failure / container-kill\nDefault action: pod-kill\n+kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill",
+                    "description": "Action defines the specific pod chaos action.\nSupported action: pod-kill / pod-failure / container-kill / container-freeze / container-crash-loop\nDefault action: pod-kill\n+kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill;container-freeze;container-crash-loop",
                     "allOf": [
                         {
                             "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.PodChaosAction"
@@ -5023,12 +6152,24 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "crashLoopInterval": {
+                    "description": "CrashLoopInterval is used in container-crash-loop action. It represents how long the restarted container\nkeeps running before it is killed again.\n+optional",
+                    "type": "string"
+                },
                 "duration": {
                     "description": "Duration represents the duration of the chaos action.\nIt is required when the action is ` + "`" + `PodFailureAction` + "`" + `.\nA duration string is a possibly signed sequence of\ndecimal numbers, each with optional fraction and a unit suffix,\nsuch as \"300ms\", \"-1.5h\" or \"2h45m\".\nValid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\n+optional",
                     "type": "string"
                 },
+                "freezeMethod": {
+                    "description": "FreezeMethod is used in container-freeze action. It represents the way to freeze the processes of the container.\nSupported method: cgroup / sigstop\nDefault method: cgroup\n+optional\n+kubebuilder:validation:Enum=cgroup;sigstop",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.FreezeMethod"
+                        }
+                    ]
+                },
                 "gracePeriod": {
-                    "description": "GracePeriod is used in pod-kill action. It represents the duration in seconds before the pod should be deleted.\nValue must be non-negative integer. The default value is zero that indicates delete immediately.\n+optional\n+kubebuilder:validation:Minimum=0",
+                    "description": "GracePeriod is used in pod-kill and container-kill action. It represents the duration in seconds before the pod should be deleted,\nor before the container is killed with SIGKILL after it receives the Signal.\nValue must be non-negative integer. The default value is zero that indicates delete immediately.\n+optional\n+kubebuilder:validation:Minimum=0",
                     "type": "integer"
                 },
                 "mode": {
@@ -5051,6 +6192,10 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "signal": {
+                    "description": "Signal is used in container-kill action. It represents the signal sent to the main process of the container.\nThe default value is SIGKILL. The injection fails if the main process would ignore the signal, e.g. it's the\ninit process of the pid namespace and has no handler for the signal.\n+optional\n+kubebuilder:validation:Enum=SIGKILL;SIGTERM;SIGINT;SIGQUIT;SIGHUP;SIGABRT;SIGUSR1;SIGUSR2",
+                    "type": "string"
+                },
                 "value": {
                     "description": "Value is required when the mode is set to ` + "`" + `FixedMode` + "`" + ` / ` + "`" + `FixedPercentMode` + "`" + ` / ` + "`" + `RandomMaxPercentMode` + "`" + `.\nIf ` + "`" + `FixedMode` + "`" + `, provide an integer of pods to do chaos action.\nIf ` + "`" + `FixedPercentMode` + "`" + `, provide a number from 0-100 to specify the percent of pods the server can do chaos action.\nIF ` + "`" + `RandomMaxPercentMode` + "`" + `,  provide a number from 0-100 to specify the max percent of pods to do chaos action\n+optional",
                     "type": "string"
@@ -5428,6 +6573,23 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.RetrySpec": {
+            "type": "object",
+            "properties": {
+                "backoff": {
+                    "description": "Backoff is the duration to wait before the first retry, such as 10s.\nIt's doubled for each of the following retries. No waiting if it's omitted.\n+optional",
+                    "type": "string"
+                },
+                "limit": {
+                    "description": "Limit is the max number of retries after the first attempt.\n+kubebuilder:validation:Minimum=1",
+                    "type": "integer"
+                },
+                "maxBackoff": {
+                    "description": "MaxBackoff is the max duration to wait before a retry.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Schedule": {
             "type": "object",
             "properties": {
@@ -5546,6 +6708,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "nodeChaos": {
+                    "description": "+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NodeChaosSpec"
+                        }
+                    ]
+                },
                 "physicalmachineChaos": {
                     "description": "+optional",
                     "allOf": [
@@ -5627,6 +6797,7 @@ const docTemplate = `{
                 "JVMChaos",
                 "KernelChaos",
                 "NetworkChaos",
+                "NodeChaos",
                 "PhysicalMachineChaos",
                 "PodChaos",
                 "StressChaos",
@@ -5644,6 +6815,7 @@ const docTemplate = `{
                 "ScheduleTypeJVMChaos",
                 "ScheduleTypeKernelChaos",
                 "ScheduleTypeNetworkChaos",
+                "ScheduleTypeNodeChaos",
                 "ScheduleTypePhysicalMachineChaos",
                 "ScheduleTypePodChaos",
                 "ScheduleTypeStressChaos",
@@ -5668,6 +6840,72 @@ const docTemplate = `{
                 "RandomMaxPercentMode"
             ]
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ShortRWSpec": {
+            "type": "object",
+            "properties": {
+                "ratio": {
+                    "description": "Ratio is the fraction of the requested length which is actually\nread or written, e.g. \"0.5\". It must be in the range (0, 1).\n+optional",
+                    "type": "string"
+                },
+                "size": {
+                    "description": "Size is the max number of bytes which is actually read or written\nby one operation.\n+optional\n+kubebuilder:validation:Minimum=1",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SocketDomain": {
+            "type": "string",
+            "enum": [
+                "ipv4",
+                "ipv6",
+                "unix"
+            ],
+            "x-enum-varnames": [
+                "SocketDomainIPv4",
+                "SocketDomainIPv6",
+                "SocketDomainUnix"
+            ]
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SocketStressor": {
+            "type": "object",
+            "properties": {
+                "domain": {
+                    "description": "Domain specifies the domain of sockets, default is ipv4.\n+kubebuilder:validation:Enum=ipv4;ipv6;unix\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SocketDomain"
+                        }
+                    ]
+                },
+                "port": {
+                    "description": "Port specifies the start port of sockets, default is 5000. Each worker uses\nits own port, from Port to Port + Workers - 1.\n+kubebuilder:validation:Minimum=1024\n+kubebuilder:validation:Maximum=65535\n+optional",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "Type specifies the type of sockets, default is stream.\n+kubebuilder:validation:Enum=stream;seqpacket\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SocketType"
+                        }
+                    ]
+                },
+                "workers": {
+                    "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SocketType": {
+            "type": "string",
+            "enum": [
+                "stream",
+                "seqpacket"
+            ],
+            "x-enum-varnames": [
+                "SocketTypeStream",
+                "SocketTypeSeqpacket"
+            ]
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.StatusCheckMode": {
             "type": "string",
             "enum": [
@@ -5885,6 +7123,14 @@ const docTemplate = `{
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Stressors": {
             "type": "object",
             "properties": {
+                "cache": {
+                    "description": "CacheStressor stresses CPU cache out by thrashing it with reads and writes\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.CacheStressor"
+                        }
+                    ]
+                },
                 "cpu": {
                     "description": "CPUStressor stresses CPU out\n+optional",
                     "allOf": [
@@ -5893,6 +7139,30 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "fork": {
+                    "description": "ForkStressor stresses process creation out by forking and exiting children continually\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ForkStressor"
+                        }
+                    ]
+                },
+                "hdd": {
+                    "description": "HDDStressor stresses disk I/O out by writing, reading and removing temporary files\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.HDDStressor"
+                        }
+                    ]
+                },
+                "iomix": {
+                    "description": "IOMixStressor stresses disk I/O out by a mix of sequential, random and memory mapped read/write operations\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.IOMixStressor"
+                        }
+                    ]
+                },
                 "memory": {
                     "description": "MemoryStressor stresses virtual memory out\n+optional",
                     "allOf": [
@@ -5900,6 +7170,73 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.MemoryStressor"
                         }
                     ]
+                },
+                "pipe": {
+                    "description": "PipeStressor stresses pipe I/O and context switching out\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.PipeStressor"
+                        }
+                    ]
+                },
+                "socket": {
+                    "description": "SocketStressor stresses network stack out by sending and receiving data through sockets\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SocketStressor"
+                        }
+                    ]
+                },
+                "vm": {
+                    "description": "VMStressor stresses virtual memory out with stress-ng vm stressors\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.VMStressor"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SubWorkflowReference": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SubWorkflowSpec": {
+            "type": "object",
+            "properties": {
+                "arguments": {
+                    "description": "Arguments are the values of the parameters declared in the referred WorkflowTemplate.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.WorkflowArgument"
+                    }
+                },
+                "workflow": {
+                    "description": "Workflow is the inline spec of the child workflow, which has the same schema as the spec of Workflow.\n+optional\n+kubebuilder:validation:Schemaless\n+kubebuilder:pruning:PreserveUnknownFields\n+kubebuilder:validation:Type=object",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "workflowRef": {
+                    "description": "WorkflowRef refers to an existing Workflow in the same namespace, the spec of which is copied to the child workflow.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SubWorkflowReference"
+                        }
+                    ]
+                },
+                "workflowTemplateRef": {
+                    "description": "WorkflowTemplateRef refers to the WorkflowTemplate in the same namespace, which the child workflow is instantiated from.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.WorkflowTemplateRef"
+                        }
+                    ]
                 }
             }
         },
@@ -5936,6 +7273,14 @@ const docTemplate = `{
                     "description": "AbortWithStatusCheck describe whether to abort the workflow when the failure threshold of StatusCheck is exceeded.\nOnly used when Type is TypeStatusCheck.\n+optional",
                     "type": "boolean"
                 },
+                "approval": {
+                    "description": "Approval describes the approval node. Only used when Type is TypeApproval.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ApprovalSpec"
+                        }
+                    ]
+                },
                 "awsChaos": {
                     "description": "+optional",
                     "allOf": [
@@ -5961,7 +7306,7 @@ const docTemplate = `{
                     ]
                 },
                 "children": {
-                    "description": "Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial or TypeParallel.\n+optional",
+                    "description": "Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial or TypeParallel.\nLoop and retry node have exactly one child, which is the template to repeat.\n+optional",
                     "type": "array",
                     "items": {
                         "type": "string"
@@ -5974,6 +7319,14 @@ const docTemplate = `{
                         "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ConditionalBranch"
                     }
                 },
+                "dag": {
+                    "description": "DAG describes the tasks and their dependencies of DAG node. Only used when Type is TypeDAG.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.DAGSpec"
+                        }
+                    ]
+                },
                 "deadline": {
                     "description": "+optional",
                     "type": "string"
@@ -6002,6 +7355,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "httpRequest": {
+                    "description": "HTTPRequest describes the HTTP request sent by HTTPRequest node. Only used when Type is TypeHTTPRequest.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.HTTPRequestNodeSpec"
+                        }
+                    ]
+                },
                 "ioChaos": {
                     "description": "+optional",
                     "allOf": [
@@ -6018,6 +7379,22 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "k8sApply": {
+                    "description": "K8sApply describes the resource applied by K8sApply node. Only used when Type is TypeK8sApply.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sApplySpec"
+                        }
+                    ]
+                },
+                "k8sWait": {
+                    "description": "K8sWait describes the condition waited by K8sWait node. Only used when Type is TypeK8sWait.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.K8sWaitSpec"
+                        }
+                    ]
+                },
                 "kernelChaos": {
                     "description": "+optional",
                     "allOf": [
@@ -6026,6 +7403,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "loop": {
+                    "description": "Loop describes the iterations of loop node. Only used when Type is TypeLoop.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.LoopSpec"
+                        }
+                    ]
+                },
                 "name": {
                     "type": "string"
                 },
@@ -6037,6 +7422,21 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "nodeChaos": {
+                    "description": "+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NodeChaosSpec"
+                        }
+                    ]
+                },
+                "outputs": {
+                    "description": "Outputs declares the values produced by Task or StatusCheck node. They could be referred with\n{{outputs.\u003ctemplate\u003e.\u003coutput\u003e}} in the string fields of the templates, which are created after the node finished.\nThey could not be referred in the fields authorized at admission, which are the selectors except the names of\npods, the namespaces, the targets of K8sApply and K8sWait, and the apiVersion, kind and metadata of manifest.\nThe template itself, the templates inside it and the templates running in parallel with it could not be referred.\nIf the referred output has no value when the template is created, the node creating it fails.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.NodeOutput"
+                    }
+                },
                 "physicalmachineChaos": {
                     "description": "+optional",
                     "allOf": [
@@ -6053,6 +7453,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "retry": {
+                    "description": "Retry describes the retry policy of retry node. Only used when Type is TypeRetry.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.RetrySpec"
+                        }
+                    ]
+                },
                 "schedule": {
                     "description": "Schedule describe the Schedule(describing scheduled chaos) to be injected with chaos nodes. Only used when Type is TypeSchedule.\n+optional",
                     "allOf": [
@@ -6077,6 +7485,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "subWorkflow": {
+                    "description": "SubWorkflow describes the child workflow instantiated by SubWorkflow node. Only used when Type is TypeSubWorkflow.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.SubWorkflowSpec"
+                        }
+                    ]
+                },
                 "task": {
                     "description": "Task describes the behavior of the custom task. Only used when Type is TypeTask.\n+optional",
                     "allOf": [
@@ -6107,6 +7523,14 @@ const docTemplate = `{
                 "Suspend",
                 "StatusCheck",
                 "Schedule",
+                "Loop",
+                "Retry",
+                "Approval",
+                "DAG",
+                "HTTPRequest",
+                "K8sApply",
+                "K8sWait",
+                "SubWorkflow",
                 "AWSChaos",
                 "AzureChaos",
                 "BlockChaos",
@@ -6117,6 +7541,7 @@ const docTemplate = `{
                 "JVMChaos",
                 "KernelChaos",
                 "NetworkChaos",
+                "NodeChaos",
                 "PhysicalMachineChaos",
                 "PodChaos",
                 "StressChaos",
@@ -6129,6 +7554,14 @@ const docTemplate = `{
                 "TypeSuspend",
                 "TypeStatusCheck",
                 "TypeSchedule",
+                "TypeLoop",
+                "TypeRetry",
+                "TypeApproval",
+                "TypeDAG",
+                "TypeHTTPRequest",
+                "TypeK8sApply",
+                "TypeK8sWait",
+                "TypeSubWorkflow",
                 "TypeAWSChaos",
                 "TypeAzureChaos",
                 "TypeBlockChaos",
@@ -6139,6 +7572,7 @@ const docTemplate = `{
                 "TypeJVMChaos",
                 "TypeKernelChaos",
                 "TypeNetworkChaos",
+                "TypeNodeChaos",
                 "TypePhysicalMachineChaos",
                 "TypePodChaos",
                 "TypeStressChaos",
@@ -6162,6 +7596,10 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "driftRate": {
+                    "description": "DriftRate defines how fast the clock of injected program runs away from the real one, in parts per million.\nFor example, 500 makes the clock gain 500us every second, and -500 makes it lose 500us every second.\nThe drift starts from TimeOffset if it's specified. The clock is stepped every second to approximate the drift.\nThe effective skew of each container is reported in the status, and the drift is resumed if chaos-daemon restarts.\n+kubebuilder:validation:Minimum=-1000000\n+kubebuilder:validation:Maximum=1000000\n+optional",
+                    "type": "integer"
+                },
                 "duration": {
                     "description": "Duration represents the duration of the chaos action",
                     "type": "string"
@@ -6187,7 +7625,7 @@ const docTemplate = `{
                     ]
                 },
                 "timeOffset": {
-                    "description": "TimeOffset defines the delta time of injected program. It's a possibly signed sequence of decimal numbers, such as\n\"300ms\", \"-1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".",
+                    "description": "TimeOffset defines the delta time of injected program. It's a possibly signed sequence of decimal numbers, such as\n\"300ms\", \"-1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\nAt least one of TimeOffset and DriftRate should be specified.\n+optional",
                     "type": "string"
                 },
                 "value": {
@@ -6229,6 +7667,27 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.VMStressor": {
+            "type": "object",
+            "properties": {
+                "bytes": {
+                    "description": "Bytes specifies N bytes allocated per vm worker, default is 256MB.\nOne can specify the size as % of total available memory or in units of B, KB/KiB,\nMB/MiB, GB/GiB, TB/TiB.\n+optional",
+                    "type": "string"
+                },
+                "keep": {
+                    "description": "Keep specifies whether to keep the memory mapped instead of unmapping and\nmapping it again in every iteration.\n+optional",
+                    "type": "boolean"
+                },
+                "method": {
+                    "description": "Method specifies the vm stress method of stress-ng, default is all.\nSee ` + "`" + `--vm-method` + "`" + ` in ` + "`" + `man stress-ng` + "`" + ` for the supported methods.\n+optional",
+                    "type": "string"
+                },
+                "workers": {
+                    "description": "Workers specifies N workers to apply the stressor.\nMaximum 8192 workers can run by stress-ng\n+kubebuilder:validation:Maximum=8192",
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Workflow": {
             "type": "object",
             "properties": {
@@ -6261,6 +7720,17 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.WorkflowArgument": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.WorkflowCondition": {
             "type": "object",
             "properties": {
@@ -6282,24 +7752,57 @@ const docTemplate = `{
             "type": "string",
             "enum": [
                 "Accomplished",
-                "Scheduled"
+                "Scheduled",
+                "Failed",
+                "Paused"
             ],
             "x-enum-varnames": [
                 "WorkflowConditionAccomplished",
-                "WorkflowConditionScheduled"
+                "WorkflowConditionScheduled",
+                "WorkflowConditionFailed",
+                "WorkflowConditionPaused"
             ]
         },
         "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.WorkflowSpec": {
             "type": "object",
             "properties": {
+                "arguments": {
+                    "description": "Arguments are the values of the parameters declared in the referred WorkflowTemplate.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.WorkflowArgument"
+                    }
+                },
                 "entry": {
+                    "description": "Entry and Templates are instantiated from the WorkflowTemplate when WorkflowTemplateRef is set.\n+optional",
+                    "type": "string"
+                },
+                "onExit": {
+                    "description": "OnExit is the name of the template which runs after the entry node finished, regardless of its result,\neven if the workflow is aborted or the deadline of the entry node exceeded.\n+optional",
+                    "type": "string"
+                },
+                "onFailure": {
+                    "description": "OnFailure is the name of the template which runs after the entry node failed, aborted or timed out.\n+optional",
+                    "type": "string"
+                },
+                "onSuccess": {
+                    "description": "OnSuccess is the name of the template which runs after the entry node succeeded.\n+optional",
                     "type": "string"
                 },
                 "templates": {
+                    "description": "+optional",
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.Template"
                     }
+                },
+                "workflowTemplateRef": {
+                    "description": "WorkflowTemplateRef refers to the WorkflowTemplate in the same namespace, which the workflow is instantiated from.\nThe entry and templates must be left empty, as they are filled from the WorkflowTemplate.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.WorkflowTemplateRef"
+                        }
+                    ]
                 }
             }
         },
@@ -6321,12 +7824,27 @@ const docTemplate = `{
                     "description": "+optional",
                     "type": "string"
                 },
+                "exitHandlerNodes": {
+                    "description": "ExitHandlerNodes are the nodes spawned with OnExit, OnFailure or OnSuccess.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "startTime": {
                     "description": "+optional",
                     "type": "string"
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_api_v1alpha1.WorkflowTemplateRef": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_pkg_config.ChaosDashboardConfig": {
             "type": "object",
             "properties": {
@@ -6660,6 +8178,22 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_types.WorkflowRerun": {
+            "type": "object",
+            "properties": {
+                "arguments": {
+                    "description": "Arguments override the arguments of the workflow instantiated from a WorkflowTemplate, the WorkflowTemplate is\ninstantiated again, so it should not be changed since the archived workflow was created.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.WorkflowArgument"
+                    }
+                },
+                "name": {
+                    "description": "Name is the name of the new workflow, it's generated from the archived one if it's empty.",
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_apiserver_utils.APIError": {
             "type": "object",
             "properties": {
@@ -6708,6 +8242,29 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.DAGTaskNode": {
+            "type": "object",
+            "properties": {
+                "dependencies": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phase": {
+                    "type": "string"
+                },
+                "task": {
+                    "type": "string"
+                },
+                "template": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.Event": {
             "type": "object",
             "properties": {
@@ -6789,6 +8346,18 @@ const docTemplate = `{
                         "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.ConditionalBranch"
                     }
                 },
+                "dag": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.DAGTaskNode"
+                    }
+                },
+                "iterations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeNameWithTemplate"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
@@ -6807,6 +8376,9 @@ const docTemplate = `{
                 "state": {
                     "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeState"
                 },
+                "sub_workflow": {
+                    "type": "string"
+                },
                 "template": {
                     "type": "string"
                 },
@@ -6818,6 +8390,38 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeComparison": {
+            "type": "object",
+            "properties": {
+                "added_targets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "base": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeRecord"
+                },
+                "duration_delta": {
+                    "type": "string"
+                },
+                "removed_targets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "state_changed": {
+                    "type": "boolean"
+                },
+                "target": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeRecord"
+                },
+                "template": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeNameWithTemplate": {
             "type": "object",
             "properties": {
@@ -6829,6 +8433,42 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeRecord": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeState"
+                },
+                "status_check": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.StatusCheckResult"
+                },
+                "targets": {
+                    "description": "Targets are the ids of the records injected by the chaos, e.g. \"namespace/pod\".",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "template": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeType"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeState": {
             "type": "string",
             "enum": [
@@ -6851,7 +8491,15 @@ const docTemplate = `{
                 "SuspendNode",
                 "TaskNode",
                 "StatusCheckNode",
-                "ScheduleNode"
+                "ScheduleNode",
+                "LoopNode",
+                "RetryNode",
+                "ApprovalNode",
+                "DAGNode",
+                "HTTPRequestNode",
+                "K8sApplyNode",
+                "K8sWaitNode",
+                "SubWorkflowNode"
             ],
             "x-enum-varnames": [
                 "ChaosNode",
@@ -6860,9 +8508,64 @@ const docTemplate = `{
                 "SuspendNode",
                 "TaskNode",
                 "StatusCheckNode",
-                "ScheduleNode"
+                "ScheduleNode",
+                "LoopNode",
+                "RetryNode",
+                "ApprovalNode",
+                "DAGNode",
+                "HTTPRequestNode",
+                "K8sApplyNode",
+                "K8sWaitNode",
+                "SubWorkflowNode"
             ]
         },
+        "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.PendingApproval": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "default_action": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_api_v1alpha1.ApprovalAction"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "node": {
+                    "type": "string"
+                },
+                "template": {
+                    "type": "string"
+                },
+                "timeout_at": {
+                    "description": "TimeoutAt is the time when the default action is taken, it's empty if the approval node has no timeout.",
+                    "type": "string"
+                },
+                "workflow": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.StatusCheckResult": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "failures": {
+                    "type": "integer"
+                },
+                "outcome": {
+                    "type": "string"
+                },
+                "successes": {
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.Topology": {
             "type": "object",
             "properties": {
@@ -6950,18 +8653,78 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowRun": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "description": "EndTime represents the time when the workflow completed all steps.",
+                    "type": "string"
+                },
+                "entry": {
+                    "description": "the entry node name",
+                    "type": "string"
+                },
+                "finish_time": {
+                    "description": "FinishTime represents the time when the workflow was deleted from Kubernetes.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeRecord"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowStatus"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowRunComparison": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowMeta"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.NodeComparison"
+                    }
+                },
+                "target": {
+                    "$ref": "#/definitions/github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowMeta"
+                }
+            }
+        },
         "github_com_chaos-mesh_chaos-mesh_pkg_dashboard_core.WorkflowStatus": {
             "type": "string",
             "enum": [
                 "running",
                 "finished",
                 "failed",
+                "paused",
                 "unknown"
             ],
             "x-enum-varnames": [
                 "WorkflowRunning",
                 "WorkflowSucceed",
                 "WorkflowFailed",
+                "WorkflowPaused",
                 "WorkflowUnknown"
             ]
         },
@@ -9651,6 +11414,44 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.Taint": {
+            "type": "object",
+            "properties": {
+                "effect": {
+                    "description": "Required. The effect of the taint on pods\nthat do not tolerate the taint.\nValid effects are NoSchedule, PreferNoSchedule and NoExecute.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.TaintEffect"
+                        }
+                    ]
+                },
+                "key": {
+                    "description": "Required. The taint key to be applied to a node.",
+                    "type": "string"
+                },
+                "timeAdded": {
+                    "description": "TimeAdded represents the time at which the taint was added.\n+optional",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "The taint value corresponding to the taint key.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TaintEffect": {
+            "type": "string",
+            "enum": [
+                "NoSchedule",
+                "PreferNoSchedule",
+                "NoExecute"
+            ],
+            "x-enum-varnames": [
+                "TaintEffectNoSchedule",
+                "TaintEffectPreferNoSchedule",
+                "TaintEffectNoExecute"
+            ]
+        },
         "v1.TerminationMessagePolicy": {
             "type": "string",
             "enum": [
@@ -0,0 +1,245 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package dnschaos
+
+import (
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/miekg/dns"
+)
+
+// Action is the chaos injected into the DNS queries matching a rule
+type Action string
+
+const (
+	// ActionError responds with SERVFAIL, which is the same as the error action of
+	// the chaos DNS server deployed with chaos mesh
+	ActionError Action = "error"
+	// ActionRandom responds with a random IP
+	ActionRandom Action = "random"
+	// ActionNXDomain responds with NXDOMAIN
+	ActionNXDomain Action = "nxdomain"
+	// ActionServFail responds with SERVFAIL
+	ActionServFail Action = "servfail"
+	// ActionRefused responds with REFUSED
+	ActionRefused Action = "refused"
+	// ActionDelay forwards the query to the upstream server after a delay
+	ActionDelay Action = "delay"
+	// ActionTimeout drops the query, so the client will time out
+	ActionTimeout Action = "timeout"
+	// ActionTruncate responds with an empty truncated message to the queries over UDP,
+	// so the client will fall back to TCP
+	ActionTruncate Action = "truncate"
+	// ActionFixed responds with the fixed answers
+	ActionFixed Action = "fixed"
+)
+
+// fixedAnswerTTL is the TTL of the fixed answers, it's short so that the client
+// will not cache the answer for too long after the chaos is recovered
+const fixedAnswerTTL = 10
+
+// Rule describes the chaos injected into the DNS queries matching the pattern
+type Rule struct {
+	// Pattern is the domain name pattern, which supports the placeholder ? and
+	// the wildcard * at the end. An empty pattern matches all the domain names.
+	Pattern string        `json:"pattern,omitempty"`
+	Action  Action        `json:"action"`
+	Delay   time.Duration `json:"delay,omitempty"`
+	Answers []net.IP      `json:"answers,omitempty"`
+}
+
+// Match returns whether the domain name matches the pattern of the rule
+func (r *Rule) Match(name string) bool {
+	return matchPattern(strings.ToLower(r.Pattern), strings.ToLower(strings.TrimSuffix(name, ".")))
+}
+
+func matchPattern(pattern string, name string) bool {
+	if len(pattern) == 0 {
+		return true
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] == '*' {
+			return true
+		}
+		if i >= len(name) {
+			return false
+		}
+		if pattern[i] != '?' && pattern[i] != name[i] {
+			return false
+		}
+	}
+	return len(pattern) == len(name)
+}
+
+// Handler is a dns.Handler which injects chaos into the queries matching the
+// rules, and forwards the other queries to the upstream server
+type Handler struct {
+	rules    []Rule
+	upstream string
+	mark     int
+	logger   logr.Logger
+}
+
+// NewHandler creates a Handler with the rules and the upstream server, the
+// first matching rule takes effect on a query. If the mark is not 0, the
+// queries forwarded to the upstream server are sent with the firewall mark, so
+// that they could be excluded from the redirection of DNS queries.
+func NewHandler(rules []Rule, upstream string, mark int, logger logr.Logger) *Handler {
+	return &Handler{
+		rules:    rules,
+		upstream: upstream,
+		mark:     mark,
+		logger:   logger,
+	}
+}
+
+func (h *Handler) match(r *dns.Msg) *Rule {
+	if len(r.Question) == 0 {
+		return nil
+	}
+	for i := range h.rules {
+		if h.rules[i].Match(r.Question[0].Name) {
+			return &h.rules[i]
+		}
+	}
+	return nil
+}
+
+// ServeDNS implements dns.Handler
+func (h *Handler) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	rule := h.match(r)
+	if rule == nil {
+		h.forward(w, r)
+		return
+	}
+
+	switch rule.Action {
+	case ActionError, ActionServFail:
+		h.reply(w, new(dns.Msg).SetRcode(r, dns.RcodeServerFailure))
+	case ActionRandom:
+		answers := fixedAnswers(r.Question[0], []net.IP{randomIPv4(), randomIPv6()})
+		if len(answers) == 0 {
+			h.reply(w, new(dns.Msg).SetRcode(r, dns.RcodeServerFailure))
+			return
+		}
+		m := new(dns.Msg).SetReply(r)
+		m.Authoritative = true
+		m.Answer = answers
+		h.reply(w, m)
+	case ActionNXDomain:
+		h.reply(w, new(dns.Msg).SetRcode(r, dns.RcodeNameError))
+	case ActionRefused:
+		h.reply(w, new(dns.Msg).SetRcode(r, dns.RcodeRefused))
+	case ActionDelay:
+		time.Sleep(rule.Delay)
+		h.forward(w, r)
+	case ActionTimeout:
+		// don't respond, and let the client time out
+	case ActionTruncate:
+		if _, ok := w.RemoteAddr().(*net.UDPAddr); !ok {
+			h.forward(w, r)
+			return
+		}
+		m := new(dns.Msg).SetReply(r)
+		m.Truncated = true
+		h.reply(w, m)
+	case ActionFixed:
+		m := new(dns.Msg).SetReply(r)
+		m.Authoritative = true
+		m.Answer = fixedAnswers(r.Question[0], rule.Answers)
+		h.reply(w, m)
+	default:
+		h.logger.Info("unknown dns chaos action, forward the query", "action", rule.Action)
+		h.forward(w, r)
+	}
+}
+
+func (h *Handler) forward(w dns.ResponseWriter, r *dns.Msg) {
+	client := &dns.Client{Net: "udp"}
+	if _, ok := w.RemoteAddr().(*net.TCPAddr); ok {
+		client.Net = "tcp"
+	}
+	if h.mark != 0 {
+		client.Dialer = &net.Dialer{Control: markControl(h.mark)}
+	}
+
+	resp, _, err := client.Exchange(r, h.upstream)
+	if err != nil {
+		h.logger.Error(err, "forward dns query", "upstream", h.upstream)
+		h.reply(w, new(dns.Msg).SetRcode(r, dns.RcodeServerFailure))
+		return
+	}
+	h.reply(w, resp)
+}
+
+func (h *Handler) reply(w dns.ResponseWriter, m *dns.Msg) {
+	if err := w.WriteMsg(m); err != nil {
+		h.logger.Error(err, "write dns response")
+	}
+}
+
+// fixedAnswers returns the answers of the IPs which match the type of the question
+func fixedAnswers(q dns.Question, ips []net.IP) []dns.RR {
+	answers := []dns.RR{}
+	for _, ip := range ips {
+		header := dns.RR_Header{Name: q.Name, Class: dns.ClassINET, Ttl: fixedAnswerTTL}
+		if ipv4 := ip.To4(); ipv4 != nil {
+			if q.Qtype != dns.TypeA {
+				continue
+			}
+			header.Rrtype = dns.TypeA
+			answers = append(answers, &dns.A{Hdr: header, A: ipv4})
+		} else {
+			if q.Qtype != dns.TypeAAAA {
+				continue
+			}
+			header.Rrtype = dns.TypeAAAA
+			answers = append(answers, &dns.AAAA{Hdr: header, AAAA: ip})
+		}
+	}
+	return answers
+}
+
+func randomIPv4() net.IP {
+	return net.IPv4(byte(rand.Intn(256)), byte(rand.Intn(256)), byte(rand.Intn(256)), byte(rand.Intn(256)))
+}
+
+func randomIPv6() net.IP {
+	ip := make(net.IP, net.IPv6len)
+	for i := range ip {
+		ip[i] = byte(rand.Intn(256))
+	}
+	// keep it out of the IPv4-mapped addresses
+	ip[0] = 0x20
+	return ip
+}
+
+// ParseNameservers returns the addresses of the nameservers in the content of resolv.conf
+func ParseNameservers(content string) []string {
+	nameservers := []string{}
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			nameservers = append(nameservers, fields[1])
+		}
+	}
+	return nameservers
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package dnschaos
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/miekg/dns"
+)
+
+func TestRuleMatch(t *testing.T) {
+	cases := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"", "google.com.", true},
+		{"google.com", "google.com.", true},
+		{"google.com", "Google.COM.", true},
+		{"google.com", "google.co.", false},
+		{"github.*", "github.com.", true},
+		{"github.*", "gitlab.com.", false},
+		{"chaos-mes?.org", "chaos-mesh.org.", true},
+		{"chaos-mes?.org", "chaos-mes.org.", false},
+	}
+
+	for _, c := range cases {
+		rule := Rule{Pattern: c.pattern}
+		if got := rule.Match(c.name); got != c.match {
+			t.Errorf("pattern %q, name %q: expected %v, got %v", c.pattern, c.name, c.match, got)
+		}
+	}
+}
+
+func TestParseNameservers(t *testing.T) {
+	content := "search default.svc.cluster.local\nnameserver 10.96.0.10\n# nameserver 1.1.1.1\nnameserver 8.8.8.8\noptions ndots:5\n"
+	got := ParseNameservers(content)
+	if len(got) != 2 || got[0] != "10.96.0.10" || got[1] != "8.8.8.8" {
+		t.Errorf("unexpected nameservers %v", got)
+	}
+}
+
+func startServer(t *testing.T, handler dns.Handler) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	started := make(chan struct{})
+	server := &dns.Server{PacketConn: conn, Handler: handler, NotifyStartedFunc: func() { close(started) }}
+	go server.ActivateAndServe()
+	t.Cleanup(func() { server.Shutdown() })
+	<-started
+	return conn.LocalAddr().String()
+}
+
+func TestHandler(t *testing.T) {
+	upstream := startServer(t, dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+		m := new(dns.Msg).SetReply(r)
+		m.Answer = fixedAnswers(r.Question[0], []net.IP{net.ParseIP("1.1.1.1")})
+		w.WriteMsg(m)
+	}))
+
+	handler := NewHandler([]Rule{
+		{Pattern: "nx.com", Action: ActionNXDomain},
+		{Pattern: "fail.com", Action: ActionServFail},
+		{Pattern: "random.com", Action: ActionRandom},
+		{Pattern: "tc.com", Action: ActionTruncate},
+		{Pattern: "timeout.com", Action: ActionTimeout},
+		{Pattern: "delay.com", Action: ActionDelay, Delay: 200 * time.Millisecond},
+		{Pattern: "fixed.*", Action: ActionFixed, Answers: []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("::1")}},
+	}, upstream, 0, logr.Discard())
+	addr := startServer(t, handler)
+
+	client := &dns.Client{Net: "udp", Timeout: time.Second}
+	query := func(name string) (*dns.Msg, time.Duration, error) {
+		return client.Exchange(new(dns.Msg).SetQuestion(name, dns.TypeA), addr)
+	}
+
+	resp, _, err := query("nx.com.")
+	if err != nil || resp.Rcode != dns.RcodeNameError {
+		t.Errorf("expected NXDOMAIN, got %v, %v", resp, err)
+	}
+
+	resp, _, err = query("fail.com.")
+	if err != nil || resp.Rcode != dns.RcodeServerFailure {
+		t.Errorf("expected SERVFAIL, got %v, %v", resp, err)
+	}
+
+	resp, _, err = query("random.com.")
+	if err != nil || len(resp.Answer) != 1 || resp.Answer[0].(*dns.A).A.To4() == nil {
+		t.Errorf("expected random answer, got %v, %v", resp, err)
+	}
+
+	resp, _, err = query("tc.com.")
+	if err != nil || !resp.Truncated || len(resp.Answer) != 0 {
+		t.Errorf("expected truncated response, got %v, %v", resp, err)
+	}
+
+	_, _, err = query("timeout.com.")
+	if err == nil {
+		t.Errorf("expected timeout")
+	}
+
+	resp, rtt, err := query("delay.com.")
+	if err != nil || len(resp.Answer) != 1 || rtt < 200*time.Millisecond {
+		t.Errorf("expected delayed answer, got %v, %v, %v", resp, rtt, err)
+	}
+
+	resp, _, err = query("fixed.org.")
+	if err != nil || len(resp.Answer) != 1 || resp.Answer[0].(*dns.A).A.String() != "10.0.0.1" {
+		t.Errorf("expected fixed answer, got %v, %v", resp, err)
+	}
+
+	resp, _, err = query("other.com.")
+	if err != nil || len(resp.Answer) != 1 || resp.Answer[0].(*dns.A).A.String() != "1.1.1.1" {
+		t.Errorf("expected forwarded answer, got %v, %v", resp, err)
+	}
+}
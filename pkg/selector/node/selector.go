@@ -0,0 +1,127 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package node
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/fx"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/config"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/generic"
+)
+
+type SelectImpl struct {
+	c client.Client
+
+	generic.Option
+}
+
+type Params struct {
+	fx.In
+
+	Client client.Client
+}
+
+type Node struct {
+	v1.Node
+}
+
+func (n *Node) Id() string {
+	return n.Name
+}
+
+func (impl *SelectImpl) Select(ctx context.Context, nodeSelector *v1alpha1.NodeSelector) ([]*Node, error) {
+	if nodeSelector == nil {
+		return []*Node{}, nil
+	}
+
+	// nodes are cluster scoped resources, they could not be selected within namespace scoped mode
+	if !impl.ClusterScoped {
+		return nil, errors.New("nodes could only be selected in cluster scoped mode")
+	}
+
+	nodes, err := SelectNodes(ctx, impl.c, nodeSelector.Selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, errors.New("no node is selected")
+	}
+
+	indexes, err := generic.FilterObjectsByMode(nodeSelector.Mode, nodeSelector.Value, len(nodes))
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []*Node
+	for _, index := range indexes {
+		filtered = append(filtered, &Node{Node: nodes[index]})
+	}
+	return filtered, nil
+}
+
+// SelectNodes returns the nodes matching the selector.
+// If both Nodes and label selectors are set, the specified nodes must also match the label selectors.
+func SelectNodes(ctx context.Context, c client.Client, spec v1alpha1.NodeSelectorSpec) ([]v1.Node, error) {
+	labelSelector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels:      spec.LabelSelectors,
+		MatchExpressions: spec.ExpressionSelectors,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(spec.Nodes) == 0 {
+		var nodeList v1.NodeList
+		if err := c.List(ctx, &nodeList, &client.ListOptions{
+			LabelSelector: labelSelector,
+		}); err != nil {
+			return nil, err
+		}
+		return nodeList.Items, nil
+	}
+
+	var nodes []v1.Node
+	for _, name := range spec.Nodes {
+		var node v1.Node
+		if err := c.Get(ctx, types.NamespacedName{Name: name}, &node); err != nil {
+			return nil, err
+		}
+		if labelSelector.Matches(labels.Set(node.Labels)) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
+
+func New(params Params) *SelectImpl {
+	return &SelectImpl{
+		params.Client,
+		generic.Option{
+			ClusterScoped:         config.ControllerCfg.ClusterScoped,
+			TargetNamespace:       config.ControllerCfg.TargetNamespace,
+			EnableFilterNamespace: config.ControllerCfg.EnableFilterNamespace,
+		},
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package node
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/generic"
+	. "github.com/chaos-mesh/chaos-mesh/pkg/testutils"
+)
+
+func TestSelectNodes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, nodes := GenerateNNodes("n", 3, map[string]string{"role": "worker"})
+	objects2, nodes2 := GenerateNNodes("m", 2, map[string]string{"role": "master"})
+	objects = append(objects, objects2...)
+	nodes = append(nodes, nodes2...)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithRuntimeObjects(objects...).
+		Build()
+
+	type TestCase struct {
+		name     string
+		selector v1alpha1.NodeSelectorSpec
+		expected []string
+	}
+
+	tcs := []TestCase{
+		{
+			name:     "select all nodes",
+			selector: v1alpha1.NodeSelectorSpec{},
+			expected: []string{nodes[0].Name, nodes[1].Name, nodes[2].Name, nodes[3].Name, nodes[4].Name},
+		},
+		{
+			name: "select nodes by names",
+			selector: v1alpha1.NodeSelectorSpec{
+				Nodes: []string{"n1", "m0"},
+			},
+			expected: []string{"n1", "m0"},
+		},
+		{
+			name: "select nodes by labels",
+			selector: v1alpha1.NodeSelectorSpec{
+				LabelSelectors: map[string]string{"role": "master"},
+			},
+			expected: []string{"m0", "m1"},
+		},
+		{
+			name: "select nodes by names and labels",
+			selector: v1alpha1.NodeSelectorSpec{
+				Nodes:          []string{"n1", "m0"},
+				LabelSelectors: map[string]string{"role": "master"},
+			},
+			expected: []string{"m0"},
+		},
+		{
+			name: "select nodes by label expressions",
+			selector: v1alpha1.NodeSelectorSpec{
+				ExpressionSelectors: []metav1.LabelSelectorRequirement{
+					{
+						Key:      "role",
+						Operator: metav1.LabelSelectorOpNotIn,
+						Values:   []string{"master"},
+					},
+				},
+			},
+			expected: []string{"n0", "n1", "n2"},
+		},
+	}
+
+	for _, tc := range tcs {
+		selected, err := SelectNodes(context.Background(), c, tc.selector)
+		g.Expect(err).ShouldNot(HaveOccurred(), tc.name)
+
+		var names []string
+		for _, node := range selected {
+			names = append(names, node.Name)
+		}
+		g.Expect(names).To(ConsistOf(tc.expected), tc.name)
+	}
+}
+
+func TestSelectInNamespaceScopedMode(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	objects, _ := GenerateNNodes("n", 1, nil)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithRuntimeObjects(objects...).
+		Build()
+
+	impl := &SelectImpl{c: c, Option: generic.Option{ClusterScoped: false}}
+	_, err := impl.Select(context.Background(), &v1alpha1.NodeSelector{Mode: v1alpha1.AllMode})
+	g.Expect(err).Should(HaveOccurred())
+
+	impl.ClusterScoped = true
+	selected, err := impl.Select(context.Background(), &v1alpha1.NodeSelector{Mode: v1alpha1.AllMode})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(selected).To(HaveLen(1))
+	g.Expect(selected[0].Id()).To(Equal("n0"))
+}
@@ -52,6 +52,21 @@ func affectedNamespaces(obj interface{}) (bool, map[string]struct{}) {
 
 			return true
 		}
+
+		// the child workflow is created by chaos-controller-manager, so its namespaces are authorized with the parent
+		if subWorkflow, ok := obj.(*v1alpha1.SubWorkflowSpec); ok {
+			if subWorkflow == nil {
+				return false
+			}
+			if spec, err := subWorkflow.GetWorkflowSpec(); err == nil && spec != nil {
+				childClusterScoped, childNamespaces := affectedNamespaces(spec)
+				clusterScoped = clusterScoped || childClusterScoped
+				for namespace := range childNamespaces {
+					namespaces[namespace] = struct{}{}
+				}
+			}
+			return true
+		}
 		return true
 	})
 	walker.Walk()
@@ -139,4 +139,39 @@ func TestAffectedNamespaces(t *testing.T) {
 		"ns1": {},
 		"ns2": {},
 	}))
+
+	subWorkflow := v1alpha1.SubWorkflowSpec{}
+	g.Expect(subWorkflow.SetWorkflowSpec(v1alpha1.WorkflowSpec{
+		Entry: "network-chaos",
+		Templates: []v1alpha1.Template{
+			{
+				Name: "network-chaos",
+				Type: v1alpha1.TypeNetworkChaos,
+				EmbedChaos: &v1alpha1.EmbedChaos{
+					NetworkChaos: &v1alpha1.NetworkChaosSpec{
+						Target: &v1alpha1.PodSelector{
+							Selector: v1alpha1.PodSelectorSpec{
+								GenericSelectorSpec: v1alpha1.GenericSelectorSpec{
+									Namespaces: []string{"ns5"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})).To(gomega.Succeed())
+	_, namespaces = affectedNamespaces(&v1alpha1.Workflow{
+		Spec: v1alpha1.WorkflowSpec{
+			Templates: []v1alpha1.Template{
+				{
+					Type:        v1alpha1.TypeSubWorkflow,
+					SubWorkflow: &subWorkflow,
+				},
+			},
+		},
+	})
+	g.Expect(namespaces).To(gomega.Equal(map[string]struct{}{
+		"ns5": {},
+	}))
 }
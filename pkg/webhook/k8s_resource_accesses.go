@@ -0,0 +1,101 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package webhook
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/chaos-mesh/chaos-mesh/api/genericwebhook"
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// k8sResourceAccess describes the verbs on the Kubernetes resource which K8sApply, K8sWait or HTTPRequest templates
+// require, they are performed by chaos-controller-manager, so the user creating the workflow should be allowed to do
+// the same.
+type k8sResourceAccess struct {
+	target v1alpha1.K8sResourceReference
+	verbs  []string
+}
+
+func k8sResourceAccesses(obj interface{}) []k8sResourceAccess {
+	var accesses []k8sResourceAccess
+
+	walker := genericwebhook.NewFieldWalker(obj, func(path *field.Path, obj interface{}, field *reflect.StructField) bool {
+		if field != nil && (field.Name == "Status" || field.Name == "TypeMeta" || field.Name == "ObjectMeta") {
+			return false
+		}
+
+		switch spec := obj.(type) {
+		case *v1alpha1.K8sApplySpec:
+			if spec == nil {
+				return false
+			}
+			if spec.Patch != nil {
+				verbs := []string{"get", "patch"}
+				if spec.Revert {
+					verbs = append(verbs, "update")
+				}
+				accesses = append(accesses, k8sResourceAccess{target: spec.Patch.Target, verbs: verbs})
+			} else if manifest, err := spec.ParseManifest(); err == nil {
+				verbs := []string{"get", "create", "patch"}
+				if spec.Revert {
+					verbs = append(verbs, "update", "delete")
+				}
+				accesses = append(accesses, k8sResourceAccess{
+					target: v1alpha1.K8sResourceReference{
+						APIVersion: manifest.GetAPIVersion(),
+						Kind:       manifest.GetKind(),
+						Namespace:  manifest.GetNamespace(),
+						Name:       manifest.GetName(),
+					},
+					verbs: verbs,
+				})
+			}
+			return false
+		case *v1alpha1.K8sWaitSpec:
+			if spec == nil {
+				return false
+			}
+			accesses = append(accesses, k8sResourceAccess{target: spec.Target, verbs: []string{"get"}})
+			return false
+		case *v1alpha1.HTTPRequestNodeSpec:
+			if spec == nil {
+				return false
+			}
+			if spec.TLS != nil && spec.TLS.SecretName != nil {
+				accesses = append(accesses, k8sResourceAccess{
+					target: v1alpha1.K8sResourceReference{APIVersion: "v1", Kind: "Secret", Name: *spec.TLS.SecretName},
+					verbs:  []string{"get"},
+				})
+			}
+			return false
+		case *v1alpha1.SubWorkflowSpec:
+			if spec == nil {
+				return false
+			}
+			if child, err := spec.GetWorkflowSpec(); err == nil && child != nil {
+				accesses = append(accesses, k8sResourceAccesses(child)...)
+			}
+			return true
+		}
+		return true
+	})
+	walker.Walk()
+
+	return accesses
+}
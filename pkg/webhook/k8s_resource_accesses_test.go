@@ -0,0 +1,102 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestK8sResourceAccesses(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	deployment := v1alpha1.K8sResourceReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "web-show"}
+	job := v1alpha1.K8sResourceReference{APIVersion: "batch/v1", Kind: "Job", Namespace: "load", Name: "load-test"}
+	secretName := "web-show-tls"
+
+	accesses := k8sResourceAccesses(&v1alpha1.Workflow{
+		Spec: v1alpha1.WorkflowSpec{
+			Templates: []v1alpha1.Template{
+				{
+					Type: v1alpha1.TypeK8sApply,
+					K8sApply: &v1alpha1.K8sApplySpec{
+						Patch:  &v1alpha1.K8sPatch{Target: deployment, Data: "spec:\n  replicas: 0\n"},
+						Revert: true,
+					},
+				},
+				{
+					Type: v1alpha1.TypeK8sApply,
+					K8sApply: &v1alpha1.K8sApplySpec{
+						Manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web-show-config\n",
+					},
+				},
+				{
+					Type:    v1alpha1.TypeK8sWait,
+					K8sWait: &v1alpha1.K8sWaitSpec{Target: job, JSONPath: "{.status.succeeded}"},
+				},
+				{
+					Type: v1alpha1.TypeSuspend,
+				},
+				{
+					Type: v1alpha1.TypeHTTPRequest,
+					HTTPRequest: &v1alpha1.HTTPRequestNodeSpec{
+						URL: "https://web-show.example.com",
+						TLS: &v1alpha1.HTTPRequestTLS{SecretName: &secretName},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(accesses).To(gomega.Equal([]k8sResourceAccess{
+		{target: deployment, verbs: []string{"get", "patch", "update"}},
+		{
+			target: v1alpha1.K8sResourceReference{APIVersion: "v1", Kind: "ConfigMap", Name: "web-show-config"},
+			verbs:  []string{"get", "create", "patch"},
+		},
+		{target: job, verbs: []string{"get"}},
+		{
+			target: v1alpha1.K8sResourceReference{APIVersion: "v1", Kind: "Secret", Name: secretName},
+			verbs:  []string{"get"},
+		},
+	}))
+
+	g.Expect(k8sResourceAccesses(&v1alpha1.PodChaos{})).To(gomega.BeEmpty())
+
+	subWorkflow := v1alpha1.SubWorkflowSpec{}
+	g.Expect(subWorkflow.SetWorkflowSpec(v1alpha1.WorkflowSpec{
+		Entry: "wait",
+		Templates: []v1alpha1.Template{
+			{
+				Name:    "wait",
+				Type:    v1alpha1.TypeK8sWait,
+				K8sWait: &v1alpha1.K8sWaitSpec{Target: job, JSONPath: "{.status.succeeded}"},
+			},
+		},
+	})).To(gomega.Succeed())
+	accesses = k8sResourceAccesses(&v1alpha1.Workflow{
+		Spec: v1alpha1.WorkflowSpec{
+			Templates: []v1alpha1.Template{
+				{Type: v1alpha1.TypeSubWorkflow, SubWorkflow: &subWorkflow},
+			},
+		},
+	})
+	g.Expect(accesses).To(gomega.Equal([]k8sResourceAccess{
+		{target: job, verbs: []string{"get"}},
+	}))
+}
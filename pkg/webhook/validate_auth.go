@@ -25,8 +25,10 @@ import (
 	"github.com/pkg/errors"
 	authnv1 "k8s.io/api/authentication/v1"
 	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -50,8 +52,9 @@ var alwaysAllowedKind = []string{
 
 // AuthValidator validates the authority
 type AuthValidator struct {
-	enabled bool
-	authCli *authorizationv1.AuthorizationV1Client
+	enabled    bool
+	authCli    *authorizationv1.AuthorizationV1Client
+	restMapper meta.RESTMapper
 
 	decoder admission.Decoder
 
@@ -62,11 +65,12 @@ type AuthValidator struct {
 }
 
 // NewAuthValidator returns a new AuthValidator
-func NewAuthValidator(enabled bool, authCli *authorizationv1.AuthorizationV1Client, decoderScheme *runtime.Scheme,
+func NewAuthValidator(enabled bool, authCli *authorizationv1.AuthorizationV1Client, restMapper meta.RESTMapper, decoderScheme *runtime.Scheme,
 	clusterScoped bool, targetNamespace string, enableFilterNamespace bool, logger logr.Logger) *AuthValidator {
 	return &AuthValidator{
 		enabled:               enabled,
 		authCli:               authCli,
+		restMapper:            restMapper,
 		decoder:               admission.NewDecoder(decoderScheme),
 		clusterScoped:         clusterScoped,
 		targetNamespace:       targetNamespace,
@@ -130,6 +134,19 @@ func (v *AuthValidator) Handle(ctx context.Context, req admission.Request) admis
 		v.logger.Info("user have the privileges on namespace, auth validate passed", "user", username, "groups", groups, "namespace", affectedNamespaces)
 	}
 
+	for _, access := range k8sResourceAccesses(chaos) {
+		for _, verb := range access.verbs {
+			allow, err := v.authK8sResource(req.UserInfo, req.Namespace, access.target, verb)
+			if err != nil {
+				return admission.Errored(http.StatusBadRequest, err)
+			}
+
+			if !allow {
+				return admission.Denied(fmt.Sprintf("%s is forbidden to %s %s %s", username, verb, access.target.Kind, access.target.Name))
+			}
+		}
+	}
+
 	return admission.Allowed("")
 }
 
@@ -163,6 +180,46 @@ func (v *AuthValidator) auth(userInfo authnv1.UserInfo, namespace string, chaosK
 	return response.Status.Allowed, nil
 }
 
+// authK8sResource checks whether the user could perform the verb on the resource referred by K8sApply or K8sWait
+// templates, the namespace of the chaos is used if the namespace of the namespaced resource is omitted.
+func (v *AuthValidator) authK8sResource(userInfo authnv1.UserInfo, namespace string, target v1alpha1.K8sResourceReference, verb string) (bool, error) {
+	gvk := schema.FromAPIVersionAndKind(target.APIVersion, target.Kind)
+	mapping, err := v.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return false, errors.Wrapf(err, "resolve resource of %s", gvk)
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		namespace = ""
+	} else if len(target.Namespace) > 0 {
+		namespace = target.Namespace
+	}
+
+	sar := authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &authzv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      verb,
+				Group:     mapping.Resource.Group,
+				Version:   mapping.Resource.Version,
+				Resource:  mapping.Resource.Resource,
+				Name:      target.Name,
+			},
+			User:   userInfo.Username,
+			UID:    userInfo.UID,
+			Groups: userInfo.Groups,
+			Extra:  convertExtra(userInfo.Extra),
+		},
+	}
+
+	// FIXME: get context from parameter
+	response, err := v.authCli.SubjectAccessReviews().Create(context.TODO(), &sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return response.Status.Allowed, nil
+}
+
 func (v *AuthValidator) resourceFor(name string) (string, error) {
 	// TODO: we should use RESTMapper, but it relates to many dependencies
 	return strings.ToLower(name), nil
@@ -0,0 +1,166 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+// ApprovalNodeReconciler watches on nodes which type is Approval
+type ApprovalNodeReconciler struct {
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewApprovalNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *ApprovalNodeReconciler {
+	return &ApprovalNodeReconciler{
+		kubeClient:    kubeClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+// Reconcile should be invoked by: changes on an approval node, or the timeout of the approval node.
+//
+// Approval node blocks until the annotation v1alpha1.WorkflowAnnotationApproval is set, or the default action
+// is taken after the timeout. The decision is recorded in v1alpha1.WorkflowNodeStatus Approval, and the node is
+// accomplished with the decision. If it's rejected, the node is also marked as failed. Nothing is decided while the
+// node is paused, and the time spent in pausing does not count for the timeout.
+func (it *ApprovalNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// only resolve approval nodes
+	if node.Spec.Type != v1alpha1.TypeApproval {
+		return reconcile.Result{}, nil
+	}
+
+	// the decision is taken after the node is resumed
+	if WorkflowNodeFinished(node.Status) || WorkflowNodePaused(node) {
+		return reconcile.Result{}, nil
+	}
+
+	decision, wait, err := approvalDecision(node, time.Now())
+	if err != nil {
+		it.logger.Error(err, "failed to resolve the decision of approval node", "node", request)
+		return reconcile.Result{}, nil
+	}
+	if decision == nil {
+		if wait != nil {
+			it.logger.V(4).Info("approval node is waiting for the decision", "node", request, "timeout after", *wait)
+			return reconcile.Result{Requeue: true, RequeueAfter: *wait}, nil
+		}
+		return reconcile.Result{}, nil
+	}
+
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		if WorkflowNodeFinished(nodeNeedUpdate.Status) || nodeNeedUpdate.Status.Approval != nil {
+			return nil
+		}
+
+		nodeNeedUpdate.Status.Approval = decision
+		SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+			Type:   v1alpha1.ConditionAccomplished,
+			Status: corev1.ConditionTrue,
+			Reason: v1alpha1.ApprovalDecided,
+		})
+		if decision.Action == v1alpha1.ApprovalActionReject {
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: v1alpha1.ApprovalRejected,
+			})
+		}
+
+		err = it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		it.eventRecorder.Event(&nodeNeedUpdate, recorder.ApprovalDecided{
+			Action:   string(decision.Action),
+			Approver: decision.Approver,
+			TimedOut: decision.TimedOut,
+		})
+		it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+		return nil
+	})
+	if updateError != nil {
+		it.logger.Error(updateError, "failed to update the status of node", "node", request)
+		return reconcile.Result{}, client.IgnoreNotFound(updateError)
+	}
+
+	it.logger.Info("approval node decided", "node", request,
+		"action", decision.Action, "approver", decision.Approver, "timed out", decision.TimedOut)
+	return reconcile.Result{}, nil
+}
+
+// approvalDecision returns the decision made on the approval node. If the decision is not made yet, it returns
+// the duration to wait before the timeout, which is nil if the approval node has no timeout.
+func approvalDecision(node v1alpha1.WorkflowNode, now time.Time) (*v1alpha1.ApprovalStatus, *time.Duration, error) {
+	action := v1alpha1.ApprovalAction(node.Annotations[v1alpha1.WorkflowAnnotationApproval])
+	if action == v1alpha1.ApprovalActionApprove || action == v1alpha1.ApprovalActionReject {
+		return &v1alpha1.ApprovalStatus{
+			Action:       action,
+			Approver:     node.Annotations[v1alpha1.WorkflowAnnotationApprover],
+			DecisionTime: metav1.NewTime(now),
+		}, nil, nil
+	}
+
+	if node.Spec.Approval == nil || node.Spec.Approval.Timeout == nil {
+		return nil, nil, nil
+	}
+	timeout, err := time.ParseDuration(*node.Spec.Approval.Timeout)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parse timeout of approval")
+	}
+
+	startTime := node.CreationTimestamp.Time
+	if node.Spec.StartTime != nil {
+		startTime = node.Spec.StartTime.Time
+	}
+	// the time spent in pausing does not count for the timeout
+	if wait := startTime.Add(timeout + WorkflowNodePausedDuration(node)).Sub(now); wait > 0 {
+		return nil, &wait, nil
+	}
+
+	return &v1alpha1.ApprovalStatus{
+		Action:       node.Spec.Approval.TimeoutAction(),
+		TimedOut:     true,
+		DecisionTime: metav1.NewTime(now),
+	}, nil, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func Test_approvalDecision(t *testing.T) {
+	now := time.Now()
+	startTime := metav1.NewTime(now.Add(-10 * time.Minute))
+	timeout := "30m"
+	expired := "5m"
+	newNode := func(annotations map[string]string, approval *v1alpha1.ApprovalSpec) v1alpha1.WorkflowNode {
+		return v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec: v1alpha1.WorkflowNodeSpec{
+				Type:      v1alpha1.TypeApproval,
+				StartTime: &startTime,
+				Approval:  approval,
+			},
+		}
+	}
+
+	t.Run("approved by user", func(t *testing.T) {
+		g := NewWithT(t)
+		node := newNode(map[string]string{
+			v1alpha1.WorkflowAnnotationApproval: "Approve",
+			v1alpha1.WorkflowAnnotationApprover: "alice",
+		}, &v1alpha1.ApprovalSpec{Timeout: &timeout})
+		decision, wait, err := approvalDecision(node, now)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(wait).Should(BeNil())
+		g.Expect(decision.Action).Should(Equal(v1alpha1.ApprovalActionApprove))
+		g.Expect(decision.Approver).Should(Equal("alice"))
+		g.Expect(decision.TimedOut).Should(BeFalse())
+	})
+
+	t.Run("waiting without timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		decision, wait, err := approvalDecision(newNode(nil, &v1alpha1.ApprovalSpec{}), now)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(decision).Should(BeNil())
+		g.Expect(wait).Should(BeNil())
+	})
+
+	t.Run("waiting for timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		decision, wait, err := approvalDecision(newNode(nil, &v1alpha1.ApprovalSpec{Timeout: &timeout}), now)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(decision).Should(BeNil())
+		g.Expect(*wait).Should(Equal(20 * time.Minute))
+	})
+
+	t.Run("timeout postponed by paused duration", func(t *testing.T) {
+		g := NewWithT(t)
+		node := newNode(map[string]string{
+			v1alpha1.WorkflowAnnotationPausedDuration: "10m",
+		}, &v1alpha1.ApprovalSpec{Timeout: &expired})
+		decision, wait, err := approvalDecision(node, now)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(decision).Should(BeNil())
+		g.Expect(*wait).Should(Equal(5 * time.Minute))
+	})
+
+	t.Run("timed out with default action", func(t *testing.T) {
+		g := NewWithT(t)
+		node := newNode(nil, &v1alpha1.ApprovalSpec{Timeout: &expired, DefaultAction: v1alpha1.ApprovalActionApprove})
+		decision, wait, err := approvalDecision(node, now)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(wait).Should(BeNil())
+		g.Expect(decision.Action).Should(Equal(v1alpha1.ApprovalActionApprove))
+		g.Expect(decision.TimedOut).Should(BeTrue())
+	})
+
+	t.Run("timed out and rejected by default", func(t *testing.T) {
+		g := NewWithT(t)
+		decision, _, err := approvalDecision(newNode(nil, &v1alpha1.ApprovalSpec{Timeout: &expired}), now)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(decision.Action).Should(Equal(v1alpha1.ApprovalActionReject))
+		g.Expect(decision.Approver).Should(BeEmpty())
+	})
+}
@@ -16,11 +16,16 @@
 package controllers
 
 import (
+	"context"
+
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/config"
@@ -86,6 +91,143 @@ func BootstrapWorkflowControllers(mgr manager.Manager, logger logr.Logger, recor
 		return err
 	}
 
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{}).
+		Owns(&v1alpha1.WorkflowNode{}).
+		Named("workflow-dag-node-reconciler").
+		Complete(
+			NewDAGNodeReconciler(
+				noCacheClient,
+				recorderBuilder.Build("workflow-dag-node-reconciler"),
+				logger.WithName("workflow-dag-node-reconciler"),
+			),
+		)
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{}).
+		Owns(&v1alpha1.WorkflowNode{}).
+		Named("workflow-loop-node-reconciler").
+		Complete(
+			NewLoopNodeReconciler(
+				noCacheClient,
+				recorderBuilder.Build("workflow-loop-node-reconciler"),
+				logger.WithName("workflow-loop-node-reconciler"),
+			),
+		)
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{}).
+		Owns(&v1alpha1.WorkflowNode{}).
+		Named("workflow-retry-node-reconciler").
+		Complete(
+			NewRetryNodeReconciler(
+				noCacheClient,
+				recorderBuilder.Build("workflow-retry-node-reconciler"),
+				logger.WithName("workflow-retry-node-reconciler"),
+			),
+		)
+	if err != nil {
+		return err
+	}
+
+	httpHosts, err := newHTTPHostFilter(config.ControllerCfg.WorkflowHTTPRequestAllowedHosts, config.ControllerCfg.WorkflowHTTPRequestDeniedHosts)
+	if err != nil {
+		return err
+	}
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{}).
+		Owns(&v1alpha1.WorkflowNode{}).
+		Named("workflow-httprequest-node-reconciler").
+		Complete(
+			NewHTTPRequestNodeReconciler(
+				noCacheClient,
+				recorderBuilder.Build("workflow-httprequest-node-reconciler"),
+				logger.WithName("workflow-httprequest-node-reconciler"),
+				httpHosts,
+			),
+		)
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{}).
+		Named("workflow-k8s-apply-node-reconciler").
+		Complete(
+			NewK8sApplyNodeReconciler(
+				noCacheClient,
+				recorderBuilder.Build("workflow-k8s-apply-node-reconciler"),
+				logger.WithName("workflow-k8s-apply-node-reconciler"),
+				config.ControllerCfg.SecurityMode,
+			),
+		)
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{}).
+		Named("workflow-k8s-wait-node-reconciler").
+		Complete(
+			NewK8sWaitNodeReconciler(
+				noCacheClient,
+				recorderBuilder.Build("workflow-k8s-wait-node-reconciler"),
+				logger.WithName("workflow-k8s-wait-node-reconciler"),
+			),
+		)
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{}).
+		Owns(&v1alpha1.Workflow{}).
+		Named("workflow-subworkflow-node-reconciler").
+		Complete(
+			NewSubWorkflowNodeReconciler(
+				noCacheClient,
+				recorderBuilder.Build("workflow-subworkflow-node-reconciler"),
+				logger.WithName("workflow-subworkflow-node-reconciler"),
+			),
+		)
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.Workflow{}).
+		Named("workflow-k8s-revert-reconciler").
+		Complete(
+			NewK8sRevertReconciler(
+				noCacheClient,
+				recorderBuilder.Build("workflow-k8s-revert-reconciler"),
+				logger.WithName("workflow-k8s-revert-reconciler"),
+			),
+		)
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{}).
+		Named("workflow-approval-node-reconciler").
+		Complete(
+			NewApprovalNodeReconciler(
+				mgr.GetClient(),
+				recorderBuilder.Build("workflow-approval-node-reconciler"),
+				logger.WithName("workflow-approval-node-reconciler"),
+			),
+		)
+	if err != nil {
+		return err
+	}
+
 	err = ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.WorkflowNode{}).
 		Named("workflow-deadline-reconciler").
@@ -162,5 +304,30 @@ func BootstrapWorkflowControllers(mgr manager.Manager, logger logr.Logger, recor
 			recorderBuilder.Build("workflow-abort-workflow-reconciler"),
 			logger.WithName("workflow-abort-workflow-reconciler"),
 		))
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.Workflow{}).
+		Watches(&v1alpha1.WorkflowNode{}, handler.EnqueueRequestsFromMapFunc(workflowOfNode)).
+		Named("workflow-pause-workflow-reconciler").
+		Complete(NewPauseWorkflowReconciler(
+			noCacheClient,
+			recorderBuilder.Build("workflow-pause-workflow-reconciler"),
+			logger.WithName("workflow-pause-workflow-reconciler"),
+		))
 	return err
 }
+
+// workflowOfNode maps the node to the workflow it belongs to.
+func workflowOfNode(_ context.Context, obj client.Object) []reconcile.Request {
+	workflowName, ok := obj.GetLabels()[v1alpha1.LabelWorkflow]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      workflowName,
+	}}}
+}
@@ -19,12 +19,14 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -223,6 +225,9 @@ func (it *ChaosNodeReconciler) syncSchedule(ctx context.Context, node v1alpha1.W
 	} else {
 		it.logger.V(4).Info("do not need spawn or remove schedule CR")
 	}
+	if len(scheduleList) > 0 {
+		return it.syncPause(ctx, node, &scheduleList[0])
+	}
 	return nil
 
 }
@@ -290,6 +295,11 @@ func (it *ChaosNodeReconciler) syncChaosResources(ctx context.Context, node v1al
 	} else {
 		it.logger.V(4).Info("do not need spawn or remove chaos CR")
 	}
+	if len(chaosList) > 0 {
+		if err := it.syncPause(ctx, node, chaosList[0]); err != nil {
+			return err
+		}
+	}
 
 	// TODO: also respawn the chaos resource if Spec changed in workflow
 
@@ -318,6 +328,9 @@ func (it *ChaosNodeReconciler) createChaos(ctx context.Context, node v1alpha1.Wo
 		v1alpha1.LabelControlledBy: node.Name,
 		v1alpha1.LabelWorkflow:     node.Spec.WorkflowName,
 	})
+	if WorkflowNodePaused(node) {
+		chaosObject.SetAnnotations(map[string]string{v1alpha1.PauseAnnotationKey: "true"})
+	}
 
 	err = it.kubeClient.Create(ctx, chaosObject)
 	if err != nil {
@@ -387,6 +400,9 @@ func (it ChaosNodeReconciler) createSchedule(ctx context.Context, node v1alpha1.
 		},
 		Spec: *node.Spec.Schedule,
 	}
+	if WorkflowNodePaused(node) {
+		scheduleToCreate.Annotations = map[string]string{v1alpha1.PauseAnnotationKey: "true"}
+	}
 	err := it.kubeClient.Create(ctx, &scheduleToCreate)
 	if err != nil {
 		it.eventRecorder.Event(&node, recorder.ChaosCustomResourceCreateFailed{})
@@ -452,3 +468,34 @@ func (it SortScheduleByCreationTimestamp) Less(i, j int) bool {
 func (it SortScheduleByCreationTimestamp) Swap(i, j int) {
 	it[i], it[j] = it[j], it[i]
 }
+
+// syncPause pauses the chaos or schedule CR with v1alpha1.PauseAnnotationKey when the node is paused, and resumes it
+// when the node is resumed.
+func (it *ChaosNodeReconciler) syncPause(ctx context.Context, node v1alpha1.WorkflowNode, object client.Object) error {
+	paused := WorkflowNodePaused(node)
+	if (object.GetAnnotations()[v1alpha1.PauseAnnotationKey] == "true") == paused {
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := it.kubeClient.Get(ctx, types.NamespacedName{
+			Namespace: object.GetNamespace(),
+			Name:      object.GetName(),
+		}, object)
+		if err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		annotations := object.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[v1alpha1.PauseAnnotationKey] = strconv.FormatBool(paused)
+		object.SetAnnotations(annotations)
+		it.logger.Info("sync pause annotation of chaos CR",
+			"chaos node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"name", object.GetName(),
+			"pause", paused,
+		)
+		return it.kubeClient.Update(ctx, object)
+	})
+}
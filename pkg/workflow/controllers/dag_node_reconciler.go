@@ -0,0 +1,341 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+// DAGNodeReconciler watches on nodes which type is DAG
+type DAGNodeReconciler struct {
+	*ChildNodesFetcher
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewDAGNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *DAGNodeReconciler {
+	return &DAGNodeReconciler{
+		ChildNodesFetcher: NewChildNodesFetcher(kubeClient, logger),
+		kubeClient:        kubeClient,
+		eventRecorder:     eventRecorder,
+		logger:            logger,
+	}
+}
+
+// Reconcile should be invoked by: changes on a DAG node, or changes on a node which controlled by DAG node.
+//
+// DAG node spawns one child node for each task once its dependencies finished with the required outcomes, the task
+// of child node is labeled with v1alpha1.LabelDAGTask. The phases of tasks are recorded in v1alpha1.WorkflowNodeStatus
+// DAGTasks. The DAG node is accomplished when all the tasks finished or skipped, and it's marked as failed if any task
+// failed or aborted without a task depending on that outcome.
+func (it *DAGNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		it.logger.V(4).Info("Finished syncing for DAG node",
+			"node", request.NamespacedName,
+			"duration", time.Since(startTime),
+		)
+	}()
+
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// only resolve DAG nodes
+	if node.Spec.Type != v1alpha1.TypeDAG {
+		return reconcile.Result{}, nil
+	}
+
+	it.logger.V(4).Info("resolve DAG node", "node", request)
+
+	err = it.syncChildNodes(ctx, node)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// update status
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		activeChildren, finishedChildren, err := it.fetchChildNodes(ctx, nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		nodeNeedUpdate.Status.FinishedChildren = nil
+		for _, finishedChild := range finishedChildren {
+			nodeNeedUpdate.Status.FinishedChildren = append(nodeNeedUpdate.Status.FinishedChildren,
+				corev1.LocalObjectReference{
+					Name: finishedChild.Name,
+				})
+		}
+
+		nodeNeedUpdate.Status.ActiveChildren = nil
+		for _, activeChild := range activeChildren {
+			nodeNeedUpdate.Status.ActiveChildren = append(nodeNeedUpdate.Status.ActiveChildren,
+				corev1.LocalObjectReference{
+					Name: activeChild.Name,
+				})
+		}
+
+		var tasks []v1alpha1.DAGTask
+		if nodeNeedUpdate.Spec.DAG != nil {
+			tasks = nodeNeedUpdate.Spec.DAG.Tasks
+		}
+		taskStatuses, _ := resolveDAGTasks(tasks, append(finishedChildren, activeChildren...))
+		nodeNeedUpdate.Status.DAGTasks = taskStatuses
+
+		if dagFailed(tasks, taskStatuses) {
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: v1alpha1.ChildNodeFailed,
+			})
+		}
+
+		if dagFinished(taskStatuses) {
+			if !WorkflowNodeFinished(nodeNeedUpdate.Status) {
+				it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+			}
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionTrue,
+				Reason: "",
+			})
+		} else {
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionFalse,
+				Reason: "",
+			})
+		}
+
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+
+	if updateError != nil {
+		it.logger.Error(updateError, "failed to update the status of node", "node", request)
+		return reconcile.Result{}, updateError
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// syncChildNodes spawns the child nodes for the tasks whose dependencies are satisfied.
+func (it *DAGNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha1.WorkflowNode) error {
+	if WorkflowNodeFinished(node.Status) || WorkflowNodePaused(node) {
+		return nil
+	}
+
+	if node.Spec.DAG == nil || len(node.Spec.DAG.Tasks) == 0 {
+		it.logger.V(4).Info("empty DAG node, NOOP",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		)
+		return nil
+	}
+
+	activeChildNodes, finishedChildNodes, err := it.fetchChildNodes(ctx, node)
+	if err != nil {
+		return err
+	}
+	_, readyTasks := resolveDAGTasks(node.Spec.DAG.Tasks, append(finishedChildNodes, activeChildNodes...))
+	if len(readyTasks) == 0 {
+		it.logger.V(4).Info("no need to spawn new child node", "node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return nil
+	}
+
+	parentWorkflow := v1alpha1.Workflow{}
+	err = it.kubeClient.Get(ctx, types.NamespacedName{
+		Namespace: node.Namespace,
+		Name:      node.Spec.WorkflowName,
+	}, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch parent workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"workflow name", node.Spec.WorkflowName)
+		return err
+	}
+
+	outputs, err := fetchWorkflowOutputs(ctx, it.kubeClient, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch outputs of workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return err
+	}
+
+	var childrenNames []string
+	for _, task := range readyTasks {
+		childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, outputs, task.Template)
+		if err != nil {
+			it.logger.Error(err, "failed to render child node of task",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"task", task.Name)
+			return failByUnresolvedOutputs(ctx, it.kubeClient, it.eventRecorder, &node, err)
+		}
+		for _, childNode := range childNodes {
+			childNode.Labels[v1alpha1.LabelDAGTask] = task.Name
+			err := it.kubeClient.Create(ctx, childNode)
+			if err != nil {
+				it.logger.Error(err, "failed to create child node",
+					"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+					"child node", childNode)
+				return err
+			}
+			childrenNames = append(childrenNames, childNode.Name)
+		}
+	}
+	it.eventRecorder.Event(&node, recorder.NodesCreated{ChildNodes: childrenNames})
+	it.logger.Info("DAG node spawn new child node",
+		"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		"child node", childrenNames)
+
+	return nil
+}
+
+// dagTaskReady is the internal phase of the task whose dependencies are satisfied but the child node is not spawned yet,
+// it's recorded as v1alpha1.DAGTaskWaiting.
+const dagTaskReady v1alpha1.DAGTaskPhase = "Ready"
+
+// resolveDAGTasks returns the statuses of the tasks in the order of spec, and the tasks which are ready to spawn.
+// The child nodes should be sorted by creation timestamp, if there are several nodes of the same task, the latest one wins.
+func resolveDAGTasks(tasks []v1alpha1.DAGTask, childNodes []v1alpha1.WorkflowNode) ([]v1alpha1.DAGTaskStatus, []v1alpha1.DAGTask) {
+	sortedChildNodes := SortByCreationTimestamp(append([]v1alpha1.WorkflowNode{}, childNodes...))
+	sort.Sort(sortedChildNodes)
+	nodes := make(map[string]v1alpha1.WorkflowNode)
+	for _, childNode := range sortedChildNodes {
+		if task, ok := childNode.Labels[v1alpha1.LabelDAGTask]; ok {
+			nodes[task] = childNode
+		}
+	}
+	taskByName := make(map[string]v1alpha1.DAGTask)
+	for _, task := range tasks {
+		taskByName[task.Name] = task
+	}
+
+	phases := make(map[string]v1alpha1.DAGTaskPhase)
+	var resolve func(name string) v1alpha1.DAGTaskPhase
+	resolve = func(name string) v1alpha1.DAGTaskPhase {
+		if phase, ok := phases[name]; ok {
+			return phase
+		}
+		// the tasks on a cycle are always waiting
+		phases[name] = v1alpha1.DAGTaskWaiting
+
+		phase := dagTaskReady
+		if childNode, ok := nodes[name]; ok {
+			phase = dagNodePhase(childNode)
+		} else if task, ok := taskByName[name]; !ok {
+			phase = v1alpha1.DAGTaskSkipped
+		} else {
+			for _, dependency := range task.Dependencies {
+				dependencyPhase := resolve(dependency.Task)
+				switch dependencyPhase {
+				case v1alpha1.DAGTaskWaiting, dagTaskReady, v1alpha1.DAGTaskRunning:
+					if phase == dagTaskReady {
+						phase = v1alpha1.DAGTaskWaiting
+					}
+				case dependency.RequiredOutcome():
+				default:
+					// the dependency finished with another outcome, or it's skipped
+					phase = v1alpha1.DAGTaskSkipped
+				}
+			}
+		}
+		phases[name] = phase
+		return phase
+	}
+
+	var statuses []v1alpha1.DAGTaskStatus
+	var readyTasks []v1alpha1.DAGTask
+	for _, task := range tasks {
+		phase := resolve(task.Name)
+		if phase == dagTaskReady {
+			readyTasks = append(readyTasks, task)
+			phase = v1alpha1.DAGTaskWaiting
+		}
+		statuses = append(statuses, v1alpha1.DAGTaskStatus{
+			Task:  task.Name,
+			Node:  nodes[task.Name].Name,
+			Phase: phase,
+		})
+	}
+	return statuses, readyTasks
+}
+
+// dagNodePhase returns the phase of the task with its child node
+func dagNodePhase(node v1alpha1.WorkflowNode) v1alpha1.DAGTaskPhase {
+	switch {
+	case !WorkflowNodeFinished(node.Status):
+		return v1alpha1.DAGTaskRunning
+	case ConditionEqualsTo(node.Status, v1alpha1.ConditionAborted, corev1.ConditionTrue):
+		return v1alpha1.DAGTaskAborted
+	case WorkflowNodeSucceeded(node):
+		return v1alpha1.DAGTaskSucceeded
+	default:
+		return v1alpha1.DAGTaskFailed
+	}
+}
+
+// dagFinished returns true if all the tasks finished or skipped
+func dagFinished(statuses []v1alpha1.DAGTaskStatus) bool {
+	for _, status := range statuses {
+		if status.Phase == v1alpha1.DAGTaskWaiting || status.Phase == v1alpha1.DAGTaskRunning {
+			return false
+		}
+	}
+	return true
+}
+
+// dagFailed returns true if any task failed or aborted, and no task depends on that outcome of it
+func dagFailed(tasks []v1alpha1.DAGTask, statuses []v1alpha1.DAGTaskStatus) bool {
+	handled := make(map[string]v1alpha1.DAGTaskPhase)
+	for _, task := range tasks {
+		for _, dependency := range task.Dependencies {
+			if outcome := dependency.RequiredOutcome(); outcome != v1alpha1.DAGTaskSucceeded {
+				handled[dependency.Task] = outcome
+			}
+		}
+	}
+	for _, status := range statuses {
+		if status.Phase != v1alpha1.DAGTaskFailed && status.Phase != v1alpha1.DAGTaskAborted {
+			continue
+		}
+		if handled[status.Task] != status.Phase {
+			return true
+		}
+	}
+	return false
+}
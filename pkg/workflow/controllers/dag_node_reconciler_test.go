@@ -0,0 +1,147 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func Test_resolveDAGTasks(t *testing.T) {
+	now := time.Now()
+	newNode := func(name string, task string, offset time.Duration, conditions ...v1alpha1.WorkflowNodeCondition) v1alpha1.WorkflowNode {
+		return v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(offset)),
+				Labels:            map[string]string{v1alpha1.LabelDAGTask: task},
+			},
+			Spec:   v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypeTask},
+			Status: v1alpha1.WorkflowNodeStatus{Conditions: conditions},
+		}
+	}
+	accomplished := v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionAccomplished, Status: corev1.ConditionTrue}
+	failed := v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionFailed, Status: corev1.ConditionTrue}
+
+	tasks := []v1alpha1.DAGTask{
+		{Name: "a", Template: "t"},
+		{Name: "b", Template: "t", Dependencies: []v1alpha1.DAGDependency{{Task: "a"}}},
+		{Name: "c", Template: "t", Dependencies: []v1alpha1.DAGDependency{{Task: "a", Outcome: v1alpha1.DAGTaskFailed}}},
+		{Name: "d", Template: "t", Dependencies: []v1alpha1.DAGDependency{{Task: "b"}, {Task: "c"}}},
+	}
+	phasesOf := func(statuses []v1alpha1.DAGTaskStatus) []v1alpha1.DAGTaskPhase {
+		var phases []v1alpha1.DAGTaskPhase
+		for _, status := range statuses {
+			phases = append(phases, status.Phase)
+		}
+		return phases
+	}
+	namesOf := func(tasks []v1alpha1.DAGTask) []string {
+		var names []string
+		for _, task := range tasks {
+			names = append(names, task.Name)
+		}
+		return names
+	}
+
+	t.Run("only roots are ready at first", func(t *testing.T) {
+		g := NewWithT(t)
+		statuses, ready := resolveDAGTasks(tasks, nil)
+		g.Expect(phasesOf(statuses)).Should(Equal([]v1alpha1.DAGTaskPhase{
+			v1alpha1.DAGTaskWaiting, v1alpha1.DAGTaskWaiting, v1alpha1.DAGTaskWaiting, v1alpha1.DAGTaskWaiting,
+		}))
+		g.Expect(namesOf(ready)).Should(Equal([]string{"a"}))
+	})
+
+	t.Run("running task blocks dependents", func(t *testing.T) {
+		g := NewWithT(t)
+		statuses, ready := resolveDAGTasks(tasks, []v1alpha1.WorkflowNode{newNode("a-1", "a", 0)})
+		g.Expect(statuses[0].Node).Should(Equal("a-1"))
+		g.Expect(statuses[0].Phase).Should(Equal(v1alpha1.DAGTaskRunning))
+		g.Expect(ready).Should(BeEmpty())
+	})
+
+	t.Run("succeeded task skips the failure branch", func(t *testing.T) {
+		g := NewWithT(t)
+		statuses, ready := resolveDAGTasks(tasks, []v1alpha1.WorkflowNode{newNode("a-1", "a", 0, accomplished)})
+		g.Expect(phasesOf(statuses)).Should(Equal([]v1alpha1.DAGTaskPhase{
+			v1alpha1.DAGTaskSucceeded, v1alpha1.DAGTaskWaiting, v1alpha1.DAGTaskSkipped, v1alpha1.DAGTaskSkipped,
+		}))
+		g.Expect(namesOf(ready)).Should(Equal([]string{"b"}))
+		g.Expect(dagFinished(statuses)).Should(BeFalse())
+	})
+
+	t.Run("failed task is handled by the failure branch", func(t *testing.T) {
+		g := NewWithT(t)
+		statuses, ready := resolveDAGTasks(tasks, []v1alpha1.WorkflowNode{
+			newNode("a-1", "a", 0, accomplished, failed),
+			newNode("c-1", "c", time.Second, accomplished),
+		})
+		g.Expect(phasesOf(statuses)).Should(Equal([]v1alpha1.DAGTaskPhase{
+			v1alpha1.DAGTaskFailed, v1alpha1.DAGTaskSkipped, v1alpha1.DAGTaskSucceeded, v1alpha1.DAGTaskSkipped,
+		}))
+		g.Expect(ready).Should(BeEmpty())
+		g.Expect(dagFinished(statuses)).Should(BeTrue())
+		g.Expect(dagFailed(tasks, statuses)).Should(BeFalse())
+	})
+
+	t.Run("latest node of the task wins", func(t *testing.T) {
+		g := NewWithT(t)
+		statuses, _ := resolveDAGTasks(tasks, []v1alpha1.WorkflowNode{
+			newNode("a-2", "a", time.Second),
+			newNode("a-1", "a", 0, accomplished),
+		})
+		g.Expect(statuses[0].Node).Should(Equal("a-2"))
+		g.Expect(statuses[0].Phase).Should(Equal(v1alpha1.DAGTaskRunning))
+	})
+}
+
+func Test_dagFailed(t *testing.T) {
+	tasks := []v1alpha1.DAGTask{
+		{Name: "a", Template: "t"},
+		{Name: "b", Template: "t", Dependencies: []v1alpha1.DAGDependency{{Task: "a", Outcome: v1alpha1.DAGTaskAborted}}},
+	}
+
+	t.Run("unhandled failure", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(dagFailed(tasks, []v1alpha1.DAGTaskStatus{
+			{Task: "a", Phase: v1alpha1.DAGTaskFailed},
+			{Task: "b", Phase: v1alpha1.DAGTaskSkipped},
+		})).Should(BeTrue())
+	})
+
+	t.Run("handled abort", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(dagFailed(tasks, []v1alpha1.DAGTaskStatus{
+			{Task: "a", Phase: v1alpha1.DAGTaskAborted},
+			{Task: "b", Phase: v1alpha1.DAGTaskSucceeded},
+		})).Should(BeFalse())
+	})
+
+	t.Run("failure of a dependent task", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(dagFailed(tasks, []v1alpha1.DAGTaskStatus{
+			{Task: "a", Phase: v1alpha1.DAGTaskAborted},
+			{Task: "b", Phase: v1alpha1.DAGTaskFailed},
+		})).Should(BeTrue())
+	})
+}
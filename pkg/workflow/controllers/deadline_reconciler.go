@@ -64,6 +64,12 @@ func (it *DeadlineReconciler) Reconcile(ctx context.Context, request reconcile.R
 		return reconcile.Result{}, nil
 	}
 
+	if WorkflowNodePaused(node) {
+		// the deadline is suspended when the workflow is paused, it will be postponed on resuming
+		it.logger.V(4).Info("node is paused, omit checking deadline", "key", request.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
 	now := metav1.NewTime(time.Now())
 	if node.Spec.Deadline.Before(&now) {
 
@@ -148,7 +154,7 @@ func (it *DeadlineReconciler) Reconcile(ctx context.Context, request reconcile.R
 
 func (it *DeadlineReconciler) propagateDeadlineToChildren(ctx context.Context, parent *v1alpha1.WorkflowNode) error {
 	switch parent.Spec.Type {
-	case v1alpha1.TypeSerial, v1alpha1.TypeParallel, v1alpha1.TypeTask:
+	case v1alpha1.TypeSerial, v1alpha1.TypeParallel, v1alpha1.TypeTask, v1alpha1.TypeLoop, v1alpha1.TypeRetry, v1alpha1.TypeDAG, v1alpha1.TypeHTTPRequest:
 		activeChildNodes, _, err := it.ChildNodesFetcher.fetchChildNodes(ctx, *parent)
 		if err != nil {
 			return err
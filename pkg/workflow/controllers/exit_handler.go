@@ -0,0 +1,162 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// The values of label v1alpha1.LabelExitHandler, which are the fields of WorkflowSpec that the node is spawned with.
+const (
+	ExitHandlerOnExit    = "onExit"
+	ExitHandlerOnFailure = "onFailure"
+	ExitHandlerOnSuccess = "onSuccess"
+)
+
+type exitHandler struct {
+	kind     string
+	template string
+}
+
+// exitHandlersToRun returns the exit handlers of the workflow which should run with the result of entry node.
+func exitHandlersToRun(spec v1alpha1.WorkflowSpec, entrySucceeded bool) []exitHandler {
+	var result []exitHandler
+	if len(spec.OnExit) > 0 {
+		result = append(result, exitHandler{kind: ExitHandlerOnExit, template: spec.OnExit})
+	}
+	if entrySucceeded && len(spec.OnSuccess) > 0 {
+		result = append(result, exitHandler{kind: ExitHandlerOnSuccess, template: spec.OnSuccess})
+	}
+	if !entrySucceeded && len(spec.OnFailure) > 0 {
+		result = append(result, exitHandler{kind: ExitHandlerOnFailure, template: spec.OnFailure})
+	}
+	return result
+}
+
+// WorkflowNodeSucceeded returns true if the finished node is not failed or aborted, and it did not time out.
+// Chaos, Schedule, Suspend and StatusCheck nodes are expected to end with their deadlines, so the deadline
+// only counts as timeout for the other types of nodes.
+func WorkflowNodeSucceeded(node v1alpha1.WorkflowNode) bool {
+	if WorkflowNodeFailed(node.Status) || ConditionEqualsTo(node.Status, v1alpha1.ConditionAborted, corev1.ConditionTrue) {
+		return false
+	}
+	switch node.Spec.Type {
+	case v1alpha1.TypeSerial, v1alpha1.TypeParallel, v1alpha1.TypeTask, v1alpha1.TypeLoop, v1alpha1.TypeRetry, v1alpha1.TypeApproval, v1alpha1.TypeDAG, v1alpha1.TypeHTTPRequest,
+		v1alpha1.TypeK8sApply, v1alpha1.TypeK8sWait, v1alpha1.TypeSubWorkflow:
+		deadline := GetCondition(node.Status, v1alpha1.ConditionDeadlineExceed)
+		return deadline == nil || deadline.Status != corev1.ConditionTrue || deadline.Reason == v1alpha1.NodeDeadlineOmitted
+	default:
+		return true
+	}
+}
+
+// fetchExitHandlerNodes returns the nodes spawned by the exit handlers of the workflow, sorted by creation timestamp.
+func fetchExitHandlerNodes(ctx context.Context, kubeClient client.Client, workflow v1alpha1.Workflow) ([]v1alpha1.WorkflowNode, error) {
+	nodeList := v1alpha1.WorkflowNodeList{}
+	exitHandlerOfWorkflow, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			v1alpha1.LabelControlledBy: workflow.Name,
+		},
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      v1alpha1.LabelExitHandler,
+			Operator: metav1.LabelSelectorOpExists,
+		}},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "build label selector")
+	}
+
+	err = kubeClient.List(ctx, &nodeList, &client.ListOptions{
+		Namespace:     workflow.Namespace,
+		LabelSelector: exitHandlerOfWorkflow,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "list exit handler workflow node")
+	}
+
+	sortedNodes := SortByCreationTimestamp(nodeList.Items)
+	sort.Sort(sortedNodes)
+
+	return sortedNodes, nil
+}
+
+// renderExitHandlerNodes renders the nodes of the exit handlers, they are owned by the workflow like the entry node.
+func renderExitHandlerNodes(workflow *v1alpha1.Workflow, outputs map[string]string, handlers []exitHandler) ([]*v1alpha1.WorkflowNode, error) {
+	var result []*v1alpha1.WorkflowNode
+	for _, handler := range handlers {
+		nodes, err := renderNodesByTemplates(workflow, nil, outputs, handler.template)
+		if err != nil {
+			return nil, errors.Wrapf(err, "render node for %s", handler.kind)
+		}
+		for _, node := range nodes {
+			node.Labels[v1alpha1.LabelExitHandler] = handler.kind
+			result = append(result, node)
+		}
+	}
+	return result, nil
+}
+
+// exitHandlersFinished returns true if all the exit handlers which should run have been spawned and finished.
+func exitHandlersFinished(spec v1alpha1.WorkflowSpec, entryNode v1alpha1.WorkflowNode, exitHandlerNodes []v1alpha1.WorkflowNode) bool {
+	finished := make(map[string]struct{})
+	for _, node := range exitHandlerNodes {
+		if WorkflowNodeFinished(node.Status) {
+			finished[node.Labels[v1alpha1.LabelExitHandler]] = struct{}{}
+		}
+	}
+	for _, handler := range exitHandlersToRun(spec, WorkflowNodeSucceeded(entryNode)) {
+		if _, ok := finished[handler.kind]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// setWorkflowFailedCondition sets the condition Failed of the workflow with the result of the finished entry node
+// and the finished exit handlers.
+func setWorkflowFailedCondition(status *v1alpha1.WorkflowStatus, entryNode v1alpha1.WorkflowNode, exitHandlerNodes []v1alpha1.WorkflowNode) {
+	if !WorkflowNodeSucceeded(entryNode) {
+		SetWorkflowCondition(status, v1alpha1.WorkflowCondition{
+			Type:   v1alpha1.WorkflowConditionFailed,
+			Status: corev1.ConditionTrue,
+			Reason: v1alpha1.EntryNodeFailed,
+		})
+		return
+	}
+	for _, node := range exitHandlerNodes {
+		if WorkflowNodeFinished(node.Status) && !WorkflowNodeSucceeded(node) {
+			SetWorkflowCondition(status, v1alpha1.WorkflowCondition{
+				Type:   v1alpha1.WorkflowConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: v1alpha1.ExitHandlerFailed,
+			})
+			return
+		}
+	}
+	SetWorkflowCondition(status, v1alpha1.WorkflowCondition{
+		Type:   v1alpha1.WorkflowConditionFailed,
+		Status: corev1.ConditionFalse,
+		Reason: "",
+	})
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func Test_exitHandlersToRun(t *testing.T) {
+	spec := v1alpha1.WorkflowSpec{OnExit: "cleanup", OnFailure: "notify-failure", OnSuccess: "notify-success"}
+	tests := []struct {
+		name           string
+		spec           v1alpha1.WorkflowSpec
+		entrySucceeded bool
+		want           []exitHandler
+	}{
+		{name: "no exit handlers", spec: v1alpha1.WorkflowSpec{}, entrySucceeded: true, want: nil},
+		{
+			name:           "entry succeeded",
+			spec:           spec,
+			entrySucceeded: true,
+			want:           []exitHandler{{ExitHandlerOnExit, "cleanup"}, {ExitHandlerOnSuccess, "notify-success"}},
+		},
+		{
+			name:           "entry failed",
+			spec:           spec,
+			entrySucceeded: false,
+			want:           []exitHandler{{ExitHandlerOnExit, "cleanup"}, {ExitHandlerOnFailure, "notify-failure"}},
+		},
+		{
+			name:           "only onSuccess with failed entry",
+			spec:           v1alpha1.WorkflowSpec{OnSuccess: "notify-success"},
+			entrySucceeded: false,
+			want:           nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(exitHandlersToRun(tt.spec, tt.entrySucceeded)).Should(Equal(tt.want))
+		})
+	}
+}
+
+func TestWorkflowNodeSucceeded(t *testing.T) {
+	newNode := func(templateType v1alpha1.TemplateType, conditions ...v1alpha1.WorkflowNodeCondition) v1alpha1.WorkflowNode {
+		return v1alpha1.WorkflowNode{
+			Spec:   v1alpha1.WorkflowNodeSpec{Type: templateType},
+			Status: v1alpha1.WorkflowNodeStatus{Conditions: conditions},
+		}
+	}
+	accomplished := v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionAccomplished, Status: corev1.ConditionTrue}
+	deadlineExceed := v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionDeadlineExceed, Status: corev1.ConditionTrue, Reason: v1alpha1.NodeDeadlineExceed}
+	deadlineOmitted := v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionDeadlineExceed, Status: corev1.ConditionTrue, Reason: v1alpha1.NodeDeadlineOmitted}
+	aborted := v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionAborted, Status: corev1.ConditionTrue}
+	failed := v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionFailed, Status: corev1.ConditionTrue}
+
+	tests := []struct {
+		name string
+		node v1alpha1.WorkflowNode
+		want bool
+	}{
+		{name: "accomplished serial", node: newNode(v1alpha1.TypeSerial, accomplished), want: true},
+		{name: "accomplished serial then deadline omitted", node: newNode(v1alpha1.TypeSerial, accomplished, deadlineOmitted), want: true},
+		{name: "serial timed out", node: newNode(v1alpha1.TypeSerial, deadlineExceed), want: false},
+		{name: "chaos ends with deadline", node: newNode(v1alpha1.TypePodChaos, deadlineExceed), want: true},
+		{name: "aborted", node: newNode(v1alpha1.TypeSerial, aborted), want: false},
+		{name: "failed", node: newNode(v1alpha1.TypeTask, accomplished, failed), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(WorkflowNodeSucceeded(tt.node)).Should(Equal(tt.want))
+		})
+	}
+}
+
+func Test_setWorkflowFailedCondition(t *testing.T) {
+	accomplished := v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionAccomplished, Status: corev1.ConditionTrue}
+	failed := v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionFailed, Status: corev1.ConditionTrue}
+	succeededNode := v1alpha1.WorkflowNode{
+		Spec:   v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypeTask},
+		Status: v1alpha1.WorkflowNodeStatus{Conditions: []v1alpha1.WorkflowNodeCondition{accomplished}},
+	}
+	failedNode := v1alpha1.WorkflowNode{
+		Spec:   v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypeTask},
+		Status: v1alpha1.WorkflowNodeStatus{Conditions: []v1alpha1.WorkflowNodeCondition{accomplished, failed}},
+	}
+
+	tests := []struct {
+		name             string
+		entryNode        v1alpha1.WorkflowNode
+		exitHandlerNodes []v1alpha1.WorkflowNode
+		wantStatus       corev1.ConditionStatus
+		wantReason       string
+	}{
+		{name: "all succeeded", entryNode: succeededNode, exitHandlerNodes: []v1alpha1.WorkflowNode{succeededNode}, wantStatus: corev1.ConditionFalse},
+		{name: "entry failed", entryNode: failedNode, exitHandlerNodes: []v1alpha1.WorkflowNode{succeededNode}, wantStatus: corev1.ConditionTrue, wantReason: v1alpha1.EntryNodeFailed},
+		{name: "exit handler failed", entryNode: succeededNode, exitHandlerNodes: []v1alpha1.WorkflowNode{failedNode}, wantStatus: corev1.ConditionTrue, wantReason: v1alpha1.ExitHandlerFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			status := v1alpha1.WorkflowStatus{}
+			setWorkflowFailedCondition(&status, tt.entryNode, tt.exitHandlerNodes)
+			condition := GetWorkflowCondition(status, v1alpha1.WorkflowConditionFailed)
+			g.Expect(condition).ShouldNot(BeNil())
+			g.Expect(condition.Status).Should(Equal(tt.wantStatus))
+			g.Expect(condition.Reason).Should(Equal(tt.wantReason))
+		})
+	}
+}
+
+func Test_exitHandlersFinished(t *testing.T) {
+	g := NewWithT(t)
+
+	spec := v1alpha1.WorkflowSpec{OnExit: "cleanup", OnSuccess: "notify"}
+	entryNode := v1alpha1.WorkflowNode{
+		Spec: v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypeSerial},
+		Status: v1alpha1.WorkflowNodeStatus{Conditions: []v1alpha1.WorkflowNodeCondition{
+			{Type: v1alpha1.ConditionAccomplished, Status: corev1.ConditionTrue},
+		}},
+	}
+	newExitHandlerNode := func(kind string, finished bool) v1alpha1.WorkflowNode {
+		node := v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{v1alpha1.LabelExitHandler: kind}},
+		}
+		if finished {
+			node.Status.Conditions = []v1alpha1.WorkflowNodeCondition{{Type: v1alpha1.ConditionAccomplished, Status: corev1.ConditionTrue}}
+		}
+		return node
+	}
+
+	g.Expect(exitHandlersFinished(spec, entryNode, nil)).Should(BeFalse())
+	g.Expect(exitHandlersFinished(spec, entryNode, []v1alpha1.WorkflowNode{
+		newExitHandlerNode(ExitHandlerOnExit, true),
+	})).Should(BeFalse())
+	g.Expect(exitHandlersFinished(spec, entryNode, []v1alpha1.WorkflowNode{
+		newExitHandlerNode(ExitHandlerOnExit, true),
+		newExitHandlerNode(ExitHandlerOnSuccess, false),
+	})).Should(BeFalse())
+	g.Expect(exitHandlersFinished(spec, entryNode, []v1alpha1.WorkflowNode{
+		newExitHandlerNode(ExitHandlerOnExit, true),
+		newExitHandlerNode(ExitHandlerOnSuccess, true),
+	})).Should(BeTrue())
+	g.Expect(exitHandlersFinished(v1alpha1.WorkflowSpec{}, entryNode, nil)).Should(BeTrue())
+}
+
+func Test_fetchExitHandlerNodes(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	workflow := &v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "workflow"},
+		Spec: v1alpha1.WorkflowSpec{
+			Entry:  "entry",
+			OnExit: "cleanup",
+			Templates: []v1alpha1.Template{
+				{Name: "entry", Type: v1alpha1.TypeSerial},
+				{Name: "cleanup", Type: v1alpha1.TypeSuspend},
+			},
+		},
+	}
+	nodes, err := renderNodesByTemplates(workflow, nil, nil, "entry")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	entryNode := nodes[0]
+	entryNode.Name = "entry-0"
+	exitHandlerNodes, err := renderExitHandlerNodes(workflow, nil, exitHandlersToRun(workflow.Spec, true))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(exitHandlerNodes).Should(HaveLen(1))
+	exitHandlerNode := exitHandlerNodes[0]
+	exitHandlerNode.Name = "cleanup-0"
+
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(entryNode, exitHandlerNode).Build()
+
+	gotEntryNodes, err := fetchEntryNode(context.Background(), kubeClient, *workflow)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(gotEntryNodes).Should(HaveLen(1))
+	g.Expect(gotEntryNodes[0].Name).Should(Equal("entry-0"))
+
+	gotExitHandlerNodes, err := fetchExitHandlerNodes(context.Background(), kubeClient, *workflow)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(gotExitHandlerNodes).Should(HaveLen(1))
+	g.Expect(gotExitHandlerNodes[0].Name).Should(Equal("cleanup-0"))
+	g.Expect(gotExitHandlerNodes[0].Labels[v1alpha1.LabelExitHandler]).Should(Equal(ExitHandlerOnExit))
+}
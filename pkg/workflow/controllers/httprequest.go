@@ -0,0 +1,320 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	statuscheckhttp "github.com/chaos-mesh/chaos-mesh/controllers/statuscheck/http"
+)
+
+// maxHTTPResponseBodySize is the max size of response body kept for the ConditionalBranches, the rest is discarded.
+const maxHTTPResponseBodySize = 64 * 1024
+
+// maxStoredHTTPResponseBodySize is the max size of response body stored in the status of node, the body is stored
+// as a string if it's truncated.
+const maxStoredHTTPResponseBodySize = 4 * 1024
+
+// the names of variables exposed to the ConditionalBranches of HTTPRequest node
+const (
+	httpEnvStatusCode = "statusCode"
+	httpEnvHeaders    = "headers"
+	httpEnvBody       = "body"
+)
+
+// httpHostFilter restricts the hosts which the HTTPRequest could be sent to. Each pattern is a host name, a wildcard
+// such as *.example.com, an address, or a CIDR. A host is denied if it matches any of the denied patterns, or the allowed patterns
+// are not empty and it matches none of them. The denied CIDRs are also checked against the addresses dialed, so the
+// host names resolved to the denied addresses are denied too.
+type httpHostFilter struct {
+	allowed []hostPattern
+	denied  []hostPattern
+}
+
+type hostPattern struct {
+	cidr *net.IPNet
+	name string
+}
+
+func newHTTPHostFilter(allowed []string, denied []string) (*httpHostFilter, error) {
+	filter := &httpHostFilter{}
+	for _, item := range allowed {
+		pattern, err := parseHostPattern(item)
+		if err != nil {
+			return nil, err
+		}
+		filter.allowed = append(filter.allowed, pattern)
+	}
+	for _, item := range denied {
+		pattern, err := parseHostPattern(item)
+		if err != nil {
+			return nil, err
+		}
+		filter.denied = append(filter.denied, pattern)
+	}
+	return filter, nil
+}
+
+func parseHostPattern(pattern string) (hostPattern, error) {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	if len(pattern) == 0 {
+		return hostPattern{}, errors.New("empty host pattern")
+	}
+	if strings.Contains(pattern, "/") {
+		_, cidr, err := net.ParseCIDR(pattern)
+		if err != nil {
+			return hostPattern{}, errors.Wrapf(err, "parse host pattern %s", pattern)
+		}
+		return hostPattern{cidr: cidr}, nil
+	}
+	// a single address is matched as a CIDR, so that it's checked against the addresses dialed in any form
+	if ip := net.ParseIP(pattern); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), 8*net.IPv4len
+		}
+		return hostPattern{cidr: &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}}, nil
+	}
+	return hostPattern{name: pattern}, nil
+}
+
+func (p hostPattern) matchHost(host string) bool {
+	host = strings.ToLower(host)
+	if p.cidr != nil {
+		ip := net.ParseIP(host)
+		return ip != nil && p.cidr.Contains(ip)
+	}
+	if strings.HasPrefix(p.name, "*.") {
+		return strings.HasSuffix(host, p.name[1:])
+	}
+	return host == p.name
+}
+
+// empty returns true if all hosts are allowed
+func (f *httpHostFilter) empty() bool {
+	return f == nil || (len(f.allowed) == 0 && len(f.denied) == 0)
+}
+
+// checkURL checks the host of the url
+func (f *httpHostFilter) checkURL(u *url.URL) error {
+	if f.empty() {
+		return nil
+	}
+	host := u.Hostname()
+	for _, pattern := range f.denied {
+		if pattern.matchHost(host) {
+			return errors.Errorf("host %s is denied", host)
+		}
+	}
+	if len(f.allowed) == 0 {
+		return nil
+	}
+	for _, pattern := range f.allowed {
+		if pattern.matchHost(host) {
+			return nil
+		}
+	}
+	return errors.Errorf("host %s is not allowed", host)
+}
+
+// checkAddress checks the address dialed against the denied CIDRs, it's used as the Control of net.Dialer
+func (f *httpHostFilter) checkAddress(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	for _, pattern := range f.denied {
+		if pattern.cidr != nil && ip != nil && pattern.cidr.Contains(ip) {
+			return errors.Errorf("address %s is denied", host)
+		}
+	}
+	return nil
+}
+
+// newHTTPClient builds the client for the HTTPRequest, the TLS secret is read from the given namespace. The url and
+// the redirections are checked with the hosts filter.
+func newHTTPClient(ctx context.Context, kubeClient client.Client, namespace string, request v1alpha1.HTTPRequestNodeSpec, hosts *httpHostFilter) (*http.Client, error) {
+	timeout, err := request.GetTimeout()
+	if err != nil {
+		return nil, errors.Wrap(err, "parse timeout")
+	}
+	if timeout > v1alpha1.MaxHTTPRequestTimeout {
+		timeout = v1alpha1.MaxHTTPRequestTimeout
+	}
+	requestURL, err := url.Parse(request.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse url")
+	}
+	if err := hosts.checkURL(requestURL); err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !hosts.empty() {
+		// the address of proxy could not be checked against the hosts
+		transport.Proxy = nil
+		transport.DialContext = (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   hosts.checkAddress,
+		}).DialContext
+	}
+	if request.TLS != nil {
+		tlsConfig := &tls.Config{
+			ServerName:         request.TLS.ServerName,
+			InsecureSkipVerify: request.TLS.InsecureSkipVerify, // #nosec G402 it's enabled by user explicitly
+		}
+		if request.TLS.SecretName != nil {
+			secret := corev1.Secret{}
+			err := kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: *request.TLS.SecretName}, &secret)
+			if err != nil {
+				return nil, errors.Wrapf(err, "get tls secret %s", *request.TLS.SecretName)
+			}
+			if err := applyTLSSecret(tlsConfig, secret); err != nil {
+				return nil, errors.Wrapf(err, "load tls secret %s", *request.TLS.SecretName)
+			}
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	httpClient := &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+	if !request.FollowLocation {
+		httpClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	} else {
+		httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			// the same limit as the default policy of http.Client
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return hosts.checkURL(req.URL)
+		}
+	}
+	return httpClient, nil
+}
+
+// applyTLSSecret loads the CA from the key ca.crt, and the client certificate from the keys tls.crt and tls.key.
+func applyTLSSecret(tlsConfig *tls.Config, secret corev1.Secret) error {
+	if ca, ok := secret.Data["ca.crt"]; ok {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return errors.New("no valid certificate in ca.crt")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	cert, hasCert := secret.Data[corev1.TLSCertKey]
+	key, hasKey := secret.Data[corev1.TLSPrivateKeyKey]
+	if hasCert != hasKey {
+		return errors.Errorf("%s and %s should be set together", corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+	}
+	if hasCert {
+		certificate, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return nil
+}
+
+// doHTTPRequest sends the request, and returns the response with at most maxHTTPResponseBodySize bytes of body.
+// The error of sending request or the unexpected status code is recorded in the returned status.
+func doHTTPRequest(ctx context.Context, httpClient *http.Client, request v1alpha1.HTTPRequestNodeSpec) (v1alpha1.HTTPResponseStatus, []byte) {
+	method := request.Method
+	if len(method) == 0 {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(ctx, method, request.URL, bytes.NewReader([]byte(request.Body)))
+	if err != nil {
+		return v1alpha1.HTTPResponseStatus{Error: errors.Wrap(err, "new http request").Error()}, nil
+	}
+	for key, values := range request.Headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return v1alpha1.HTTPResponseStatus{Error: errors.Wrap(err, "do http request").Error()}, nil
+	}
+	defer resp.Body.Close()
+
+	status := v1alpha1.HTTPResponseStatus{
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header,
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseBodySize))
+	if err != nil {
+		status.Error = errors.Wrap(err, "read response body").Error()
+		return status, body
+	}
+	if request.Criteria != nil && !statuscheckhttp.MatchStatusCode(request.Criteria.StatusCode, resp.StatusCode) {
+		status.Error = fmt.Sprintf("unexpected status code: %d", resp.StatusCode)
+	}
+	return status, body
+}
+
+// truncateHTTPResponseBody returns at most maxStoredHTTPResponseBodySize bytes of body to be stored in the status.
+func truncateHTTPResponseBody(body []byte) []byte {
+	if len(body) <= maxStoredHTTPResponseBodySize {
+		return body
+	}
+	return body[:maxStoredHTTPResponseBodySize]
+}
+
+// httpResponseEnv returns the variables exposed to the ConditionalBranches. The values of the same header are
+// joined with comma, and the body is decoded as JSON if possible.
+func httpResponseEnv(status v1alpha1.HTTPResponseStatus, body []byte) map[string]interface{} {
+	headers := make(map[string]string)
+	for key, values := range status.Headers {
+		headers[http.CanonicalHeaderKey(key)] = strings.Join(values, ",")
+	}
+
+	var decodedBody interface{}
+	if err := json.Unmarshal(body, &decodedBody); err != nil {
+		decodedBody = string(body)
+	}
+
+	return map[string]interface{}{
+		httpEnvStatusCode: status.StatusCode,
+		httpEnvHeaders:    headers,
+		httpEnvBody:       decodedBody,
+	}
+}
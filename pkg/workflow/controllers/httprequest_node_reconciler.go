@@ -0,0 +1,316 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/workflow/task"
+)
+
+// HTTPRequestNodeReconciler watches on nodes which type is HTTPRequest
+type HTTPRequestNodeReconciler struct {
+	*ChildNodesFetcher
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+	hosts         *httpHostFilter
+	// inFlight records the UID of nodes whose request is being sent
+	inFlight sync.Map
+}
+
+func NewHTTPRequestNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger, hosts *httpHostFilter) *HTTPRequestNodeReconciler {
+	return &HTTPRequestNodeReconciler{
+		ChildNodesFetcher: NewChildNodesFetcher(kubeClient, logger),
+		kubeClient:        kubeClient,
+		eventRecorder:     eventRecorder,
+		logger:            logger,
+		hosts:             hosts,
+	}
+}
+
+// Reconcile should be invoked by: changes on a HTTPRequest node, or changes on a node which controlled by it.
+//
+// HTTPRequest node sends the request once, and records the response in v1alpha1.WorkflowNodeStatus HTTPResponse.
+// Then the ConditionalBranches are evaluated with the response, the selected branches are spawned as child nodes
+// like Task node. The request is sent in background to avoid blocking the other nodes, and it would be sent again if
+// the controller failed to record the response.
+func (it *HTTPRequestNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		it.logger.V(4).Info("Finished syncing for HTTPRequest node",
+			"node", request.NamespacedName,
+			"duration", time.Since(startTime),
+		)
+	}()
+
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// only resolve HTTPRequest nodes
+	if node.Spec.Type != v1alpha1.TypeHTTPRequest {
+		return reconcile.Result{}, nil
+	}
+
+	it.logger.V(4).Info("resolve HTTPRequest node", "node", request)
+
+	if node.Status.HTTPResponse == nil {
+		if WorkflowNodeFinished(node.Status) || WorkflowNodePaused(node) || node.Spec.HTTPRequest == nil {
+			return reconcile.Result{}, nil
+		}
+		timeout, err := node.Spec.HTTPRequest.GetTimeout()
+		if err != nil || timeout > v1alpha1.MaxHTTPRequestTimeout {
+			timeout = v1alpha1.MaxHTTPRequestTimeout
+		}
+		if _, loaded := it.inFlight.LoadOrStore(node.UID, struct{}{}); !loaded {
+			go func() {
+				defer it.inFlight.Delete(node.UID)
+				// the context of Reconcile is not used, the request is limited by the timeout of http client
+				if err := it.sendRequest(context.Background(), node); err != nil {
+					it.logger.Error(err, "failed to send HTTPRequest",
+						"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+				}
+			}()
+		}
+		// the update of status would trigger the next reconcile, requeue in case that the response is not recorded
+		return reconcile.Result{RequeueAfter: timeout + time.Second}, nil
+	}
+
+	err = it.syncChildNodes(ctx, node)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// update the status of children workflow nodes
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		activeChildren, finishedChildren, err := it.fetchChildNodes(ctx, nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		nodeNeedUpdate.Status.FinishedChildren = nil
+		for _, finishedChild := range finishedChildren {
+			nodeNeedUpdate.Status.FinishedChildren = append(nodeNeedUpdate.Status.FinishedChildren,
+				corev1.LocalObjectReference{
+					Name: finishedChild.Name,
+				})
+		}
+
+		nodeNeedUpdate.Status.ActiveChildren = nil
+		for _, activeChild := range activeChildren {
+			nodeNeedUpdate.Status.ActiveChildren = append(nodeNeedUpdate.Status.ActiveChildren,
+				corev1.LocalObjectReference{
+					Name: activeChild.Name,
+				})
+		}
+
+		markFailedByChildren(&nodeNeedUpdate.Status, finishedChildren)
+
+		if len(finishedChildren) == len(selectedBranches(nodeNeedUpdate.Status)) {
+			if !WorkflowNodeFinished(nodeNeedUpdate.Status) {
+				it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+			}
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionTrue,
+				Reason: "",
+			})
+		} else {
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionFalse,
+				Reason: "",
+			})
+		}
+
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+
+	return reconcile.Result{}, client.IgnoreNotFound(updateError)
+}
+
+// sendRequest sends the request and records the response and the evaluated ConditionalBranches.
+func (it *HTTPRequestNodeReconciler) sendRequest(ctx context.Context, node v1alpha1.WorkflowNode) error {
+	var response v1alpha1.HTTPResponseStatus
+	var body []byte
+	httpClient, err := newHTTPClient(ctx, it.kubeClient, node.Namespace, *node.Spec.HTTPRequest, it.hosts)
+	if err != nil {
+		response.Error = err.Error()
+	} else {
+		response, body = doHTTPRequest(ctx, httpClient, *node.Spec.HTTPRequest)
+	}
+	env := httpResponseEnv(response, body)
+
+	evaluator := task.NewEvaluator(it.logger, it.kubeClient)
+	branches, err := evaluator.EvaluateConditionBranches(node.Spec.ConditionalBranches, env)
+	if err != nil {
+		it.logger.Error(err, "failed to evaluate expression",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		)
+		return err
+	}
+
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, types.NamespacedName{Namespace: node.Namespace, Name: node.Name}, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		if nodeNeedUpdate.Status.HTTPResponse != nil {
+			return nil
+		}
+
+		nodeNeedUpdate.Status.HTTPResponse = &response
+		nodeNeedUpdate.Status.ConditionalBranchesStatus = &v1alpha1.ConditionalBranchesStatus{
+			Branches: branches,
+		}
+		jsonString, err := json.Marshal(httpResponseEnv(response, truncateHTTPResponseBody(body)))
+		if err != nil {
+			it.logger.Error(err, "failed to convert env to json",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"env", env)
+		} else {
+			nodeNeedUpdate.Status.ConditionalBranchesStatus.Context = []string{string(jsonString)}
+		}
+
+		if len(response.Error) > 0 {
+			it.eventRecorder.Event(&nodeNeedUpdate, recorder.HTTPRequestFailed{Err: response.Error})
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: v1alpha1.HTTPRequestFailed,
+			})
+		} else {
+			it.eventRecorder.Event(&nodeNeedUpdate, recorder.HTTPRequestSent{StatusCode: response.StatusCode})
+		}
+		it.eventRecorder.Event(&nodeNeedUpdate, recorder.ConditionalBranchesSelected{SelectedBranches: selectedBranches(nodeNeedUpdate.Status)})
+
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+	if client.IgnoreNotFound(updateError) != nil {
+		it.logger.Error(updateError, "failed to record the response of HTTPRequest",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return updateError
+	}
+	return nil
+}
+
+// syncChildNodes spawns the child nodes for the selected branches.
+func (it *HTTPRequestNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha1.WorkflowNode) error {
+	if WorkflowNodeFinished(node.Status) || WorkflowNodePaused(node) {
+		return nil
+	}
+
+	activeChildNodes, finishedChildNodes, err := it.fetchChildNodes(ctx, node)
+	if err != nil {
+		return err
+	}
+	spawned := make(map[string]struct{})
+	for _, childNode := range append(activeChildNodes, finishedChildNodes...) {
+		spawned[getTaskNameFromGeneratedName(childNode.GetName())] = struct{}{}
+	}
+
+	var tasksToStartup []string
+	for _, target := range selectedBranches(node.Status) {
+		if _, ok := spawned[target]; !ok {
+			tasksToStartup = append(tasksToStartup, target)
+		}
+	}
+	if len(tasksToStartup) == 0 {
+		it.logger.V(4).Info("no need to spawn new child node", "node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return nil
+	}
+
+	parentWorkflow := v1alpha1.Workflow{}
+	err = it.kubeClient.Get(ctx, types.NamespacedName{
+		Namespace: node.Namespace,
+		Name:      node.Spec.WorkflowName,
+	}, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch parent workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"workflow name", node.Spec.WorkflowName)
+		return err
+	}
+
+	outputs, err := fetchWorkflowOutputs(ctx, it.kubeClient, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch outputs of workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return err
+	}
+
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, outputs, tasksToStartup...)
+	if err != nil {
+		it.logger.Error(err, "failed to render children childNodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return failByUnresolvedOutputs(ctx, it.kubeClient, it.eventRecorder, &node, err)
+	}
+
+	var childrenNames []string
+	for _, childNode := range childNodes {
+		err := it.kubeClient.Create(ctx, childNode)
+		if err != nil {
+			it.logger.Error(err, "failed to create child node",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"child node", childNode)
+			return err
+		}
+		childrenNames = append(childrenNames, childNode.Name)
+	}
+	it.eventRecorder.Event(&node, recorder.NodesCreated{ChildNodes: childrenNames})
+	it.logger.Info("HTTPRequest node spawn new child node",
+		"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		"child node", childrenNames)
+
+	return nil
+}
+
+// selectedBranches returns the targets of the branches which are evaluated as true.
+func selectedBranches(status v1alpha1.WorkflowNodeStatus) []string {
+	if status.ConditionalBranchesStatus == nil {
+		return nil
+	}
+	var result []string
+	for _, branch := range status.ConditionalBranchesStatus.Branches {
+		if branch.EvaluationResult == corev1.ConditionTrue {
+			result = append(result, branch.Target)
+		}
+	}
+	return result
+}
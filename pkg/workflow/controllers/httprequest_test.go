@@ -0,0 +1,240 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/config"
+)
+
+func Test_doHTTPRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/echo":
+			body, _ := io.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Method", r.Method)
+			w.Header().Set("X-Token", r.Header.Get("X-Token"))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write(body)
+		case "/redirect":
+			http.Redirect(w, r, "/echo", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	send := func(request v1alpha1.HTTPRequestNodeSpec) (v1alpha1.HTTPResponseStatus, []byte) {
+		httpClient, err := newHTTPClient(context.Background(), nil, "default", request, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return doHTTPRequest(context.Background(), httpClient, request)
+	}
+
+	t.Run("post with headers and body", func(t *testing.T) {
+		g := NewWithT(t)
+		status, body := send(v1alpha1.HTTPRequestNodeSpec{
+			URL:      server.URL + "/echo",
+			Method:   http.MethodPost,
+			Headers:  http.Header{"X-Token": []string{"secret"}},
+			Body:     `{"items": [{"name": "web-show"}], "count": 1}`,
+			Criteria: &v1alpha1.HTTPCriteria{StatusCode: "200-299"},
+		})
+		g.Expect(status.Error).Should(BeEmpty())
+		g.Expect(status.StatusCode).Should(Equal(http.StatusCreated))
+		g.Expect(status.Headers.Get("X-Method")).Should(Equal(http.MethodPost))
+		g.Expect(status.Headers.Get("X-Token")).Should(Equal("secret"))
+
+		env := httpResponseEnv(status, body)
+		g.Expect(env[httpEnvStatusCode]).Should(Equal(http.StatusCreated))
+		g.Expect(env[httpEnvHeaders]).Should(HaveKeyWithValue("Content-Type", "application/json"))
+		g.Expect(env[httpEnvBody]).Should(HaveKeyWithValue("count", float64(1)))
+	})
+
+	t.Run("unexpected status code", func(t *testing.T) {
+		g := NewWithT(t)
+		status, body := send(v1alpha1.HTTPRequestNodeSpec{
+			URL:      server.URL + "/not-found",
+			Criteria: &v1alpha1.HTTPCriteria{StatusCode: "200"},
+		})
+		g.Expect(status.StatusCode).Should(Equal(http.StatusNotFound))
+		g.Expect(status.Error).Should(Equal("unexpected status code: 404"))
+		g.Expect(httpResponseEnv(status, body)[httpEnvBody]).Should(Equal("404 page not found\n"))
+	})
+
+	t.Run("redirect is not followed by default", func(t *testing.T) {
+		g := NewWithT(t)
+		status, _ := send(v1alpha1.HTTPRequestNodeSpec{URL: server.URL + "/redirect"})
+		g.Expect(status.StatusCode).Should(Equal(http.StatusFound))
+
+		status, _ = send(v1alpha1.HTTPRequestNodeSpec{URL: server.URL + "/redirect", FollowLocation: true})
+		g.Expect(status.StatusCode).Should(Equal(http.StatusCreated))
+	})
+
+	t.Run("request could not be sent", func(t *testing.T) {
+		g := NewWithT(t)
+		status, body := send(v1alpha1.HTTPRequestNodeSpec{URL: "http://127.0.0.1:0"})
+		g.Expect(status.StatusCode).Should(Equal(0))
+		g.Expect(strings.HasPrefix(status.Error, "do http request")).Should(BeTrue())
+		g.Expect(body).Should(BeNil())
+	})
+}
+
+func Test_httpHostFilter(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := newHTTPHostFilter([]string{"10.0.0.0/33"}, nil)
+	g.Expect(err).Should(HaveOccurred())
+
+	filter, err := newHTTPHostFilter([]string{"*.example.com", "10.0.0.0/8"}, []string{"admin.example.com", "10.0.0.1/32"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	check := func(rawURL string) error {
+		u, err := url.Parse(rawURL)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		return filter.checkURL(u)
+	}
+	g.Expect(check("https://web-show.example.com/api")).Should(Succeed())
+	g.Expect(check("http://10.1.2.3:8080")).Should(Succeed())
+	g.Expect(check("https://admin.example.com")).ShouldNot(Succeed())
+	g.Expect(check("http://10.0.0.1")).ShouldNot(Succeed())
+	g.Expect(check("http://example.org")).ShouldNot(Succeed())
+	g.Expect(filter.checkAddress("tcp", "10.0.0.1:80", nil)).ShouldNot(Succeed())
+	g.Expect(filter.checkAddress("tcp", "10.0.0.2:80", nil)).Should(Succeed())
+
+	var empty *httpHostFilter
+	g.Expect(empty.checkURL(&url.URL{Host: "169.254.169.254"})).Should(Succeed())
+
+	// localhost is resolved to the loopback addresses which are denied
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	denyLoopback, err := newHTTPHostFilter(nil, []string{"127.0.0.0/8", "::1/128"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	request := v1alpha1.HTTPRequestNodeSpec{URL: strings.Replace(server.URL, "127.0.0.1", "localhost", 1)}
+	httpClient, err := newHTTPClient(context.Background(), nil, "default", request, denyLoopback)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	status, _ := doHTTPRequest(context.Background(), httpClient, request)
+	g.Expect(status.Error).Should(ContainSubstring("is denied"))
+}
+
+func Test_httpHostFilterDefaultDeniedHosts(t *testing.T) {
+	g := NewWithT(t)
+
+	configField, ok := reflect.TypeOf(config.ChaosControllerConfig{}).FieldByName("WorkflowHTTPRequestDeniedHosts")
+	g.Expect(ok).To(BeTrue())
+	filter, err := newHTTPHostFilter(nil, strings.Split(configField.Tag.Get("default"), ","))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	for _, rawURL := range []string{
+		"http://127.0.0.1:8080",
+		"http://127.1.2.3",
+		"http://[::1]:8080",
+		"http://[0:0:0:0:0:0:0:1]",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[fe80::1]",
+		"http://[fd00:ec2::254]/latest/meta-data",
+	} {
+		u, err := url.Parse(rawURL)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(filter.checkURL(u)).ShouldNot(Succeed(), rawURL)
+	}
+	g.Expect(filter.checkURL(&url.URL{Host: "10.0.0.1"})).Should(Succeed())
+	g.Expect(filter.checkAddress("tcp", "[::ffff:127.0.0.1]:80", nil)).ShouldNot(Succeed())
+	g.Expect(filter.checkAddress("tcp", "[fd00:ec2::254]:80", nil)).ShouldNot(Succeed())
+	g.Expect(filter.checkAddress("tcp", "10.0.0.1:80", nil)).Should(Succeed())
+
+	// the loopback server could not be reached with the default denied hosts
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	request := v1alpha1.HTTPRequestNodeSpec{URL: server.URL}
+	_, err = newHTTPClient(context.Background(), nil, "default", request, filter)
+	g.Expect(err).Should(MatchError(ContainSubstring("host 127.0.0.1 is denied")))
+}
+
+func Test_httpHostFilterRedirect(t *testing.T) {
+	g := NewWithT(t)
+
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, strings.Replace(target.URL, "127.0.0.1", "localhost", 1), http.StatusFound)
+	}))
+	defer server.Close()
+
+	filter, err := newHTTPHostFilter(nil, []string{"localhost"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// the redirection is not followed
+	request := v1alpha1.HTTPRequestNodeSpec{URL: server.URL}
+	httpClient, err := newHTTPClient(context.Background(), nil, "default", request, filter)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	status, _ := doHTTPRequest(context.Background(), httpClient, request)
+	g.Expect(status.Error).Should(BeEmpty())
+	g.Expect(status.StatusCode).Should(Equal(http.StatusFound))
+
+	// the redirection to the denied host is refused
+	request.FollowLocation = true
+	httpClient, err = newHTTPClient(context.Background(), nil, "default", request, filter)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	status, _ = doHTTPRequest(context.Background(), httpClient, request)
+	g.Expect(status.Error).Should(ContainSubstring("host localhost is denied"))
+	g.Expect(redirected).Should(BeFalse())
+}
+
+func Test_truncateHTTPResponseBody(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(truncateHTTPResponseBody([]byte("ok"))).Should(Equal([]byte("ok")))
+	g.Expect(truncateHTTPResponseBody(make([]byte, maxHTTPResponseBodySize))).Should(HaveLen(maxStoredHTTPResponseBodySize))
+}
+
+func Test_applyTLSSecret(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(applyTLSSecret(&tls.Config{}, corev1.Secret{})).Should(Succeed())
+	g.Expect(applyTLSSecret(&tls.Config{}, corev1.Secret{Data: map[string][]byte{"ca.crt": []byte("not a certificate")}})).ShouldNot(Succeed())
+	g.Expect(applyTLSSecret(&tls.Config{}, corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: []byte("cert")}})).ShouldNot(Succeed())
+}
+
+func Test_selectedBranches(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(selectedBranches(v1alpha1.WorkflowNodeStatus{})).Should(BeEmpty())
+	g.Expect(selectedBranches(v1alpha1.WorkflowNodeStatus{
+		ConditionalBranchesStatus: &v1alpha1.ConditionalBranchesStatus{
+			Branches: []v1alpha1.ConditionalBranchStatus{
+				{Target: "a", EvaluationResult: corev1.ConditionTrue},
+				{Target: "b", EvaluationResult: corev1.ConditionFalse},
+				{Target: "c", EvaluationResult: corev1.ConditionUnknown},
+				{Target: "d", EvaluationResult: corev1.ConditionTrue},
+			},
+		},
+	})).Should(Equal([]string{"a", "d"}))
+}
@@ -0,0 +1,248 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+// K8sApplyNodeReconciler watches on nodes which type is K8sApply
+type K8sApplyNodeReconciler struct {
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+	// securityMode is required, because the creator of workflow is only authorized by the webhook in security mode
+	securityMode bool
+}
+
+func NewK8sApplyNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger, securityMode bool) *K8sApplyNodeReconciler {
+	return &K8sApplyNodeReconciler{
+		kubeClient:    kubeClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+		securityMode:  securityMode,
+	}
+}
+
+// Reconcile should be invoked by: changes on a K8sApply node.
+//
+// K8sApply node takes a snapshot of the target resource before applying, and records it in v1alpha1.WorkflowNodeStatus
+// K8sApply, so the resource could be reverted by K8sRevertReconciler when the workflow is accomplished, or by this
+// reconciler before the node with v1alpha1.K8sRevertFinalizer is deleted. Then the resource is applied, applying
+// again after a failed status update is harmless. The resource which did not exist is created instead, and it's
+// recorded as created only after the creation succeeded, so the resource created by others is never deleted.
+func (it *K8sApplyNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		it.logger.V(4).Info("Finished syncing for K8sApply node",
+			"node", request.NamespacedName,
+			"duration", time.Since(startTime),
+		)
+	}()
+
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// only resolve K8sApply nodes
+	if node.Spec.Type != v1alpha1.TypeK8sApply {
+		return reconcile.Result{}, nil
+	}
+	if !node.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, it.revertBeforeDeletion(ctx, node)
+	}
+	if WorkflowNodeFinished(node.Status) || WorkflowNodePaused(node) || node.Spec.K8sApply == nil {
+		return reconcile.Result{}, nil
+	}
+
+	it.logger.V(4).Info("resolve K8sApply node", "node", request)
+
+	if !it.securityMode {
+		return reconcile.Result{}, it.finish(ctx, request, "", errors.New("K8sApply is not allowed when the security mode is disabled"))
+	}
+
+	if node.Spec.K8sApply.Revert && !controllerutil.ContainsFinalizer(&node, v1alpha1.K8sRevertFinalizer) {
+		updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			nodeNeedUpdate := v1alpha1.WorkflowNode{}
+			err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+			if err != nil {
+				return err
+			}
+			if !nodeNeedUpdate.DeletionTimestamp.IsZero() {
+				return nil
+			}
+			controllerutil.AddFinalizer(&nodeNeedUpdate, v1alpha1.K8sRevertFinalizer)
+			return it.kubeClient.Update(ctx, &nodeNeedUpdate)
+		})
+		// the snapshot is taken on the next round, after the finalizer is added
+		return reconcile.Result{}, client.IgnoreNotFound(updateError)
+	}
+
+	obj, ref, err := resolveK8sApplyTarget(it.kubeClient, node)
+	if err != nil {
+		return reconcile.Result{}, it.finish(ctx, request, "", err)
+	}
+
+	if node.Status.K8sApply == nil {
+		status := v1alpha1.K8sApplyStatus{Target: ref}
+		current, err := getK8sResource(ctx, it.kubeClient, ref)
+		if err != nil {
+			it.logger.Error(err, "failed to fetch the resource to apply",
+				"node", request.NamespacedName,
+				"resource", formatK8sResourceReference(ref),
+			)
+			return reconcile.Result{}, err
+		}
+		if current != nil {
+			if status.Original, err = snapshotK8sResource(current); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
+		updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			nodeNeedUpdate := v1alpha1.WorkflowNode{}
+			err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+			if err != nil {
+				return err
+			}
+			if nodeNeedUpdate.Status.K8sApply != nil {
+				return nil
+			}
+			nodeNeedUpdate.Status.K8sApply = &status
+			return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+		})
+		// the resource is applied on the next round, after the snapshot is recorded
+		return reconcile.Result{}, client.IgnoreNotFound(updateError)
+	}
+
+	if node.Spec.K8sApply.Patch == nil && len(node.Status.K8sApply.Original) == 0 {
+		// the resource did not exist when taking the snapshot
+		err = createK8sResource(ctx, it.kubeClient, obj)
+		if apierrors.IsAlreadyExists(err) {
+			err = errors.Wrap(err, "the resource has been created by others after taking the snapshot")
+		}
+		if err != nil {
+			return reconcile.Result{}, it.finish(ctx, request, "", err)
+		}
+		return reconcile.Result{}, it.finish(ctx, request, obj.GetUID(), nil)
+	}
+
+	err = applyK8sResource(ctx, it.kubeClient, *node.Spec.K8sApply, obj)
+	return reconcile.Result{}, it.finish(ctx, request, "", err)
+}
+
+// revertBeforeDeletion reverts the resource if it's not reverted yet, then removes the v1alpha1.K8sRevertFinalizer.
+// The resource which has been deleted or recreated by others is not reverted, and the finalizer is removed as well.
+func (it *K8sApplyNodeReconciler) revertBeforeDeletion(ctx context.Context, node v1alpha1.WorkflowNode) error {
+	if !controllerutil.ContainsFinalizer(&node, v1alpha1.K8sRevertFinalizer) {
+		return nil
+	}
+
+	if k8sResourceRevertible(node) {
+		resource := formatK8sResourceReference(node.Status.K8sApply.Target)
+		err := revertK8sResource(ctx, it.kubeClient, *node.Status.K8sApply)
+		if err != nil {
+			it.eventRecorder.Event(&node, recorder.K8sResourceRevertFailed{Resource: resource, Err: err.Error()})
+			if !errors.Is(err, errK8sResourceReplaced) {
+				return err
+			}
+		} else {
+			it.eventRecorder.Event(&node, recorder.K8sResourceReverted{Resource: resource})
+		}
+	}
+
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, types.NamespacedName{Namespace: node.Namespace, Name: node.Name}, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		if !controllerutil.RemoveFinalizer(&nodeNeedUpdate, v1alpha1.K8sRevertFinalizer) {
+			return nil
+		}
+		return it.kubeClient.Update(ctx, &nodeNeedUpdate)
+	})
+	return client.IgnoreNotFound(updateError)
+}
+
+// finish records the result of applying, the node is failed if the resource could not be applied. The createdUID is
+// the uid of the resource created by the node, it's empty if the resource is not created.
+func (it *K8sApplyNodeReconciler) finish(ctx context.Context, request reconcile.Request, createdUID types.UID, applyError error) error {
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		if WorkflowNodeFinished(nodeNeedUpdate.Status) {
+			return nil
+		}
+
+		if len(createdUID) > 0 && nodeNeedUpdate.Status.K8sApply != nil {
+			nodeNeedUpdate.Status.K8sApply.Created = true
+			nodeNeedUpdate.Status.K8sApply.UID = createdUID
+		}
+
+		resource := ""
+		if nodeNeedUpdate.Status.K8sApply != nil {
+			resource = formatK8sResourceReference(nodeNeedUpdate.Status.K8sApply.Target)
+		}
+		if applyError != nil {
+			if nodeNeedUpdate.Status.K8sApply == nil {
+				nodeNeedUpdate.Status.K8sApply = &v1alpha1.K8sApplyStatus{}
+			}
+			nodeNeedUpdate.Status.K8sApply.Error = applyError.Error()
+			it.eventRecorder.Event(&nodeNeedUpdate, recorder.K8sResourceApplyFailed{Resource: resource, Err: applyError.Error()})
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: v1alpha1.K8sResourceApplyFailed,
+			})
+		} else {
+			it.eventRecorder.Event(&nodeNeedUpdate, recorder.K8sResourceApplied{Resource: resource})
+		}
+		it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+		SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+			Type:   v1alpha1.ConditionAccomplished,
+			Status: corev1.ConditionTrue,
+			Reason: "",
+		})
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+	if client.IgnoreNotFound(updateError) != nil {
+		it.logger.Error(updateError, "failed to record the result of K8sApply",
+			"node", fmt.Sprintf("%s/%s", request.Namespace, request.Name))
+		return updateError
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// k8sApplyFieldManager is the field manager of the resources applied by K8sApply node.
+const k8sApplyFieldManager = "chaos-mesh-workflow"
+
+// errK8sResourceReplaced means the resource applied by K8sApply node has been deleted or recreated by others, so
+// it would never be reverted.
+var errK8sResourceReplaced = errors.New("the resource has been deleted or recreated")
+
+// resolveK8sApplyTarget returns the object to apply and its reference, the namespace of namespaced resource is
+// defaulted to the namespace of the workflow node.
+func resolveK8sApplyTarget(kubeClient client.Client, node v1alpha1.WorkflowNode) (*unstructured.Unstructured, v1alpha1.K8sResourceReference, error) {
+	spec := node.Spec.K8sApply
+
+	obj := &unstructured.Unstructured{}
+	if spec.Patch != nil {
+		obj.SetAPIVersion(spec.Patch.Target.APIVersion)
+		obj.SetKind(spec.Patch.Target.Kind)
+		obj.SetNamespace(spec.Patch.Target.Namespace)
+		obj.SetName(spec.Patch.Target.Name)
+	} else {
+		var err error
+		obj, err = spec.ParseManifest()
+		if err != nil {
+			return nil, v1alpha1.K8sResourceReference{}, errors.Wrap(err, "parse manifest")
+		}
+	}
+
+	if err := defaultK8sNamespace(kubeClient, obj, node.Namespace); err != nil {
+		return nil, v1alpha1.K8sResourceReference{}, err
+	}
+	return obj, k8sResourceReferenceOf(obj), nil
+}
+
+// defaultK8sNamespace sets the namespace of the namespaced object if it's empty, and clears it for cluster-scoped object.
+func defaultK8sNamespace(kubeClient client.Client, obj *unstructured.Unstructured, namespace string) error {
+	namespaced, err := kubeClient.IsObjectNamespaced(obj)
+	if err != nil {
+		return errors.Wrapf(err, "resolve scope of %s", obj.GroupVersionKind())
+	}
+	if !namespaced {
+		obj.SetNamespace("")
+	} else if len(obj.GetNamespace()) == 0 {
+		obj.SetNamespace(namespace)
+	}
+	return nil
+}
+
+func k8sResourceReferenceOf(obj *unstructured.Unstructured) v1alpha1.K8sResourceReference {
+	return v1alpha1.K8sResourceReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// getK8sResource fetches the referred resource, it returns nil if the resource does not exist.
+func getK8sResource(ctx context.Context, kubeClient client.Client, ref v1alpha1.K8sResourceReference) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	err := kubeClient.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, obj)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// snapshotK8sResource returns the JSON of the resource without the fields maintained by the apiserver, the uid is
+// kept so the reverting would not override a recreated resource.
+func snapshotK8sResource(obj *unstructured.Unstructured) (string, error) {
+	snapshot := obj.DeepCopy()
+	unstructured.RemoveNestedField(snapshot.Object, "status")
+	unstructured.RemoveNestedField(snapshot.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(snapshot.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(snapshot.Object, "metadata", "creationTimestamp")
+	data, err := json.Marshal(snapshot.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// createK8sResource creates the resource of manifest which did not exist when taking the snapshot, the obj is
+// updated with the created one. It fails if the resource has been created by others after taking the snapshot.
+func createK8sResource(ctx context.Context, kubeClient client.Client, obj *unstructured.Unstructured) error {
+	return kubeClient.Create(ctx, obj, client.FieldOwner(k8sApplyFieldManager))
+}
+
+// applyK8sResource applies the manifest with server-side apply or patches the target resource.
+func applyK8sResource(ctx context.Context, kubeClient client.Client, spec v1alpha1.K8sApplySpec, obj *unstructured.Unstructured) error {
+	if spec.Patch == nil {
+		return kubeClient.Patch(ctx, obj, client.Apply, client.FieldOwner(k8sApplyFieldManager), client.ForceOwnership)
+	}
+
+	data, err := utilyaml.ToJSON([]byte(spec.Patch.Data))
+	if err != nil {
+		return errors.Wrap(err, "parse patch")
+	}
+	var patchType types.PatchType
+	switch spec.Patch.GetPatchType() {
+	case v1alpha1.K8sPatchTypeMerge:
+		patchType = types.MergePatchType
+	case v1alpha1.K8sPatchTypeJSON:
+		patchType = types.JSONPatchType
+	default:
+		patchType = types.StrategicMergePatchType
+	}
+	return kubeClient.Patch(ctx, obj, client.RawPatch(patchType, data), client.FieldOwner(k8sApplyFieldManager))
+}
+
+// revertK8sResource deletes the resource created by K8sApply node, or restores it with the snapshot. The resource
+// deleted or recreated by others is left alone, but the reverting is last-writer-wins: the changes made by others
+// after applying are overridden by the snapshot.
+func revertK8sResource(ctx context.Context, kubeClient client.Client, status v1alpha1.K8sApplyStatus) error {
+	current, err := getK8sResource(ctx, kubeClient, status.Target)
+	if err != nil {
+		return err
+	}
+
+	if status.Created {
+		if current == nil {
+			return nil
+		}
+		if len(status.UID) > 0 && current.GetUID() != status.UID {
+			return errors.Wrap(errK8sResourceReplaced, formatK8sResourceReference(status.Target))
+		}
+		options := []client.DeleteOption{
+			// some resources orphan their dependents by default, such as Job
+			client.PropagationPolicy(metav1.DeletePropagationBackground),
+		}
+		if len(status.UID) > 0 {
+			options = append(options, client.Preconditions{UID: &status.UID})
+		}
+		return client.IgnoreNotFound(kubeClient.Delete(ctx, current, options...))
+	}
+
+	original := &unstructured.Unstructured{}
+	if err := original.UnmarshalJSON([]byte(status.Original)); err != nil {
+		return errors.Wrap(err, "parse original resource")
+	}
+	if current == nil || current.GetUID() != original.GetUID() {
+		return errors.Wrap(errK8sResourceReplaced, formatK8sResourceReference(status.Target))
+	}
+	original.SetResourceVersion(current.GetResourceVersion())
+	return kubeClient.Update(ctx, original)
+}
+
+// evaluateJSONPath returns the result of the JSONPath template against the object, it's empty if the object is nil
+// or the field does not exist.
+func evaluateJSONPath(obj *unstructured.Unstructured, template string) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	parser := jsonpath.New("k8s-wait").AllowMissingKeys(true)
+	if err := parser.Parse(template); err != nil {
+		return "", err
+	}
+	buffer := &bytes.Buffer{}
+	if err := parser.Execute(buffer, obj.Object); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// k8sWaitConditionMet returns true if the value satisfies the K8sWait node.
+func k8sWaitConditionMet(spec v1alpha1.K8sWaitSpec, value string) bool {
+	if len(spec.Value) == 0 {
+		return len(value) > 0
+	}
+	return value == spec.Value
+}
+
+func formatK8sResourceReference(ref v1alpha1.K8sResourceReference) string {
+	if len(ref.Namespace) == 0 {
+		return fmt.Sprintf("%s %s", ref.Kind, ref.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", ref.Kind, ref.Namespace, ref.Name)
+}
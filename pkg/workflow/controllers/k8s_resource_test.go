@@ -0,0 +1,225 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newK8sResourceTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).WithObjects(objs...).Build()
+}
+
+func Test_resolveK8sApplyTarget(t *testing.T) {
+	kubeClient := newK8sResourceTestClient()
+
+	t.Run("namespace of manifest is defaulted", func(t *testing.T) {
+		g := NewWithT(t)
+		node := v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "chaos-testing"},
+			Spec: v1alpha1.WorkflowNodeSpec{K8sApply: &v1alpha1.K8sApplySpec{Manifest: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: web-show-config
+data:
+  mode: degraded
+`}},
+		}
+		obj, ref, err := resolveK8sApplyTarget(kubeClient, node)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(ref).Should(Equal(v1alpha1.K8sResourceReference{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Namespace:  "chaos-testing",
+			Name:       "web-show-config",
+		}))
+		g.Expect(obj.GetNamespace()).Should(Equal("chaos-testing"))
+	})
+
+	t.Run("namespace of cluster-scoped resource is cleared", func(t *testing.T) {
+		g := NewWithT(t)
+		node := v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "chaos-testing"},
+			Spec: v1alpha1.WorkflowNodeSpec{K8sApply: &v1alpha1.K8sApplySpec{Patch: &v1alpha1.K8sPatch{
+				Target: v1alpha1.K8sResourceReference{APIVersion: "v1", Kind: "Namespace", Namespace: "chaos-testing", Name: "web-show"},
+				Data:   `{"metadata": {"labels": {"chaos": "true"}}}`,
+			}}},
+		}
+		_, ref, err := resolveK8sApplyTarget(kubeClient, node)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(ref.Namespace).Should(BeEmpty())
+	})
+}
+
+func Test_applyAndRevertK8sResource(t *testing.T) {
+	ctx := context.Background()
+	ref := v1alpha1.K8sResourceReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "web-show-config"}
+	key := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+
+	t.Run("restore the patched resource", func(t *testing.T) {
+		g := NewWithT(t)
+		kubeClient := newK8sResourceTestClient(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ref.Namespace, Name: ref.Name},
+			Data:       map[string]string{"mode": "normal"},
+		})
+
+		current, err := getK8sResource(ctx, kubeClient, ref)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		original, err := snapshotK8sResource(current)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		spec := v1alpha1.K8sApplySpec{Patch: &v1alpha1.K8sPatch{
+			Target: ref,
+			Type:   v1alpha1.K8sPatchTypeMerge,
+			Data:   "data:\n  mode: degraded\n",
+		}}
+		g.Expect(applyK8sResource(ctx, kubeClient, spec, current)).Should(Succeed())
+		configMap := corev1.ConfigMap{}
+		g.Expect(kubeClient.Get(ctx, key, &configMap)).Should(Succeed())
+		g.Expect(configMap.Data).Should(HaveKeyWithValue("mode", "degraded"))
+
+		g.Expect(revertK8sResource(ctx, kubeClient, v1alpha1.K8sApplyStatus{Target: ref, Original: original})).Should(Succeed())
+		g.Expect(kubeClient.Get(ctx, key, &configMap)).Should(Succeed())
+		g.Expect(configMap.Data).Should(HaveKeyWithValue("mode", "normal"))
+	})
+
+	t.Run("delete the created resource", func(t *testing.T) {
+		g := NewWithT(t)
+		kubeClient := newK8sResourceTestClient(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ref.Namespace, Name: ref.Name},
+		})
+		g.Expect(revertK8sResource(ctx, kubeClient, v1alpha1.K8sApplyStatus{Target: ref, Created: true})).Should(Succeed())
+		current, err := getK8sResource(ctx, kubeClient, ref)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(current).Should(BeNil())
+
+		// the resource has been deleted by others
+		g.Expect(revertK8sResource(ctx, kubeClient, v1alpha1.K8sApplyStatus{Target: ref, Created: true})).Should(Succeed())
+	})
+
+	t.Run("create the resource only if it does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		kubeClient := newK8sResourceTestClient()
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(ref.APIVersion)
+		obj.SetKind(ref.Kind)
+		obj.SetNamespace(ref.Namespace)
+		obj.SetName(ref.Name)
+		g.Expect(createK8sResource(ctx, kubeClient, obj.DeepCopy())).Should(Succeed())
+		g.Expect(apierrors.IsAlreadyExists(createK8sResource(ctx, kubeClient, obj.DeepCopy()))).Should(BeTrue())
+	})
+
+	t.Run("the recreated resource is not reverted", func(t *testing.T) {
+		g := NewWithT(t)
+		kubeClient := newK8sResourceTestClient(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ref.Namespace, Name: ref.Name, UID: "recreated"},
+			Data:       map[string]string{"mode": "degraded"},
+		})
+
+		err := revertK8sResource(ctx, kubeClient, v1alpha1.K8sApplyStatus{Target: ref, Created: true, UID: "created"})
+		g.Expect(errors.Is(err, errK8sResourceReplaced)).Should(BeTrue())
+
+		original, err := snapshotK8sResource(&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"namespace": ref.Namespace, "name": ref.Name, "uid": "original"},
+			"data":       map[string]interface{}{"mode": "normal"},
+		}})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		err = revertK8sResource(ctx, kubeClient, v1alpha1.K8sApplyStatus{Target: ref, Original: original})
+		g.Expect(errors.Is(err, errK8sResourceReplaced)).Should(BeTrue())
+
+		configMap := corev1.ConfigMap{}
+		g.Expect(kubeClient.Get(ctx, key, &configMap)).Should(Succeed())
+		g.Expect(configMap.Data).Should(HaveKeyWithValue("mode", "degraded"))
+	})
+}
+
+func Test_k8sResourceRevertible(t *testing.T) {
+	g := NewWithT(t)
+	ref := v1alpha1.K8sResourceReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "web-show-config"}
+	node := v1alpha1.WorkflowNode{
+		Spec: v1alpha1.WorkflowNodeSpec{
+			Type:     v1alpha1.TypeK8sApply,
+			K8sApply: &v1alpha1.K8sApplySpec{Manifest: "{}", Revert: true},
+		},
+		Status: v1alpha1.WorkflowNodeStatus{K8sApply: &v1alpha1.K8sApplyStatus{Target: ref}},
+	}
+	// the resource did not exist, and it's not created yet
+	g.Expect(k8sResourceRevertible(node)).Should(BeFalse())
+
+	node.Status.K8sApply.Created = true
+	g.Expect(k8sResourceRevertible(node)).Should(BeTrue())
+	g.Expect(k8sResourceNeedRevert(node)).Should(BeFalse())
+
+	node.Status.Conditions = []v1alpha1.WorkflowNodeCondition{{Type: v1alpha1.ConditionAccomplished, Status: corev1.ConditionTrue}}
+	g.Expect(k8sResourceNeedRevert(node)).Should(BeTrue())
+
+	node.Status.K8sApply.Reverted = true
+	g.Expect(k8sResourceRevertible(node)).Should(BeFalse())
+}
+
+func Test_evaluateK8sWaitCondition(t *testing.T) {
+	g := NewWithT(t)
+	job := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"succeeded": int64(1),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Complete", "status": "True"},
+			},
+		},
+	}}
+
+	value, err := evaluateJSONPath(job, (&v1alpha1.K8sWaitSpec{JSONPath: ".status.succeeded"}).GetJSONPath())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(value).Should(Equal("1"))
+	g.Expect(k8sWaitConditionMet(v1alpha1.K8sWaitSpec{}, value)).Should(BeTrue())
+	g.Expect(k8sWaitConditionMet(v1alpha1.K8sWaitSpec{Value: "2"}, value)).Should(BeFalse())
+
+	value, err = evaluateJSONPath(job, `{.status.conditions[?(@.type=="Complete")].status}`)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(k8sWaitConditionMet(v1alpha1.K8sWaitSpec{Value: "True"}, value)).Should(BeTrue())
+
+	value, err = evaluateJSONPath(job, "{.status.failed}")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(k8sWaitConditionMet(v1alpha1.K8sWaitSpec{}, value)).Should(BeFalse())
+
+	value, err = evaluateJSONPath(nil, "{.status.succeeded}")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(value).Should(BeEmpty())
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+type K8sRevertReconciler struct {
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewK8sRevertReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *K8sRevertReconciler {
+	return &K8sRevertReconciler{
+		kubeClient:    kubeClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+// Reconcile watches `Workflows`, when the workflow is accomplished, the resources applied by its K8sApply nodes with
+// revert enabled are reverted in the reverse order of applying. The failed reverting is retried with backoff.
+func (it *K8sRevertReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	workflow := v1alpha1.Workflow{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &workflow)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !WorkflowConditionEqualsTo(workflow.Status, v1alpha1.WorkflowConditionAccomplished, corev1.ConditionTrue) {
+		return reconcile.Result{}, nil
+	}
+
+	nodes, err := fetchAllNodes(ctx, it.kubeClient, workflow)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "fetch nodes of workflow")
+	}
+	sortedNodes := SortByCreationTimestamp(nodes)
+	sort.Sort(sort.Reverse(sortedNodes))
+
+	var revertErrors []error
+	for _, node := range sortedNodes {
+		if !k8sResourceNeedRevert(node) {
+			continue
+		}
+		resource := formatK8sResourceReference(node.Status.K8sApply.Target)
+		revertError := revertK8sResource(ctx, it.kubeClient, *node.Status.K8sApply)
+
+		key := types.NamespacedName{Namespace: node.Namespace, Name: node.Name}
+		updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			nodeNeedUpdate := v1alpha1.WorkflowNode{}
+			err := it.kubeClient.Get(ctx, key, &nodeNeedUpdate)
+			if err != nil {
+				return err
+			}
+			if !k8sResourceNeedRevert(nodeNeedUpdate) {
+				return nil
+			}
+			if revertError != nil {
+				it.eventRecorder.Event(&nodeNeedUpdate, recorder.K8sResourceRevertFailed{Resource: resource, Err: revertError.Error()})
+				nodeNeedUpdate.Status.K8sApply.Error = revertError.Error()
+			} else {
+				it.eventRecorder.Event(&nodeNeedUpdate, recorder.K8sResourceReverted{Resource: resource})
+				nodeNeedUpdate.Status.K8sApply.Reverted = true
+				nodeNeedUpdate.Status.K8sApply.Error = ""
+			}
+			return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+		})
+		if client.IgnoreNotFound(updateError) != nil {
+			it.logger.Error(updateError, "failed to record the reverting of resource",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"resource", resource,
+			)
+			return reconcile.Result{}, updateError
+		}
+		// the resource which has been deleted or recreated would never be reverted, so it's not retried
+		if revertError != nil && !errors.Is(revertError, errK8sResourceReplaced) {
+			revertErrors = append(revertErrors, errors.Wrapf(revertError, "revert %s", resource))
+		}
+	}
+
+	if len(revertErrors) > 0 {
+		return reconcile.Result{}, errors.Errorf("failed to revert resources of workflow: %v", revertErrors)
+	}
+	return reconcile.Result{}, nil
+}
+
+// k8sResourceNeedRevert returns true if the accomplished node should revert the resource.
+func k8sResourceNeedRevert(node v1alpha1.WorkflowNode) bool {
+	return k8sResourceRevertible(node) &&
+		ConditionEqualsTo(node.Status, v1alpha1.ConditionAccomplished, corev1.ConditionTrue)
+}
+
+// k8sResourceRevertible returns true if the node has taken the snapshot of the resource or created it with revert
+// enabled, and it's not reverted yet.
+func k8sResourceRevertible(node v1alpha1.WorkflowNode) bool {
+	return node.Spec.Type == v1alpha1.TypeK8sApply &&
+		node.Spec.K8sApply != nil &&
+		node.Spec.K8sApply.Revert &&
+		node.Status.K8sApply != nil &&
+		len(node.Status.K8sApply.Target.Name) > 0 &&
+		(node.Status.K8sApply.Created || len(node.Status.K8sApply.Original) > 0) &&
+		!node.Status.K8sApply.Reverted
+}
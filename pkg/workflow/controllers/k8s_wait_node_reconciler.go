@@ -0,0 +1,172 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+// K8sWaitNodeReconciler watches on nodes which type is K8sWait
+type K8sWaitNodeReconciler struct {
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewK8sWaitNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *K8sWaitNodeReconciler {
+	return &K8sWaitNodeReconciler{
+		kubeClient:    kubeClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+// Reconcile should be invoked by: changes on a K8sWait node, or the requeue after the polling interval.
+//
+// K8sWait node polls the target resource and evaluates the JSONPath against it, the latest value is recorded in
+// v1alpha1.WorkflowNodeStatus K8sWait. The node is accomplished once the condition is met, and it's marked as failed
+// if the deadline exceeded before that.
+func (it *K8sWaitNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		it.logger.V(4).Info("Finished syncing for K8sWait node",
+			"node", request.NamespacedName,
+			"duration", time.Since(startTime),
+		)
+	}()
+
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// only resolve K8sWait nodes
+	if node.Spec.Type != v1alpha1.TypeK8sWait || node.Spec.K8sWait == nil {
+		return reconcile.Result{}, nil
+	}
+
+	if WorkflowNodeFinished(node.Status) {
+		if ConditionEqualsTo(node.Status, v1alpha1.ConditionDeadlineExceed, corev1.ConditionTrue) &&
+			!ConditionEqualsTo(node.Status, v1alpha1.ConditionAccomplished, corev1.ConditionTrue) &&
+			!WorkflowNodeFailed(node.Status) {
+			return reconcile.Result{}, it.markTimeout(ctx, request)
+		}
+		return reconcile.Result{}, nil
+	}
+	if WorkflowNodePaused(node) {
+		return reconcile.Result{}, nil
+	}
+
+	it.logger.V(4).Info("resolve K8sWait node", "node", request)
+
+	spec := *node.Spec.K8sWait
+	interval, err := spec.GetInterval()
+	if err != nil {
+		interval = v1alpha1.DefaultK8sWaitInterval
+	}
+
+	status := v1alpha1.K8sWaitStatus{}
+	met := false
+	target := &unstructured.Unstructured{}
+	target.SetAPIVersion(spec.Target.APIVersion)
+	target.SetKind(spec.Target.Kind)
+	target.SetNamespace(spec.Target.Namespace)
+	if err := defaultK8sNamespace(it.kubeClient, target, node.Namespace); err != nil {
+		status.Error = err.Error()
+	} else {
+		ref := spec.Target
+		ref.Namespace = target.GetNamespace()
+		current, err := getK8sResource(ctx, it.kubeClient, ref)
+		if err != nil {
+			status.Error = err.Error()
+		} else if status.Value, err = evaluateJSONPath(current, spec.GetJSONPath()); err != nil {
+			status.Error = err.Error()
+		} else {
+			met = k8sWaitConditionMet(spec, status.Value)
+		}
+	}
+
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		if WorkflowNodeFinished(nodeNeedUpdate.Status) {
+			return nil
+		}
+
+		nodeNeedUpdate.Status.K8sWait = &status
+		if met {
+			it.eventRecorder.Event(&nodeNeedUpdate, recorder.K8sWaitConditionMet{Value: status.Value})
+			it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionTrue,
+				Reason: v1alpha1.K8sWaitConditionMet,
+			})
+		}
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+	if client.IgnoreNotFound(updateError) != nil {
+		it.logger.Error(updateError, "failed to record the status of K8sWait", "node", request.NamespacedName)
+		return reconcile.Result{}, updateError
+	}
+
+	if met {
+		return reconcile.Result{}, nil
+	}
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
+
+// markTimeout marks the node as failed because the condition is not met before the deadline.
+func (it *K8sWaitNodeReconciler) markTimeout(ctx context.Context, request reconcile.Request) error {
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		if WorkflowNodeFailed(nodeNeedUpdate.Status) {
+			return nil
+		}
+
+		value := ""
+		if nodeNeedUpdate.Status.K8sWait != nil {
+			value = nodeNeedUpdate.Status.K8sWait.Value
+		}
+		it.eventRecorder.Event(&nodeNeedUpdate, recorder.K8sWaitTimeout{Value: value})
+		SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+			Type:   v1alpha1.ConditionFailed,
+			Status: corev1.ConditionTrue,
+			Reason: v1alpha1.K8sWaitTimeout,
+		})
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+	return client.IgnoreNotFound(updateError)
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+// LoopNodeReconciler watches on nodes which type is Loop
+type LoopNodeReconciler struct {
+	*ChildNodesFetcher
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewLoopNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *LoopNodeReconciler {
+	return &LoopNodeReconciler{
+		ChildNodesFetcher: NewChildNodesFetcher(kubeClient, logger),
+		kubeClient:        kubeClient,
+		eventRecorder:     eventRecorder,
+		logger:            logger,
+	}
+}
+
+// Reconcile should be invoked by: changes on a loop node, or changes on a node which controlled by loop node.
+//
+// Loop node spawns one child node for each iteration, the iterations are performed one by one, like the serial node
+// with the same child repeated. The index and the item of the iteration are recorded in the spec of the child node,
+// and inherited by all its descendants, the references of them in the templates are resolved while rendering.
+// The loop node is accomplished after all the iterations finished, and it's failed if any of the iterations failed.
+func (it *LoopNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		it.logger.V(4).Info("Finished syncing for loop node",
+			"node", request.NamespacedName,
+			"duration", time.Since(startTime),
+		)
+	}()
+
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// only resolve loop nodes
+	if node.Spec.Type != v1alpha1.TypeLoop {
+		return reconcile.Result{}, nil
+	}
+
+	it.logger.V(4).Info("resolve loop node", "node", request)
+
+	err = it.syncChildNodes(ctx, node)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// update status
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		activeChildren, finishedChildren, err := it.fetchChildNodes(ctx, nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		nodeNeedUpdate.Status.FinishedChildren = nil
+		for _, finishedChild := range finishedChildren {
+			nodeNeedUpdate.Status.FinishedChildren = append(nodeNeedUpdate.Status.FinishedChildren,
+				corev1.LocalObjectReference{
+					Name: finishedChild.Name,
+				})
+		}
+
+		nodeNeedUpdate.Status.ActiveChildren = nil
+		for _, activeChild := range activeChildren {
+			nodeNeedUpdate.Status.ActiveChildren = append(nodeNeedUpdate.Status.ActiveChildren,
+				corev1.LocalObjectReference{
+					Name: activeChild.Name,
+				})
+		}
+
+		markFailedByChildren(&nodeNeedUpdate.Status, finishedChildren)
+
+		if nodeNeedUpdate.Spec.Loop != nil && len(finishedChildren) >= nodeNeedUpdate.Spec.Loop.Iterations() {
+			if !WorkflowNodeFinished(nodeNeedUpdate.Status) {
+				it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+			}
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionTrue,
+				Reason: "",
+			})
+		} else {
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionFalse,
+				Reason: "",
+			})
+		}
+
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+
+	if updateError != nil {
+		it.logger.Error(updateError, "failed to update the status of node", "node", request)
+		return reconcile.Result{}, updateError
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// syncChildNodes spawns the child node for the next iteration, if there is no active iteration.
+func (it *LoopNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha1.WorkflowNode) error {
+	if WorkflowNodeFinished(node.Status) || WorkflowNodePaused(node) {
+		return nil
+	}
+
+	if node.Spec.Loop == nil || len(node.Spec.Children) != 1 {
+		it.logger.Info("loop node should contain loop spec and exactly one child, NOOP",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		)
+		return nil
+	}
+
+	activeChildNodes, finishedChildNodes, err := it.fetchChildNodes(ctx, node)
+	if err != nil {
+		return err
+	}
+	if len(activeChildNodes) > 0 {
+		it.logger.V(4).Info("loop node has active child, skip scheduling",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"active children", activeChildNodes)
+		return nil
+	}
+
+	index := len(finishedChildNodes)
+	if index >= node.Spec.Loop.Iterations() {
+		return nil
+	}
+
+	parentWorkflow := v1alpha1.Workflow{}
+	err = it.kubeClient.Get(ctx, types.NamespacedName{
+		Namespace: node.Namespace,
+		Name:      node.Spec.WorkflowName,
+	}, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch parent workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"workflow name", node.Spec.WorkflowName)
+		return err
+	}
+
+	outputs, err := fetchWorkflowOutputs(ctx, it.kubeClient, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch outputs of workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return err
+	}
+
+	childNodes, err := renderNodesInIteration(&parentWorkflow, &node, outputs, loopIteration(*node.Spec.Loop, index), node.Spec.Children[0])
+	if err != nil {
+		it.logger.Error(err, "failed to render children childNodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return failByUnresolvedOutputs(ctx, it.kubeClient, it.eventRecorder, &node, err)
+	}
+
+	var childrenNames []string
+	for _, childNode := range childNodes {
+		err := it.kubeClient.Create(ctx, childNode)
+		if err != nil {
+			it.logger.Error(err, "failed to create child node",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"child node", childNode)
+			return err
+		}
+		childrenNames = append(childrenNames, childNode.Name)
+	}
+	it.eventRecorder.Event(&node, recorder.NodesCreated{ChildNodes: childrenNames})
+	it.logger.Info("loop node spawn new iteration",
+		"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		"index", index,
+		"child node", childrenNames)
+
+	return nil
+}
+
+// loopIteration returns the iteration with the given index of the loop
+func loopIteration(loop v1alpha1.LoopSpec, index int) *v1alpha1.LoopIteration {
+	iteration := &v1alpha1.LoopIteration{
+		Index: index,
+	}
+	if loop.Count == nil && index < len(loop.WithItems) {
+		iteration.Item = loop.WithItems[index]
+	}
+	return iteration
+}
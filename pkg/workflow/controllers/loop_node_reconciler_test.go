@@ -0,0 +1,185 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// integration tests
+var _ = Describe("Workflow", func() {
+	var ns string
+	BeforeEach(func() {
+		ctx := context.TODO()
+		newNs := corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "chaos-mesh-",
+			},
+			Spec: corev1.NamespaceSpec{},
+		}
+		Expect(kubeClient.Create(ctx, &newNs)).To(Succeed())
+		ns = newNs.Name
+		By(fmt.Sprintf("create new namespace %s", ns))
+	})
+
+	AfterEach(func() {
+		ctx := context.TODO()
+		nsToDelete := corev1.Namespace{}
+		Expect(kubeClient.Get(ctx, types.NamespacedName{Name: ns}, &nsToDelete)).To(Succeed())
+		Expect(kubeClient.Delete(ctx, &nsToDelete)).To(Succeed())
+		By(fmt.Sprintf("cleanup namespace %s", ns))
+	})
+
+	Context("with one loop node", func() {
+		It("should spawn the iterations one by one", func() {
+			By("create simple workflow")
+			ctx := context.TODO()
+
+			suspendDuration := 2 * time.Second
+			suspendDurationString := suspendDuration.String()
+			toleratedJitter := 10 * time.Second
+
+			simpleLoopWorkflow := v1alpha1.Workflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "simple-loop",
+					Namespace: ns,
+				},
+				Spec: v1alpha1.WorkflowSpec{
+					Entry: "loop",
+					Templates: []v1alpha1.Template{
+						{
+							Name:     "loop",
+							Type:     v1alpha1.TypeLoop,
+							Children: []string{"suspend"},
+							Loop: &v1alpha1.LoopSpec{
+								WithItems: []string{"a", "b", "c"},
+							},
+						}, {
+							Name:     "suspend",
+							Type:     v1alpha1.TypeSuspend,
+							Deadline: &suspendDurationString,
+						},
+					},
+				},
+			}
+			Expect(kubeClient.Create(ctx, &simpleLoopWorkflow)).To(Succeed())
+
+			By("assert that all the iterations have been spawned")
+			Eventually(func() []v1alpha1.LoopIteration {
+				workflowNodeList := v1alpha1.WorkflowNodeList{}
+				Expect(kubeClient.List(ctx, &workflowNodeList, &client.ListOptions{Namespace: ns})).To(Succeed())
+				var iterations []v1alpha1.LoopIteration
+				for _, item := range workflowNodeList.Items {
+					if item.Spec.Type == v1alpha1.TypeSuspend && item.Spec.Iteration != nil {
+						iterations = append(iterations, *item.Spec.Iteration)
+					}
+				}
+				return iterations
+			}, 3*suspendDuration+toleratedJitter, time.Second).Should(ConsistOf(
+				v1alpha1.LoopIteration{Index: 0, Item: "a"},
+				v1alpha1.LoopIteration{Index: 1, Item: "b"},
+				v1alpha1.LoopIteration{Index: 2, Item: "c"},
+			))
+
+			By("assert that the loop node has been accomplished")
+			Eventually(func() bool {
+				workflowNodeList := v1alpha1.WorkflowNodeList{}
+				Expect(kubeClient.List(ctx, &workflowNodeList, &client.ListOptions{Namespace: ns})).To(Succeed())
+				for _, item := range workflowNodeList.Items {
+					if item.Spec.Type == v1alpha1.TypeLoop {
+						return ConditionEqualsTo(item.Status, v1alpha1.ConditionAccomplished, corev1.ConditionTrue)
+					}
+				}
+				return false
+			}, suspendDuration+toleratedJitter, time.Second).Should(BeTrue())
+		})
+	})
+})
+
+func Test_loopIteration(t *testing.T) {
+	count := 2
+	tests := []struct {
+		name  string
+		loop  v1alpha1.LoopSpec
+		index int
+		want  *v1alpha1.LoopIteration
+	}{
+		{
+			name:  "loop with count",
+			loop:  v1alpha1.LoopSpec{Count: &count},
+			index: 1,
+			want:  &v1alpha1.LoopIteration{Index: 1},
+		}, {
+			name:  "loop with items",
+			loop:  v1alpha1.LoopSpec{WithItems: []string{"a", "b"}},
+			index: 1,
+			want:  &v1alpha1.LoopIteration{Index: 1, Item: "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := loopIteration(tt.loop, tt.index); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("loopIteration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_renderNodesInIteration(t *testing.T) {
+	g := NewWithT(t)
+
+	workflow := &v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "loop"},
+		Spec: v1alpha1.WorkflowSpec{
+			Templates: []v1alpha1.Template{{
+				Name: "kill",
+				Type: v1alpha1.TypeTask,
+				Task: &v1alpha1.Task{
+					Container: &corev1.Container{
+						Name:  "main",
+						Image: "busybox",
+						Args:  []string{"--pod", "{{item}}", "--round", "{{index}}"},
+					},
+				},
+			}},
+		},
+	}
+	loop := &v1alpha1.WorkflowNode{ObjectMeta: metav1.ObjectMeta{Name: "loop-node"}}
+
+	nodes, err := renderNodesInIteration(workflow, loop, nil, &v1alpha1.LoopIteration{Index: 1, Item: "web-1"}, "kill")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(nodes).Should(HaveLen(1))
+	g.Expect(nodes[0].Spec.Task.Container.Args).Should(Equal([]string{"--pod", "web-1", "--round", "1"}))
+	g.Expect(nodes[0].Spec.Iteration).Should(Equal(&v1alpha1.LoopIteration{Index: 1, Item: "web-1"}))
+
+	// the descendants inherit the iteration
+	nodes, err = renderNodesByTemplates(workflow, nodes[0], nil, "kill")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(nodes[0].Spec.Task.Container.Args).Should(Equal([]string{"--pod", "web-1", "--round", "1"}))
+}
@@ -34,14 +34,34 @@ var (
 )
 
 // renderNodesByTemplates will render the nodes one by one, will setup owner by given parent. If parent is nil, it will use workflow as its owner.
-func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.WorkflowNode, templates ...string) ([]*v1alpha1.WorkflowNode, error) {
+// The references of outputs in the templates are resolved with the given outputs, which are built with fetchWorkflowOutputs.
+// The nodes inherit the iteration of loop node from the parent.
+func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.WorkflowNode, outputs map[string]string, templates ...string) ([]*v1alpha1.WorkflowNode, error) {
+	var iteration *v1alpha1.LoopIteration
+	if parent != nil {
+		iteration = parent.Spec.Iteration
+	}
+	return renderNodesInIteration(workflow, parent, outputs, iteration, templates...)
+}
+
+// renderNodesInIteration is the same as renderNodesByTemplates, but the nodes belong to the given iteration of loop node,
+// the references of the item and the index in the templates are resolved with it.
+func renderNodesInIteration(workflow *v1alpha1.Workflow, parent *v1alpha1.WorkflowNode, outputs map[string]string, iteration *v1alpha1.LoopIteration, templates ...string) ([]*v1alpha1.WorkflowNode, error) {
 	templateNameSet := make(map[string]v1alpha1.Template)
 	for _, template := range workflow.Spec.Templates {
 		templateNameSet[template.Name] = template
 	}
 	var result []*v1alpha1.WorkflowNode
 	for _, name := range templates {
-		if template, ok := templateNameSet[name]; ok {
+		if origin, ok := templateNameSet[name]; ok {
+			template, err := origin.ResolveOutputs(outputs)
+			if err != nil {
+				return nil, &outputsResolveError{template: name, err: err}
+			}
+			template, err = template.ResolveIteration(iteration)
+			if err != nil {
+				return nil, err
+			}
 
 			now := metav1.NewTime(time.Now())
 			var deadline *metav1.Time = nil
@@ -74,6 +94,16 @@ func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.Workfl
 					Schedule:             conversionSchedule(template.Schedule),
 					StatusCheck:          template.StatusCheck,
 					AbortWithStatusCheck: template.AbortWithStatusCheck,
+					Loop:                 template.Loop,
+					Retry:                template.Retry,
+					Approval:             template.Approval,
+					DAG:                  template.DAG,
+					HTTPRequest:          template.HTTPRequest,
+					K8sApply:             template.K8sApply,
+					K8sWait:              template.K8sWait,
+					SubWorkflow:          template.SubWorkflow,
+					Outputs:              template.Outputs,
+					Iteration:            iteration.DeepCopy(),
 				},
 			}
 
@@ -0,0 +1,191 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/workflow/task/collector"
+)
+
+// fetchWorkflowOutputs returns the outputs of all the nodes in the workflow, the keys are built with v1alpha1.OutputKey.
+// If there are several nodes of the same template, such as the iterations of loop node, the latest one wins.
+func fetchWorkflowOutputs(ctx context.Context, kubeClient client.Client, workflow *v1alpha1.Workflow) (map[string]string, error) {
+	nodes := v1alpha1.WorkflowNodeList{}
+	err := kubeClient.List(ctx, &nodes, client.InNamespace(workflow.Namespace), client.MatchingLabels{
+		v1alpha1.LabelWorkflow: workflow.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortedNodes := SortByCreationTimestamp(nodes.Items)
+	sort.Sort(sortedNodes)
+
+	result := make(map[string]string)
+	for _, node := range sortedNodes {
+		for name, value := range node.Status.Outputs {
+			result[v1alpha1.OutputKey(node.Spec.TemplateName, name)] = value
+		}
+	}
+	return result, nil
+}
+
+// outputsResolveError is returned by rendering the nodes if the outputs referred by the template could not be resolved,
+// such as the referred node has finished without the value of the output. It could not be fixed by retrying.
+type outputsResolveError struct {
+	template string
+	err      error
+}
+
+func (e *outputsResolveError) Error() string {
+	return fmt.Sprintf("template %s: %s", e.template, e.err)
+}
+
+// failByUnresolvedOutputs marks the node as failed with the reason OutputsResolveFailed if its children could not be
+// rendered because of the unresolved outputs, so it's finished instead of being requeued forever. Other errors are
+// returned as they are.
+func failByUnresolvedOutputs(ctx context.Context, kubeClient client.Client, eventRecorder recorder.ChaosRecorder, node *v1alpha1.WorkflowNode, err error) error {
+	var resolveErr *outputsResolveError
+	if !errors.As(err, &resolveErr) {
+		return err
+	}
+
+	eventRecorder.Event(node, recorder.OutputsResolveFailed{Template: resolveErr.template, Err: resolveErr.err.Error()})
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := kubeClient.Get(ctx, types.NamespacedName{
+			Namespace: node.Namespace,
+			Name:      node.Name,
+		}, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+			Type:   v1alpha1.ConditionFailed,
+			Status: corev1.ConditionTrue,
+			Reason: v1alpha1.OutputsResolveFailed,
+		})
+		return kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+}
+
+// outputsResolveFailed returns true if the node has failed to resolve the outputs referred by its children
+func outputsResolveFailed(status v1alpha1.WorkflowNodeStatus) bool {
+	condition := GetCondition(status, v1alpha1.ConditionFailed)
+	return condition != nil && condition.Status == corev1.ConditionTrue && condition.Reason == v1alpha1.OutputsResolveFailed
+}
+
+// collectTaskOutputs collects the outputs of the task from the stdout and the termination message of the container
+func collectTaskOutputs(outputs []v1alpha1.NodeOutput, pod corev1.Pod, containerName string, env map[string]interface{}) (map[string]string, error) {
+	if len(outputs) == 0 {
+		return nil, nil
+	}
+
+	var stdout interface{}
+	stdoutParsed := false
+
+	result := make(map[string]string)
+	for _, output := range outputs {
+		switch {
+		case output.StdoutField != nil:
+			if !stdoutParsed {
+				raw, _ := env[collector.Stdout].(string)
+				if err := json.Unmarshal([]byte(raw), &stdout); err != nil {
+					return nil, errors.Wrap(err, "parse stdout of task as JSON")
+				}
+				stdoutParsed = true
+			}
+			value, err := lookupJSONField(stdout, *output.StdoutField)
+			if err != nil {
+				return nil, errors.Wrapf(err, "collect output %s", output.Name)
+			}
+			result[output.Name] = value
+		case output.File != nil:
+			message, ok := terminationMessage(pod, containerName)
+			if !ok {
+				return nil, errors.Errorf("collect output %s: container %s is not terminated", output.Name, containerName)
+			}
+			result[output.Name] = strings.TrimSpace(message)
+		}
+	}
+	return result, nil
+}
+
+// lookupJSONField returns the field of the JSON value with the dot-separated path, the field is returned as it is if
+// it's a string, otherwise it's encoded as JSON.
+func lookupJSONField(value interface{}, path string) (string, error) {
+	current := value
+	for _, key := range strings.Split(path, ".") {
+		switch typed := current.(type) {
+		case map[string]interface{}:
+			next, ok := typed[key]
+			if !ok {
+				return "", errors.Errorf("field %s not found", path)
+			}
+			current = next
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(typed) {
+				return "", errors.Errorf("field %s not found", path)
+			}
+			current = typed[index]
+		default:
+			return "", errors.Errorf("field %s not found", path)
+		}
+	}
+
+	if str, ok := current.(string); ok {
+		return str, nil
+	}
+	raw, err := json.Marshal(current)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func terminationMessage(pod corev1.Pod, containerName string) (string, bool) {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == containerName && status.State.Terminated != nil {
+			return status.State.Terminated.Message, true
+		}
+	}
+	return "", false
+}
+
+// fileOutput returns the output of the task which comes from file
+func fileOutput(outputs []v1alpha1.NodeOutput) *v1alpha1.NodeOutput {
+	for i := range outputs {
+		if outputs[i].File != nil {
+			return &outputs[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/workflow/task/collector"
+)
+
+func Test_lookupJSONField(t *testing.T) {
+	value := map[string]interface{}{
+		"pod": map[string]interface{}{
+			"name": "web-0",
+		},
+		"latency": 120.5,
+		"pods":    []interface{}{"web-0", "web-1"},
+	}
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{name: "nested string", path: "pod.name", want: "web-0"},
+		{name: "number", path: "latency", want: "120.5"},
+		{name: "object", path: "pod", want: `{"name":"web-0"}`},
+		{name: "index of array", path: "pods.1", want: "web-1"},
+		{name: "missing field", path: "pod.namespace", wantErr: true},
+		{name: "index out of range", path: "pods.2", wantErr: true},
+		{name: "field of string", path: "pod.name.first", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lookupJSONField(value, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("lookupJSONField() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("lookupJSONField() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_collectTaskOutputs(t *testing.T) {
+	g := NewWithT(t)
+
+	stdoutField := "pod.name"
+	file := "/tmp/latency"
+	outputs := []v1alpha1.NodeOutput{
+		{Name: "pod", StdoutField: &stdoutField},
+		{Name: "latency", File: &file},
+	}
+	pod := corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: "main",
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{Message: "120ms\n"},
+				},
+			}},
+		},
+	}
+
+	got, err := collectTaskOutputs(outputs, pod, "main", map[string]interface{}{
+		collector.Stdout: `{"pod": {"name": "web-0"}}`,
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(got).Should(Equal(map[string]string{"pod": "web-0", "latency": "120ms"}))
+
+	_, err = collectTaskOutputs(outputs, pod, "main", map[string]interface{}{
+		collector.Stdout: "not json",
+	})
+	g.Expect(err).Should(HaveOccurred())
+
+	_, err = collectTaskOutputs(outputs[1:], corev1.Pod{}, "main", nil)
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func Test_fetchWorkflowOutputs(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	workflow := &v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "workflow"}}
+	now := time.Now()
+	newNode := func(name string, template string, workflowName string, created time.Time, outputs map[string]string) *v1alpha1.WorkflowNode {
+		return &v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "default",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels:            map[string]string{v1alpha1.LabelWorkflow: workflowName},
+			},
+			Spec:   v1alpha1.WorkflowNodeSpec{TemplateName: template},
+			Status: v1alpha1.WorkflowNodeStatus{Outputs: outputs},
+		}
+	}
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newNode("measure-0", "measure", "workflow", now.Add(-2*time.Minute), map[string]string{"latency": "100ms"}),
+		newNode("measure-1", "measure", "workflow", now.Add(-time.Minute), map[string]string{"latency": "120ms"}),
+		newNode("discover-0", "discover", "workflow", now.Add(-3*time.Minute), map[string]string{"pod": "web-0"}),
+		newNode("discover-1", "discover", "another-workflow", now, map[string]string{"pod": "web-1"}),
+	).Build()
+
+	got, err := fetchWorkflowOutputs(context.Background(), kubeClient, workflow)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(got).Should(Equal(map[string]string{
+		"measure.latency": "120ms",
+		"discover.pod":    "web-0",
+	}))
+}
+
+func Test_failByUnresolvedOutputs(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	deadline := "{{outputs.measure.latency}}"
+	workflow := &v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "workflow"},
+		Spec: v1alpha1.WorkflowSpec{
+			Entry: "entry",
+			Templates: []v1alpha1.Template{
+				{Name: "entry", Type: v1alpha1.TypeSerial, Children: []string{"measure", "wait"}},
+				{Name: "measure", Type: v1alpha1.TypeTask},
+				{Name: "wait", Type: v1alpha1.TypeSuspend, Deadline: &deadline},
+			},
+		},
+	}
+	node := &v1alpha1.WorkflowNode{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "entry"},
+		Spec:       v1alpha1.WorkflowNodeSpec{TemplateName: "entry", WorkflowName: "workflow", Type: v1alpha1.TypeSerial},
+	}
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(node).WithStatusSubresource(node).Build()
+	ctx := context.Background()
+
+	// the task has finished without collecting the output
+	_, err := renderNodesByTemplates(workflow, node, map[string]string{}, "wait")
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(failByUnresolvedOutputs(ctx, kubeClient, recorder.NewDebugRecorder(), node, err)).To(Succeed())
+
+	updated := v1alpha1.WorkflowNode{}
+	g.Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(node), &updated)).To(Succeed())
+	g.Expect(WorkflowNodeFailed(updated.Status)).To(BeTrue())
+	g.Expect(WorkflowNodeFinished(updated.Status)).To(BeTrue())
+
+	// the reason is kept while the status is synced with the children
+	markFailedByChildren(&updated.Status, []v1alpha1.WorkflowNode{{Status: v1alpha1.WorkflowNodeStatus{
+		Conditions: []v1alpha1.WorkflowNodeCondition{{Type: v1alpha1.ConditionFailed, Status: corev1.ConditionTrue}},
+	}}})
+	g.Expect(GetCondition(updated.Status, v1alpha1.ConditionFailed).Reason).To(Equal(v1alpha1.OutputsResolveFailed))
+
+	nodes, err := renderNodesByTemplates(workflow, node, map[string]string{"measure.latency": "10s"}, "wait")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(nodes[0].Spec.Deadline).ShouldNot(BeNil())
+
+	// the other errors are returned to be retried
+	otherErr := errors.New("connection refused")
+	g.Expect(failByUnresolvedOutputs(ctx, kubeClient, recorder.NewDebugRecorder(), node, otherErr)).To(Equal(otherErr))
+}
@@ -106,6 +106,8 @@ func (it *ParallelNodeReconciler) Reconcile(ctx context.Context, request reconci
 				})
 		}
 
+		markFailedByChildren(&nodeNeedUpdate.Status, finishedChildren)
+
 		// TODO: also check the consistent between spec in task and the spec in child node
 		if len(finishedChildren) == len(nodeNeedUpdate.Spec.Children) {
 			if !WorkflowNodeFinished(nodeNeedUpdate.Status) {
@@ -145,7 +147,7 @@ func (it *ParallelNodeReconciler) syncChildNodes(ctx context.Context, node v1alp
 		return nil
 	}
 
-	if WorkflowNodeFinished(node.Status) {
+	if WorkflowNodeFinished(node.Status) || WorkflowNodePaused(node) {
 		return nil
 	}
 
@@ -206,13 +208,20 @@ func (it *ParallelNodeReconciler) syncChildNodes(ctx context.Context, node v1alp
 		return err
 	}
 
-	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, tasksToStartup...)
+	outputs, err := fetchWorkflowOutputs(ctx, it.kubeClient, &parentWorkflow)
 	if err != nil {
-		it.logger.Error(err, "failed to render children childNodes",
+		it.logger.Error(err, "failed to fetch outputs of workflow",
 			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
 		return err
 	}
 
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, outputs, tasksToStartup...)
+	if err != nil {
+		it.logger.Error(err, "failed to render children childNodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return failByUnresolvedOutputs(ctx, it.kubeClient, it.eventRecorder, &node, err)
+	}
+
 	var childrenNames []string
 	for _, childNode := range childNodes {
 		err := it.kubeClient.Create(ctx, childNode)
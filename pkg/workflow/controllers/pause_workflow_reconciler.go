@@ -0,0 +1,201 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+type PauseWorkflowReconciler struct {
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewPauseWorkflowReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *PauseWorkflowReconciler {
+	return &PauseWorkflowReconciler{
+		kubeClient:    kubeClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+// Reconcile watches `Workflows` and their `WorkflowNodes`, if the workflow has the pause annotation, it will mark all
+// the unfinished nodes as paused with annotation v1alpha1.WorkflowAnnotationPausedAt. When the pause annotation is
+// removed, the deadlines and the other timers of the paused nodes are postponed by the paused duration, then they
+// continue from where they were paused.
+func (it *PauseWorkflowReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	workflow := v1alpha1.Workflow{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &workflow)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	paused := WorkflowPaused(workflow) && !WorkflowConditionEqualsTo(workflow.Status, v1alpha1.WorkflowConditionAccomplished, corev1.ConditionTrue)
+
+	nodes, err := fetchAllNodes(ctx, it.kubeClient, workflow)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "fetch nodes of workflow")
+	}
+
+	now := time.Now()
+	for _, node := range nodes {
+		if WorkflowNodePaused(node) == (paused && !WorkflowNodeFinished(node.Status)) {
+			continue
+		}
+		key := types.NamespacedName{Namespace: node.Namespace, Name: node.Name}
+		updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			nodeNeedUpdate := v1alpha1.WorkflowNode{}
+			err := it.kubeClient.Get(ctx, key, &nodeNeedUpdate)
+			if err != nil {
+				return err
+			}
+			if paused && !WorkflowNodeFinished(nodeNeedUpdate.Status) {
+				if WorkflowNodePaused(nodeNeedUpdate) {
+					return nil
+				}
+				pauseNode(&nodeNeedUpdate, now)
+			} else {
+				if !WorkflowNodePaused(nodeNeedUpdate) {
+					return nil
+				}
+				resumeNode(&nodeNeedUpdate, now)
+			}
+			return it.kubeClient.Update(ctx, &nodeNeedUpdate)
+		})
+		if client.IgnoreNotFound(updateError) != nil {
+			it.logger.Error(updateError, "failed to update pause annotation of node",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"paused", paused,
+			)
+			return reconcile.Result{}, updateError
+		}
+	}
+
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		workflowNeedUpdate := v1alpha1.Workflow{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &workflowNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		pausedCondition := GetWorkflowCondition(workflowNeedUpdate.Status, v1alpha1.WorkflowConditionPaused)
+		if paused {
+			if pausedCondition != nil && pausedCondition.Status == corev1.ConditionTrue {
+				return nil
+			}
+			it.eventRecorder.Event(&workflowNeedUpdate, recorder.WorkflowPaused{})
+			SetWorkflowCondition(&workflowNeedUpdate.Status, v1alpha1.WorkflowCondition{
+				Type:      v1alpha1.WorkflowConditionPaused,
+				Status:    corev1.ConditionTrue,
+				Reason:    v1alpha1.WorkflowPaused,
+				StartTime: &metav1.Time{Time: now},
+			})
+		} else {
+			if pausedCondition == nil || pausedCondition.Status != corev1.ConditionTrue {
+				return nil
+			}
+			pausedDuration := time.Duration(0)
+			if pausedCondition.StartTime != nil {
+				pausedDuration = now.Sub(pausedCondition.StartTime.Time).Round(time.Second)
+			}
+			it.eventRecorder.Event(&workflowNeedUpdate, recorder.WorkflowResumed{PausedDuration: pausedDuration.String()})
+			SetWorkflowCondition(&workflowNeedUpdate.Status, v1alpha1.WorkflowCondition{
+				Type:      v1alpha1.WorkflowConditionPaused,
+				Status:    corev1.ConditionFalse,
+				Reason:    v1alpha1.WorkflowResumed,
+				StartTime: &metav1.Time{Time: now},
+			})
+		}
+		return it.kubeClient.Status().Update(ctx, &workflowNeedUpdate)
+	})
+
+	return reconcile.Result{}, client.IgnoreNotFound(updateError)
+}
+
+// pauseNode records the time when the node is paused.
+func pauseNode(node *v1alpha1.WorkflowNode, now time.Time) {
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	node.Annotations[v1alpha1.WorkflowAnnotationPausedAt] = now.Format(time.RFC3339)
+}
+
+// resumeNode removes the pause record of the node, adds the paused duration to v1alpha1.WorkflowAnnotationPausedDuration,
+// and postpones the deadline of node by the paused duration, so the time spent in pausing does not count for the
+// deadline. The deadline exceeded before pausing is not postponed. The other timers of the node are postponed with
+// WorkflowNodePausedDuration by the reconcilers of them, see postponeStatusTimers.
+func resumeNode(node *v1alpha1.WorkflowNode, now time.Time) {
+	pausedAt, err := time.Parse(time.RFC3339, node.Annotations[v1alpha1.WorkflowAnnotationPausedAt])
+	delete(node.Annotations, v1alpha1.WorkflowAnnotationPausedAt)
+	if err != nil {
+		return
+	}
+	pausedDuration := now.Sub(pausedAt)
+	if pausedDuration <= 0 {
+		return
+	}
+	node.Annotations[v1alpha1.WorkflowAnnotationPausedDuration] = (WorkflowNodePausedDuration(*node) + pausedDuration).String()
+	if node.Spec.Deadline != nil && node.Spec.Deadline.After(pausedAt) {
+		node.Spec.Deadline = &metav1.Time{Time: node.Spec.Deadline.Add(pausedDuration)}
+	}
+}
+
+// postponeStatusTimers postpones the timers in the status of node, such as NextRetryTime, by the paused duration
+// which they have not been postponed by. The status records the postponed duration, so it's safe to call it
+// every time before updating the status.
+func postponeStatusTimers(node *v1alpha1.WorkflowNode) {
+	paused := WorkflowNodePausedDuration(*node)
+	postponed := time.Duration(0)
+	if node.Status.PostponedDuration != nil {
+		postponed = node.Status.PostponedDuration.Duration
+	}
+	if paused == postponed {
+		return
+	}
+	if node.Status.NextRetryTime != nil && paused > postponed {
+		nextRetryTime := metav1.NewTime(node.Status.NextRetryTime.Add(paused - postponed))
+		node.Status.NextRetryTime = &nextRetryTime
+	}
+	node.Status.PostponedDuration = &metav1.Duration{Duration: paused}
+}
+
+// fetchAllNodes returns all the nodes of the workflow, including the nodes spawned by exit handlers.
+func fetchAllNodes(ctx context.Context, kubeClient client.Client, workflow v1alpha1.Workflow) ([]v1alpha1.WorkflowNode, error) {
+	nodeList := v1alpha1.WorkflowNodeList{}
+	err := kubeClient.List(ctx, &nodeList,
+		client.InNamespace(workflow.Namespace),
+		client.MatchingLabels{v1alpha1.LabelWorkflow: workflow.Name},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return nodeList.Items, nil
+}
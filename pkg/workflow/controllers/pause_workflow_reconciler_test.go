@@ -0,0 +1,98 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func Test_pauseAndResumeNode(t *testing.T) {
+	pausedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resumedAt := pausedAt.Add(10 * time.Minute)
+
+	t.Run("postpone deadline by paused duration", func(t *testing.T) {
+		g := NewWithT(t)
+		node := v1alpha1.WorkflowNode{Spec: v1alpha1.WorkflowNodeSpec{
+			Deadline: &metav1.Time{Time: pausedAt.Add(5 * time.Minute)},
+		}}
+		pauseNode(&node, pausedAt)
+		g.Expect(WorkflowNodePaused(node)).Should(BeTrue())
+
+		resumeNode(&node, resumedAt)
+		g.Expect(WorkflowNodePaused(node)).Should(BeFalse())
+		g.Expect(node.Spec.Deadline.Time).Should(Equal(pausedAt.Add(15 * time.Minute)))
+		g.Expect(WorkflowNodePausedDuration(node)).Should(Equal(10 * time.Minute))
+	})
+
+	t.Run("accumulate paused duration", func(t *testing.T) {
+		g := NewWithT(t)
+		node := v1alpha1.WorkflowNode{}
+		pauseNode(&node, pausedAt)
+		resumeNode(&node, resumedAt)
+		pauseNode(&node, resumedAt.Add(time.Minute))
+		resumeNode(&node, resumedAt.Add(6*time.Minute))
+		g.Expect(WorkflowNodePausedDuration(node)).Should(Equal(15 * time.Minute))
+	})
+
+	t.Run("node without deadline", func(t *testing.T) {
+		g := NewWithT(t)
+		node := v1alpha1.WorkflowNode{}
+		pauseNode(&node, pausedAt)
+		resumeNode(&node, resumedAt)
+		g.Expect(WorkflowNodePaused(node)).Should(BeFalse())
+		g.Expect(node.Spec.Deadline).Should(BeNil())
+	})
+
+	t.Run("deadline exceeded before pausing", func(t *testing.T) {
+		g := NewWithT(t)
+		deadline := pausedAt.Add(-time.Minute)
+		node := v1alpha1.WorkflowNode{Spec: v1alpha1.WorkflowNodeSpec{
+			Deadline: &metav1.Time{Time: deadline},
+		}}
+		pauseNode(&node, pausedAt)
+		resumeNode(&node, resumedAt)
+		g.Expect(node.Spec.Deadline.Time).Should(Equal(deadline))
+	})
+}
+
+func Test_postponeStatusTimers(t *testing.T) {
+	g := NewWithT(t)
+
+	nextRetryTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	node := v1alpha1.WorkflowNode{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			v1alpha1.WorkflowAnnotationPausedDuration: "10m",
+		}},
+		Status: v1alpha1.WorkflowNodeStatus{
+			NextRetryTime:     &metav1.Time{Time: nextRetryTime},
+			PostponedDuration: &metav1.Duration{Duration: 4 * time.Minute},
+		},
+	}
+
+	postponeStatusTimers(&node)
+	g.Expect(node.Status.NextRetryTime.Time).Should(Equal(nextRetryTime.Add(6 * time.Minute)))
+	g.Expect(node.Status.PostponedDuration.Duration).Should(Equal(10 * time.Minute))
+
+	// the timers are postponed only once
+	postponeStatusTimers(&node)
+	g.Expect(node.Status.NextRetryTime.Time).Should(Equal(nextRetryTime.Add(6 * time.Minute)))
+}
@@ -0,0 +1,323 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+// RetryNodeReconciler watches on nodes which type is Retry
+type RetryNodeReconciler struct {
+	*ChildNodesFetcher
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewRetryNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *RetryNodeReconciler {
+	return &RetryNodeReconciler{
+		ChildNodesFetcher: NewChildNodesFetcher(kubeClient, logger),
+		kubeClient:        kubeClient,
+		eventRecorder:     eventRecorder,
+		logger:            logger,
+	}
+}
+
+// Reconcile should be invoked by: changes on a retry node, or changes on a node which controlled by retry node.
+//
+// Retry node spawns one child node for each attempt, the number of attempts is recorded in v1alpha1.WorkflowNodeStatus
+// Attempts, and each attempt is labeled with its number. When the latest attempt failed and the limit is not reached,
+// the next attempt is scheduled after the backoff, the time is recorded in v1alpha1.WorkflowNodeStatus NextRetryTime.
+// The retry node is accomplished when one attempt finished without failure, or all the attempts failed, in which
+// case it's also marked as failed.
+func (it *RetryNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		it.logger.V(4).Info("Finished syncing for retry node",
+			"node", request.NamespacedName,
+			"duration", time.Since(startTime),
+		)
+	}()
+
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// only resolve retry nodes
+	if node.Spec.Type != v1alpha1.TypeRetry {
+		return reconcile.Result{}, nil
+	}
+
+	it.logger.V(4).Info("resolve retry node", "node", request)
+
+	requeueAfter, err := it.syncChildNodes(ctx, node)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// update status
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		activeChildren, finishedChildren, err := it.fetchChildNodes(ctx, nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		nodeNeedUpdate.Status.FinishedChildren = nil
+		for _, finishedChild := range finishedChildren {
+			nodeNeedUpdate.Status.FinishedChildren = append(nodeNeedUpdate.Status.FinishedChildren,
+				corev1.LocalObjectReference{
+					Name: finishedChild.Name,
+				})
+		}
+
+		nodeNeedUpdate.Status.ActiveChildren = nil
+		for _, activeChild := range activeChildren {
+			nodeNeedUpdate.Status.ActiveChildren = append(nodeNeedUpdate.Status.ActiveChildren,
+				corev1.LocalObjectReference{
+					Name: activeChild.Name,
+				})
+		}
+
+		postponeStatusTimers(&nodeNeedUpdate)
+
+		attempts, latest := latestAttempt(nodeNeedUpdate, append(activeChildren, finishedChildren...))
+		nodeNeedUpdate.Status.Attempts = attempts
+
+		accomplished := false
+		switch {
+		case latest == nil || !WorkflowNodeFinished(latest.Status):
+			nodeNeedUpdate.Status.NextRetryTime = nil
+		case !WorkflowNodeFailed(latest.Status):
+			nodeNeedUpdate.Status.NextRetryTime = nil
+			accomplished = true
+		case nodeNeedUpdate.Spec.Retry == nil || attempts > nodeNeedUpdate.Spec.Retry.Limit:
+			// all the attempts failed
+			nodeNeedUpdate.Status.NextRetryTime = nil
+			accomplished = true
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: v1alpha1.ChildNodeFailed,
+			})
+		case nodeNeedUpdate.Status.NextRetryTime == nil && !WorkflowNodeFinished(nodeNeedUpdate.Status) && !WorkflowNodePaused(nodeNeedUpdate):
+			backoff, err := retryBackoff(*nodeNeedUpdate.Spec.Retry, attempts-1)
+			if err != nil {
+				return err
+			}
+			nextRetryTime := metav1.NewTime(time.Now().Add(backoff))
+			nodeNeedUpdate.Status.NextRetryTime = &nextRetryTime
+			requeueAfter = &backoff
+			it.eventRecorder.Event(&nodeNeedUpdate, recorder.RetryScheduled{
+				FailedNode: latest.Name,
+				Attempt:    attempts + 1,
+			})
+		}
+
+		if accomplished {
+			if !WorkflowNodeFinished(nodeNeedUpdate.Status) {
+				it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+			}
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionTrue,
+				Reason: "",
+			})
+		} else {
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionFalse,
+				Reason: "",
+			})
+		}
+
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+
+	if updateError != nil {
+		it.logger.Error(updateError, "failed to update the status of node", "node", request)
+		return reconcile.Result{}, updateError
+	}
+
+	if requeueAfter != nil {
+		return reconcile.Result{Requeue: true, RequeueAfter: *requeueAfter}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// syncChildNodes spawns the child node for the next attempt. If the next attempt is waiting for the backoff,
+// it returns the duration to wait.
+func (it *RetryNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha1.WorkflowNode) (*time.Duration, error) {
+	if WorkflowNodeFinished(node.Status) || WorkflowNodePaused(node) {
+		return nil, nil
+	}
+
+	if node.Spec.Retry == nil || len(node.Spec.Children) != 1 {
+		it.logger.Info("retry node should contain retry spec and exactly one child, NOOP",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		)
+		return nil, nil
+	}
+
+	activeChildNodes, finishedChildNodes, err := it.fetchChildNodes(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+	if len(activeChildNodes) > 0 {
+		it.logger.V(4).Info("retry node has active child, skip scheduling",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"active children", activeChildNodes)
+		return nil, nil
+	}
+
+	attempts, latest := latestAttempt(node, finishedChildNodes)
+	if attempts > 0 {
+		if latest == nil {
+			it.logger.Info("the latest attempt of retry node is not found, NOOP",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"attempt", attempts)
+			return nil, nil
+		}
+		if !WorkflowNodeFailed(latest.Status) || attempts > node.Spec.Retry.Limit {
+			return nil, nil
+		}
+		// the next retry time is set while updating the status, and postponed after the node is resumed
+		postponeStatusTimers(&node)
+		if node.Status.NextRetryTime == nil {
+			return nil, nil
+		}
+		if wait := time.Until(node.Status.NextRetryTime.Time); wait > 0 {
+			return &wait, nil
+		}
+	}
+
+	parentWorkflow := v1alpha1.Workflow{}
+	err = it.kubeClient.Get(ctx, types.NamespacedName{
+		Namespace: node.Namespace,
+		Name:      node.Spec.WorkflowName,
+	}, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch parent workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"workflow name", node.Spec.WorkflowName)
+		return nil, err
+	}
+
+	outputs, err := fetchWorkflowOutputs(ctx, it.kubeClient, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch outputs of workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return nil, err
+	}
+
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, outputs, node.Spec.Children[0])
+	if err != nil {
+		it.logger.Error(err, "failed to render children childNodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return nil, failByUnresolvedOutputs(ctx, it.kubeClient, it.eventRecorder, &node, err)
+	}
+
+	var childrenNames []string
+	for _, childNode := range childNodes {
+		childNode.Labels[v1alpha1.LabelRetryAttempt] = strconv.Itoa(attempts + 1)
+		err := it.kubeClient.Create(ctx, childNode)
+		if err != nil {
+			it.logger.Error(err, "failed to create child node",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+				"child node", childNode)
+			return nil, err
+		}
+		childrenNames = append(childrenNames, childNode.Name)
+	}
+	it.eventRecorder.Event(&node, recorder.NodesCreated{ChildNodes: childrenNames})
+	it.logger.Info("retry node spawn new attempt",
+		"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		"attempt", attempts+1,
+		"child node", childrenNames)
+
+	return nil, nil
+}
+
+// latestAttempt returns the number of attempts of the retry node and the child node of the latest attempt, which
+// is nil if it's not in the given children. The number is taken from the labels of the children if it's larger than
+// the one in the status, in case the status was not updated after the attempt was spawned.
+func latestAttempt(node v1alpha1.WorkflowNode, children []v1alpha1.WorkflowNode) (int, *v1alpha1.WorkflowNode) {
+	attempts := node.Status.Attempts
+	for _, child := range children {
+		if attempt, err := strconv.Atoi(child.Labels[v1alpha1.LabelRetryAttempt]); err == nil && attempt > attempts {
+			attempts = attempt
+		}
+	}
+	for i := range children {
+		if children[i].Labels[v1alpha1.LabelRetryAttempt] == strconv.Itoa(attempts) {
+			return attempts, &children[i]
+		}
+	}
+	return attempts, nil
+}
+
+// retryBackoff returns the duration to wait before the next retry, when the given number of retries have been performed.
+// The backoff is doubled for each retry, and limited by MaxBackoff.
+func retryBackoff(spec v1alpha1.RetrySpec, retried int) (time.Duration, error) {
+	if spec.Backoff == nil {
+		return 0, nil
+	}
+	backoff, err := time.ParseDuration(*spec.Backoff)
+	if err != nil {
+		return 0, err
+	}
+
+	var maxBackoff time.Duration
+	if spec.MaxBackoff != nil {
+		maxBackoff, err = time.ParseDuration(*spec.MaxBackoff)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	for i := 0; i < retried; i++ {
+		if (maxBackoff > 0 && backoff >= maxBackoff) || backoff > math.MaxInt64/2 {
+			break
+		}
+		backoff *= 2
+	}
+	if maxBackoff > 0 && backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func Test_retryBackoff(t *testing.T) {
+	backoff := "10s"
+	maxBackoff := "30s"
+	invalid := "ten seconds"
+	tests := []struct {
+		name    string
+		spec    v1alpha1.RetrySpec
+		retried int
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name:    "no backoff",
+			spec:    v1alpha1.RetrySpec{Limit: 3},
+			retried: 2,
+			want:    0,
+		}, {
+			name:    "first retry",
+			spec:    v1alpha1.RetrySpec{Limit: 3, Backoff: &backoff},
+			retried: 0,
+			want:    10 * time.Second,
+		}, {
+			name:    "doubled for each retry",
+			spec:    v1alpha1.RetrySpec{Limit: 3, Backoff: &backoff},
+			retried: 2,
+			want:    40 * time.Second,
+		}, {
+			name:    "limited by max backoff",
+			spec:    v1alpha1.RetrySpec{Limit: 3, Backoff: &backoff, MaxBackoff: &maxBackoff},
+			retried: 2,
+			want:    30 * time.Second,
+		}, {
+			name:    "invalid backoff",
+			spec:    v1alpha1.RetrySpec{Limit: 3, Backoff: &invalid},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := retryBackoff(tt.spec, tt.retried)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("retryBackoff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("retryBackoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_latestAttempt(t *testing.T) {
+	attempt := func(name string, number string) v1alpha1.WorkflowNode {
+		return v1alpha1.WorkflowNode{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{v1alpha1.LabelRetryAttempt: number},
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		attempts     int
+		children     []v1alpha1.WorkflowNode
+		wantAttempts int
+		wantLatest   string
+	}{
+		{
+			name: "no attempt",
+		}, {
+			name:         "latest attempt in status",
+			attempts:     2,
+			children:     []v1alpha1.WorkflowNode{attempt("second", "2"), attempt("first", "1")},
+			wantAttempts: 2,
+			wantLatest:   "second",
+		}, {
+			name:         "status not updated after spawning",
+			attempts:     1,
+			children:     []v1alpha1.WorkflowNode{attempt("first", "1"), attempt("second", "2")},
+			wantAttempts: 2,
+			wantLatest:   "second",
+		}, {
+			name:         "latest attempt not found",
+			attempts:     3,
+			children:     []v1alpha1.WorkflowNode{attempt("first", "1"), attempt("second", "2")},
+			wantAttempts: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := v1alpha1.WorkflowNode{Status: v1alpha1.WorkflowNodeStatus{Attempts: tt.attempts}}
+			attempts, latest := latestAttempt(node, tt.children)
+			if attempts != tt.wantAttempts {
+				t.Errorf("latestAttempt() attempts = %v, want %v", attempts, tt.wantAttempts)
+			}
+			latestName := ""
+			if latest != nil {
+				latestName = latest.Name
+			}
+			if latestName != tt.wantLatest {
+				t.Errorf("latestAttempt() latest = %v, want %v", latestName, tt.wantLatest)
+			}
+		})
+	}
+}
@@ -126,6 +126,8 @@ func (it *SerialNodeReconciler) Reconcile(ctx context.Context, request reconcile
 			it.logger.Info("warning: serial node has more than 1 active children", "namespace", nodeNeedUpdate.Namespace, "name", nodeNeedUpdate.Name, "children", nodeNeedUpdate.Status.ActiveChildren)
 		}
 
+		markFailedByChildren(&nodeNeedUpdate.Status, finishedChildren)
+
 		// TODO: also check the consistent between spec in task and the spec in child node
 		if len(finishedChildren) == len(nodeNeedUpdate.Spec.Children) {
 			if !WorkflowNodeFinished(nodeNeedUpdate.Status) {
@@ -170,7 +172,7 @@ func (it *SerialNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha
 		return nil
 	}
 
-	if WorkflowNodeFinished(node.Status) {
+	if WorkflowNodeFinished(node.Status) || WorkflowNodePaused(node) {
 		return nil
 	}
 
@@ -261,12 +263,19 @@ func (it *SerialNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha
 			"workflow name", node.Spec.WorkflowName)
 		return err
 	}
+	outputs, err := fetchWorkflowOutputs(ctx, it.kubeClient, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch outputs of workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return err
+	}
+
 	// TODO: using ordered id instead of random suffix is better, like StatefulSet, also related to the sorting
-	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, taskToStartup)
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, outputs, taskToStartup)
 	if err != nil {
 		it.logger.Error(err, "failed to render children childNodes",
 			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
-		return err
+		return failByUnresolvedOutputs(ctx, it.kubeClient, it.eventRecorder, &node, err)
 	}
 
 	var childrenNames []string
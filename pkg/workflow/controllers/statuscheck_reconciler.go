@@ -165,6 +165,7 @@ func (it *StatusCheckReconciler) updateNodeStatus(ctx context.Context, request r
 				Status: corev1.ConditionTrue,
 				Reason: v1alpha1.StatusCheckCompleted,
 			})
+			node.Status.Outputs = statusCheckOutputs(node.Spec.Outputs, statusCheck)
 		} else {
 			SetCondition(&node.Status, v1alpha1.WorkflowNodeCondition{
 				Type:   v1alpha1.ConditionAccomplished,
@@ -173,6 +174,14 @@ func (it *StatusCheckReconciler) updateNodeStatus(ctx context.Context, request r
 			})
 		}
 
+		if needToAbort(statusCheck) {
+			SetCondition(&node.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: v1alpha1.StatusCheckFailed,
+			})
+		}
+
 		if node.Spec.AbortWithStatusCheck && needToAbort(statusCheck) {
 			SetCondition(&node.Status, v1alpha1.WorkflowNodeCondition{
 				Type:   v1alpha1.ConditionAborted,
@@ -256,6 +265,25 @@ func getParentWorkflow(ctx context.Context, kubeClient client.Client, node v1alp
 	return &parentWorkflow, nil
 }
 
+// statusCheckOutputs returns the outputs of the completed status check
+func statusCheckOutputs(outputs []v1alpha1.NodeOutput, statusCheck v1alpha1.StatusCheck) map[string]string {
+	if len(outputs) == 0 {
+		return nil
+	}
+	result := make(map[string]string)
+	for _, output := range outputs {
+		if !output.StatusCheckResult {
+			continue
+		}
+		if needToAbort(statusCheck) {
+			result[output.Name] = string(v1alpha1.StatusCheckOutcomeFailure)
+		} else {
+			result[output.Name] = string(v1alpha1.StatusCheckOutcomeSuccess)
+		}
+	}
+	return result
+}
+
 func needToAbort(statusCheck v1alpha1.StatusCheck) bool {
 	if !statusCheck.IsCompleted() {
 		return false
@@ -0,0 +1,304 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+// SubWorkflowNodeReconciler watches on nodes which type is SubWorkflow, and the child workflows created by them.
+type SubWorkflowNodeReconciler struct {
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewSubWorkflowNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *SubWorkflowNodeReconciler {
+	return &SubWorkflowNodeReconciler{
+		kubeClient:    kubeClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+// Reconcile should be invoked by: changes on a SubWorkflow node, or changes on the child workflow.
+//
+// SubWorkflow node creates the child workflow with the same name in its namespace, and waits for it to be
+// accomplished. The outcome of the child workflow is mapped into the conditions of the node. The pause of the node
+// is propagated to the child workflow, and the child workflow is aborted if the node is aborted or its deadline exceeded.
+func (it *SubWorkflowNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		it.logger.V(4).Info("Finished syncing for SubWorkflow node",
+			"node", request.NamespacedName,
+			"duration", time.Since(startTime),
+		)
+	}()
+
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// only resolve SubWorkflow nodes
+	if node.Spec.Type != v1alpha1.TypeSubWorkflow || node.Spec.SubWorkflow == nil {
+		return reconcile.Result{}, nil
+	}
+
+	child := v1alpha1.Workflow{}
+	err = it.kubeClient.Get(ctx, types.NamespacedName{Namespace: node.Namespace, Name: subWorkflowName(node)}, &child)
+	if client.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, err
+	}
+	childExists := err == nil
+
+	// the workflow with the same name is not created by this node, it should never be touched
+	if childExists && !metav1.IsControlledBy(&child, &node) {
+		if WorkflowNodeFinished(node.Status) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, it.markCreateFailed(ctx, node, notControlledChildError(child))
+	}
+
+	if WorkflowNodeFinished(node.Status) {
+		if childExists && !WorkflowConditionEqualsTo(child.Status, v1alpha1.WorkflowConditionAccomplished, corev1.ConditionTrue) {
+			return reconcile.Result{}, it.abortChild(ctx, node, child)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if !childExists {
+		if WorkflowNodePaused(node) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, it.createChild(ctx, node)
+	}
+
+	if WorkflowConditionEqualsTo(child.Status, v1alpha1.WorkflowConditionAccomplished, corev1.ConditionTrue) {
+		return reconcile.Result{}, it.finish(ctx, request, child)
+	}
+
+	if WorkflowNodePaused(node) != WorkflowPaused(child) {
+		return reconcile.Result{}, it.patchChildAnnotation(ctx, child, v1alpha1.WorkflowAnnotationPause, WorkflowNodePaused(node))
+	}
+	return reconcile.Result{}, nil
+}
+
+// subWorkflowName returns the name of the child workflow, which is the same as the node.
+func subWorkflowName(node v1alpha1.WorkflowNode) string {
+	return node.Name
+}
+
+// subWorkflowSpec returns the spec of the child workflow. The referred workflow is resolved by the webhook while
+// the parent workflow is created, it's only resolved here if the webhook is not enabled.
+func (it *SubWorkflowNodeReconciler) subWorkflowSpec(ctx context.Context, node v1alpha1.WorkflowNode) (*v1alpha1.WorkflowSpec, error) {
+	templates := []v1alpha1.Template{{
+		Name:        node.Spec.TemplateName,
+		Type:        v1alpha1.TypeSubWorkflow,
+		SubWorkflow: node.Spec.SubWorkflow.DeepCopy(),
+	}}
+	if errs := v1alpha1.ResolveSubWorkflows(ctx, it.kubeClient, node.Namespace, field.NewPath("spec"), templates); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	return templates[0].SubWorkflow.GetWorkflowSpec()
+}
+
+func (it *SubWorkflowNodeReconciler) createChild(ctx context.Context, node v1alpha1.WorkflowNode) error {
+	spec, err := it.subWorkflowSpec(ctx, node)
+	if err != nil {
+		return it.markCreateFailed(ctx, node, err)
+	}
+
+	isController := true
+	blockOwnerDeletion := true
+	child := v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: node.Namespace,
+			Name:      subWorkflowName(node),
+			Labels: map[string]string{
+				v1alpha1.LabelControlledBy: node.Name,
+				v1alpha1.LabelWorkflow:     node.Spec.WorkflowName,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         ApiVersion,
+				Kind:               KindWorkflowNode,
+				Name:               node.Name,
+				UID:                node.UID,
+				Controller:         &isController,
+				BlockOwnerDeletion: &blockOwnerDeletion,
+			}},
+		},
+		Spec: *spec,
+	}
+
+	err = it.kubeClient.Create(ctx, &child)
+	if apierrors.IsAlreadyExists(err) {
+		existing := v1alpha1.Workflow{}
+		if err := it.kubeClient.Get(ctx, types.NamespacedName{Namespace: child.Namespace, Name: child.Name}, &existing); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if !metav1.IsControlledBy(&existing, &node) {
+			return it.markCreateFailed(ctx, node, notControlledChildError(existing))
+		}
+		return nil
+	}
+	if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) || apierrors.IsBadRequest(err) {
+		// the child workflow is rejected, it won't be accepted by retrying
+		return it.markCreateFailed(ctx, node, err)
+	}
+	if err != nil {
+		it.logger.Error(err, "failed to create the child workflow", "node", node.Name)
+		return err
+	}
+
+	it.eventRecorder.Event(&node, recorder.SubWorkflowCreated{Name: child.Name})
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, types.NamespacedName{Namespace: node.Namespace, Name: node.Name}, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		nodeNeedUpdate.Status.SubWorkflow = &v1alpha1.SubWorkflowStatus{Name: child.Name}
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+	return client.IgnoreNotFound(updateError)
+}
+
+// notControlledChildError is the reason why the node is failed when the workflow with the same name already exists.
+func notControlledChildError(child v1alpha1.Workflow) error {
+	return errors.Errorf("workflow %s/%s already exists and it's not controlled by the node", child.Namespace, child.Name)
+}
+
+// markCreateFailed marks the node as failed because the child workflow could not be created.
+func (it *SubWorkflowNodeReconciler) markCreateFailed(ctx context.Context, node v1alpha1.WorkflowNode, cause error) error {
+	it.logger.Error(cause, "the child workflow could not be created", "node", node.Name)
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, types.NamespacedName{Namespace: node.Namespace, Name: node.Name}, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		if WorkflowNodeFinished(nodeNeedUpdate.Status) {
+			return nil
+		}
+
+		it.eventRecorder.Event(&nodeNeedUpdate, recorder.SubWorkflowCreateFailed{Err: cause.Error()})
+		it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+		SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+			Type:   v1alpha1.ConditionAccomplished,
+			Status: corev1.ConditionTrue,
+			Reason: v1alpha1.SubWorkflowCreateFailed,
+		})
+		SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+			Type:   v1alpha1.ConditionFailed,
+			Status: corev1.ConditionTrue,
+			Reason: v1alpha1.SubWorkflowCreateFailed,
+		})
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+	return client.IgnoreNotFound(updateError)
+}
+
+// finish maps the outcome of the accomplished child workflow into the conditions of the node.
+func (it *SubWorkflowNodeReconciler) finish(ctx context.Context, request reconcile.Request, child v1alpha1.Workflow) error {
+	failed := WorkflowConditionEqualsTo(child.Status, v1alpha1.WorkflowConditionFailed, corev1.ConditionTrue)
+	reason := v1alpha1.SubWorkflowSucceed
+	if failed {
+		reason = v1alpha1.SubWorkflowFailed
+	}
+
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		if WorkflowNodeFinished(nodeNeedUpdate.Status) {
+			return nil
+		}
+
+		nodeNeedUpdate.Status.SubWorkflow = &v1alpha1.SubWorkflowStatus{Name: child.Name}
+		SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+			Type:   v1alpha1.ConditionAccomplished,
+			Status: corev1.ConditionTrue,
+			Reason: reason,
+		})
+		if failed {
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionFailed,
+				Status: corev1.ConditionTrue,
+				Reason: reason,
+			})
+		}
+		err = it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+		it.eventRecorder.Event(&nodeNeedUpdate, recorder.SubWorkflowAccomplished{Name: child.Name, Failed: failed})
+		it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+		return nil
+	})
+	if client.IgnoreNotFound(updateError) != nil {
+		it.logger.Error(updateError, "failed to update the status of node", "node", request)
+		return updateError
+	}
+	return nil
+}
+
+// abortChild aborts the unfinished child workflow after the node is aborted or its deadline exceeded.
+func (it *SubWorkflowNodeReconciler) abortChild(ctx context.Context, node v1alpha1.WorkflowNode, child v1alpha1.Workflow) error {
+	if WorkflowAborted(child) {
+		return nil
+	}
+	if err := it.patchChildAnnotation(ctx, child, v1alpha1.WorkflowAnnotationAbort, true); err != nil {
+		return err
+	}
+	it.eventRecorder.Event(&node, recorder.SubWorkflowAborted{Name: child.Name})
+	return nil
+}
+
+func (it *SubWorkflowNodeReconciler) patchChildAnnotation(ctx context.Context, child v1alpha1.Workflow, key string, value bool) error {
+	mergePatch, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				key: strconv.FormatBool(value),
+			},
+		},
+	})
+	err := it.kubeClient.Patch(ctx, &child, client.RawPatch(types.MergePatchType, mergePatch))
+	if err != nil {
+		return errors.Wrapf(err, "patch annotation %s of the child workflow %s", key, child.Name)
+	}
+	return nil
+}
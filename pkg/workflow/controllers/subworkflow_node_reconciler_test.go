@@ -0,0 +1,171 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+type discardRecorder struct{}
+
+func (discardRecorder) Event(runtime.Object, recorder.ChaosEvent) {}
+
+func newSubWorkflowTestReconciler(objs ...client.Object) (*SubWorkflowNodeReconciler, client.Client) {
+	scheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(scheme)
+	kubeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1alpha1.WorkflowNode{}, &v1alpha1.Workflow{}).
+		Build()
+	return NewSubWorkflowNodeReconciler(kubeClient, discardRecorder{}, logr.Discard()), kubeClient
+}
+
+func newSubWorkflowTestNode(t *testing.T) *v1alpha1.WorkflowNode {
+	deadline := "1m"
+	subWorkflow := &v1alpha1.SubWorkflowSpec{}
+	if err := subWorkflow.SetWorkflowSpec(v1alpha1.WorkflowSpec{
+		Entry:     "suspend",
+		Templates: []v1alpha1.Template{{Name: "suspend", Type: v1alpha1.TypeSuspend, Deadline: &deadline}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return &v1alpha1.WorkflowNode{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "team-a-xxxxx", UID: "node-uid"},
+		Spec: v1alpha1.WorkflowNodeSpec{
+			TemplateName: "team-a",
+			WorkflowName: "game-day",
+			Type:         v1alpha1.TypeSubWorkflow,
+			SubWorkflow:  subWorkflow,
+		},
+	}
+}
+
+func Test_subWorkflowNode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create the child workflow and map its outcome", func(t *testing.T) {
+		g := NewWithT(t)
+		node := newSubWorkflowTestNode(t)
+		reconciler, kubeClient := newSubWorkflowTestReconciler(node)
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: node.Namespace, Name: node.Name}}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		child := v1alpha1.Workflow{}
+		g.Expect(kubeClient.Get(ctx, request.NamespacedName, &child)).Should(Succeed())
+		g.Expect(child.Spec.Entry).Should(Equal("suspend"))
+		g.Expect(child.Labels).Should(HaveKeyWithValue(v1alpha1.LabelWorkflow, "game-day"))
+		g.Expect(metav1.IsControlledBy(&child, node)).Should(BeTrue())
+
+		g.Expect(kubeClient.Get(ctx, request.NamespacedName, node)).Should(Succeed())
+		g.Expect(node.Status.SubWorkflow).Should(Equal(&v1alpha1.SubWorkflowStatus{Name: child.Name}))
+		g.Expect(WorkflowNodeFinished(node.Status)).Should(BeFalse())
+
+		SetWorkflowCondition(&child.Status, v1alpha1.WorkflowCondition{Type: v1alpha1.WorkflowConditionAccomplished, Status: corev1.ConditionTrue})
+		SetWorkflowCondition(&child.Status, v1alpha1.WorkflowCondition{Type: v1alpha1.WorkflowConditionFailed, Status: corev1.ConditionTrue})
+		g.Expect(kubeClient.Status().Update(ctx, &child)).Should(Succeed())
+
+		_, err = reconciler.Reconcile(ctx, request)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(kubeClient.Get(ctx, request.NamespacedName, node)).Should(Succeed())
+		g.Expect(WorkflowNodeFinished(node.Status)).Should(BeTrue())
+		g.Expect(WorkflowNodeFailed(node.Status)).Should(BeTrue())
+		g.Expect(GetCondition(node.Status, v1alpha1.ConditionAccomplished).Reason).Should(Equal(v1alpha1.SubWorkflowFailed))
+	})
+
+	t.Run("propagate pause and abort to the child workflow", func(t *testing.T) {
+		g := NewWithT(t)
+		node := newSubWorkflowTestNode(t)
+		reconciler, kubeClient := newSubWorkflowTestReconciler(node)
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: node.Namespace, Name: node.Name}}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		g.Expect(kubeClient.Get(ctx, request.NamespacedName, node)).Should(Succeed())
+		pauseNode(node, time.Now())
+		g.Expect(kubeClient.Update(ctx, node)).Should(Succeed())
+		_, err = reconciler.Reconcile(ctx, request)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		child := v1alpha1.Workflow{}
+		g.Expect(kubeClient.Get(ctx, request.NamespacedName, &child)).Should(Succeed())
+		g.Expect(WorkflowPaused(child)).Should(BeTrue())
+
+		SetCondition(&node.Status, v1alpha1.WorkflowNodeCondition{Type: v1alpha1.ConditionAborted, Status: corev1.ConditionTrue})
+		g.Expect(kubeClient.Status().Update(ctx, node)).Should(Succeed())
+		_, err = reconciler.Reconcile(ctx, request)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(kubeClient.Get(ctx, request.NamespacedName, &child)).Should(Succeed())
+		g.Expect(WorkflowAborted(child)).Should(BeTrue())
+	})
+
+	t.Run("fail the node if the workflow with the same name is not controlled by it", func(t *testing.T) {
+		g := NewWithT(t)
+		node := newSubWorkflowTestNode(t)
+		existing := &v1alpha1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Namespace: node.Namespace, Name: node.Name},
+			Spec: v1alpha1.WorkflowSpec{
+				Entry:     "suspend",
+				Templates: []v1alpha1.Template{{Name: "suspend", Type: v1alpha1.TypeSuspend}},
+			},
+		}
+		reconciler, kubeClient := newSubWorkflowTestReconciler(node, existing)
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: node.Namespace, Name: node.Name}}
+
+		g.Expect(reconciler.createChild(ctx, *node)).Should(Succeed())
+		g.Expect(kubeClient.Get(ctx, request.NamespacedName, node)).Should(Succeed())
+		g.Expect(WorkflowNodeFailed(node.Status)).Should(BeTrue())
+		g.Expect(GetCondition(node.Status, v1alpha1.ConditionAccomplished).Reason).Should(Equal(v1alpha1.SubWorkflowCreateFailed))
+
+		// the finished node leaves the workflow alone
+		_, err := reconciler.Reconcile(ctx, request)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		child := v1alpha1.Workflow{}
+		g.Expect(kubeClient.Get(ctx, request.NamespacedName, &child)).Should(Succeed())
+		g.Expect(WorkflowAborted(child)).Should(BeFalse())
+	})
+
+	t.Run("fail the node if the referred workflow does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		node := newSubWorkflowTestNode(t)
+		node.Spec.SubWorkflow = &v1alpha1.SubWorkflowSpec{WorkflowRef: &v1alpha1.SubWorkflowReference{Name: "not-exist"}}
+		reconciler, kubeClient := newSubWorkflowTestReconciler(node)
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: node.Namespace, Name: node.Name}}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(kubeClient.Get(ctx, request.NamespacedName, node)).Should(Succeed())
+		g.Expect(WorkflowNodeFailed(node.Status)).Should(BeTrue())
+		g.Expect(GetCondition(node.Status, v1alpha1.ConditionAccomplished).Reason).Should(Equal(v1alpha1.SubWorkflowCreateFailed))
+	})
+}
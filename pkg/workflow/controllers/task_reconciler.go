@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -37,6 +38,11 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/workflow/task/collector"
 )
 
+const (
+	EnvLoopIndex = "CHAOS_MESH_LOOP_INDEX"
+	EnvLoopItem  = "CHAOS_MESH_LOOP_ITEM"
+)
+
 type TaskReconciler struct {
 	*ChildNodesFetcher
 	kubeClient    client.Client
@@ -83,7 +89,8 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 		return reconcile.Result{}, err
 	}
 
-	if len(pods) == 0 {
+	// the task pod is not spawned when the workflow is paused
+	if len(pods) == 0 && !WorkflowNodePaused(node) {
 		if workflowName, ok := node.Labels[v1alpha1.LabelWorkflow]; ok {
 			parentWorkflow := v1alpha1.Workflow{}
 			err := it.kubeClient.Get(ctx, types.NamespacedName{
@@ -158,6 +165,21 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 					}
 				}
 
+				outputs, err := collectTaskOutputs(nodeNeedUpdate.Spec.Outputs, pods[0], nodeNeedUpdate.Spec.Task.Container.Name, env)
+				if err != nil {
+					it.logger.Error(err, "failed to collect outputs from task",
+						"task", fmt.Sprintf("%s/%s", nodeNeedUpdate.Namespace, nodeNeedUpdate.Name),
+					)
+					it.eventRecorder.Event(&nodeNeedUpdate, recorder.Failed{Activity: "collect outputs", Err: err.Error()})
+					SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+						Type:   v1alpha1.ConditionFailed,
+						Status: corev1.ConditionTrue,
+						Reason: v1alpha1.OutputsCollectFailed,
+					})
+				} else {
+					nodeNeedUpdate.Status.Outputs = outputs
+				}
+
 				evaluator := task.NewEvaluator(it.logger, it.kubeClient)
 				evaluateConditionBranches, err := evaluator.EvaluateConditionBranches(nodeNeedUpdate.Spec.ConditionalBranches, env)
 				if err != nil {
@@ -169,6 +191,14 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 
 				nodeNeedUpdate.Status.ConditionalBranchesStatus.Branches = evaluateConditionBranches
 
+				if pods[0].Status.Phase == corev1.PodFailed {
+					SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+						Type:   v1alpha1.ConditionFailed,
+						Status: corev1.ConditionTrue,
+						Reason: v1alpha1.TaskPodFailed,
+					})
+				}
+
 				var selectedBranches []string
 				for _, item := range evaluateConditionBranches {
 					if item.EvaluationResult == corev1.ConditionTrue {
@@ -278,6 +308,8 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 			if err != nil {
 				return err
 			}
+			markFailedByChildren(&nodeNeedUpdate.Status, finishedChildren)
+
 			if evaluated && len(finishedChildren) == len(tasks) {
 				if !WorkflowNodeFinished(nodeNeedUpdate.Status) {
 					it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
@@ -306,6 +338,9 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 }
 
 func (it *TaskReconciler) syncChildNodes(ctx context.Context, evaluatedNode v1alpha1.WorkflowNode) error {
+	if WorkflowNodePaused(evaluatedNode) {
+		return nil
+	}
 
 	var tasks []string
 	for _, branch := range evaluatedNode.Status.ConditionalBranchesStatus.Branches {
@@ -371,13 +406,20 @@ func (it *TaskReconciler) syncChildNodes(ctx context.Context, evaluatedNode v1al
 		return err
 	}
 
-	childNodes, err := renderNodesByTemplates(&parentWorkflow, &evaluatedNode, tasks...)
+	outputs, err := fetchWorkflowOutputs(ctx, it.kubeClient, &parentWorkflow)
 	if err != nil {
-		it.logger.Error(err, "failed to render children childNodes",
+		it.logger.Error(err, "failed to fetch outputs of workflow",
 			"node", fmt.Sprintf("%s/%s", evaluatedNode.Namespace, evaluatedNode.Name))
 		return err
 	}
 
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &evaluatedNode, outputs, tasks...)
+	if err != nil {
+		it.logger.Error(err, "failed to render children childNodes",
+			"node", fmt.Sprintf("%s/%s", evaluatedNode.Namespace, evaluatedNode.Name))
+		return failByUnresolvedOutputs(ctx, it.kubeClient, it.eventRecorder, &evaluatedNode, err)
+	}
+
 	// TODO: emit event
 	var childrenNames []string
 	for _, childNode := range childNodes {
@@ -430,6 +472,20 @@ func (it *TaskReconciler) SpawnTaskPod(ctx context.Context, node *v1alpha1.Workf
 	if err != nil {
 		return nil, err
 	}
+	if output := fileOutput(node.Spec.Outputs); output != nil {
+		// the output from file is collected as the termination message of the container
+		for i := range podSpec.Containers {
+			if podSpec.Containers[i].Name == node.Spec.Task.Container.Name {
+				podSpec.Containers[i].TerminationMessagePath = *output.File
+				podSpec.Containers[i].TerminationMessagePolicy = corev1.TerminationMessageReadFile
+			}
+		}
+	}
+	if node.Spec.Iteration != nil {
+		for i := range podSpec.Containers {
+			podSpec.Containers[i].Env = append(podSpec.Containers[i].Env, loopIterationEnv(*node.Spec.Iteration)...)
+		}
+	}
 
 	labels := map[string]string{
 		v1alpha1.LabelControlledBy: node.Name,
@@ -471,6 +527,14 @@ func (it *TaskReconciler) SpawnTaskPod(ctx context.Context, node *v1alpha1.Workf
 	return &taskPod, nil
 }
 
+// loopIterationEnv returns the environment variables which pass the iteration of loop node to the task pod
+func loopIterationEnv(iteration v1alpha1.LoopIteration) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{Name: EnvLoopIndex, Value: strconv.Itoa(iteration.Index)},
+		{Name: EnvLoopItem, Value: iteration.Item},
+	}
+}
+
 func (it *TaskReconciler) conditionalBranchesEvaluated(ctx context.Context, node v1alpha1.WorkflowNode) (bool, error) {
 	// task pod should be completed, it's phase should be PodSucceeded or PodFailed
 	pods, err := it.FetchPodControlledByThisWorkflowNode(ctx, node)
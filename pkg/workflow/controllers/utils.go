@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -34,6 +35,10 @@ func SetCondition(status *v1alpha1.WorkflowNodeStatus, condition v1alpha1.Workfl
 	if currentCond != nil && currentCond.Status == condition.Status && currentCond.Reason == condition.Reason {
 		return
 	}
+	// the node is finished by failing to resolve the outputs, keep the reason so that it won't spawn children again
+	if condition.Type == v1alpha1.ConditionFailed && outputsResolveFailed(*status) {
+		return
+	}
 	newConditions := filterOutCondition(status.Conditions, condition.Type)
 	status.Conditions = append(newConditions, condition)
 }
@@ -66,16 +71,66 @@ func filterOutCondition(conditions []v1alpha1.WorkflowNodeCondition, except v1al
 	return newConditions
 }
 
+// WorkflowNodeFinished returns true if the node is accomplished, exceeds the deadline, is aborted, or could not
+// spawn its children because of the unresolved outputs.
 func WorkflowNodeFinished(status v1alpha1.WorkflowNodeStatus) bool {
 	return ConditionEqualsTo(status, v1alpha1.ConditionAccomplished, corev1.ConditionTrue) ||
 		ConditionEqualsTo(status, v1alpha1.ConditionDeadlineExceed, corev1.ConditionTrue) ||
-		ConditionEqualsTo(status, v1alpha1.ConditionAborted, corev1.ConditionTrue)
+		ConditionEqualsTo(status, v1alpha1.ConditionAborted, corev1.ConditionTrue) ||
+		outputsResolveFailed(status)
+}
+
+func WorkflowNodeFailed(status v1alpha1.WorkflowNodeStatus) bool {
+	return ConditionEqualsTo(status, v1alpha1.ConditionFailed, corev1.ConditionTrue)
+}
+
+// anyWorkflowNodeFailed returns true if any of the given nodes has failed
+func anyWorkflowNodeFailed(nodes []v1alpha1.WorkflowNode) bool {
+	for _, item := range nodes {
+		if WorkflowNodeFailed(item.Status) {
+			return true
+		}
+	}
+	return false
+}
+
+// markFailedByChildren sets the condition Failed on the node if any of its finished children has failed.
+// Failed is never reverted once it has been set.
+func markFailedByChildren(status *v1alpha1.WorkflowNodeStatus, finishedChildren []v1alpha1.WorkflowNode) {
+	if anyWorkflowNodeFailed(finishedChildren) {
+		SetCondition(status, v1alpha1.WorkflowNodeCondition{
+			Type:   v1alpha1.ConditionFailed,
+			Status: corev1.ConditionTrue,
+			Reason: v1alpha1.ChildNodeFailed,
+		})
+	}
 }
 
 func WorkflowAborted(workflow v1alpha1.Workflow) bool {
 	return workflow.Annotations[v1alpha1.WorkflowAnnotationAbort] == "true"
 }
 
+func WorkflowPaused(workflow v1alpha1.Workflow) bool {
+	return workflow.Annotations[v1alpha1.WorkflowAnnotationPause] == "true"
+}
+
+// WorkflowNodePaused returns true if the node is paused with its workflow, the paused node should not spawn new
+// child nodes and its deadline is suspended.
+func WorkflowNodePaused(node v1alpha1.WorkflowNode) bool {
+	_, ok := node.Annotations[v1alpha1.WorkflowAnnotationPausedAt]
+	return ok
+}
+
+// WorkflowNodePausedDuration returns the total duration the node has been paused before the latest resuming, the
+// timers of the node are postponed by it.
+func WorkflowNodePausedDuration(node v1alpha1.WorkflowNode) time.Duration {
+	duration, err := time.ParseDuration(node.Annotations[v1alpha1.WorkflowAnnotationPausedDuration])
+	if err != nil {
+		return 0
+	}
+	return duration
+}
+
 func SetWorkflowCondition(status *v1alpha1.WorkflowStatus, condition v1alpha1.WorkflowCondition) {
 	currentCond := GetWorkflowCondition(*status, condition.Type)
 	if currentCond != nil && currentCond.Status == condition.Status && currentCond.Reason == condition.Reason {
@@ -113,6 +113,16 @@ func (it *WorkflowEntryReconciler) Reconcile(ctx context.Context, request reconc
 		}
 	}
 
+	if len(entryNodes) > 0 && WorkflowNodeFinished(entryNodes[0].Status) {
+		// the entry node is finished, spawn the exit handlers if they are not spawned yet
+		err := it.syncExitHandlerNodes(ctx, workflow, entryNodes[0])
+		if err != nil {
+			it.logger.Error(err, "failed to sync exit handler nodes of workflow",
+				"workflow", request.NamespacedName)
+			return reconcile.Result{}, err
+		}
+	}
+
 	// sync the status
 	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		workflowNeedUpdate := v1alpha1.Workflow{}
@@ -153,7 +163,24 @@ func (it *WorkflowEntryReconciler) Reconcile(ctx context.Context, request reconc
 				Reason: "",
 			})
 
+			exitHandlerNodes, err := fetchExitHandlerNodes(ctx, it.kubeClient, workflowNeedUpdate)
+			if err != nil {
+				it.logger.Error(err,
+					"failed to list exit handler nodes of workflow",
+					"workflow", request.NamespacedName,
+				)
+				return err
+			}
+			workflowNeedUpdate.Status.ExitHandlerNodes = nil
+			for _, node := range exitHandlerNodes {
+				workflowNeedUpdate.Status.ExitHandlerNodes = append(workflowNeedUpdate.Status.ExitHandlerNodes, node.Name)
+			}
+
 			if WorkflowNodeFinished(entryNodes[0].Status) {
+				setWorkflowFailedCondition(&workflowNeedUpdate.Status, entryNodes[0], exitHandlerNodes)
+			}
+
+			if WorkflowNodeFinished(entryNodes[0].Status) && exitHandlersFinished(workflowNeedUpdate.Spec, entryNodes[0], exitHandlerNodes) {
 				SetWorkflowCondition(&workflowNeedUpdate.Status, v1alpha1.WorkflowCondition{
 					Type:   v1alpha1.WorkflowConditionAccomplished,
 					Status: corev1.ConditionTrue,
@@ -212,6 +239,11 @@ func fetchEntryNode(ctx context.Context, kubeClient client.Client, workflow v1al
 		MatchLabels: map[string]string{
 			v1alpha1.LabelControlledBy: workflow.Name,
 		},
+		// the nodes of exit handlers are also controlled by the workflow
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      v1alpha1.LabelExitHandler,
+			Operator: metav1.LabelSelectorOpDoesNotExist,
+		}},
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "build label selector")
@@ -234,7 +266,7 @@ func fetchEntryNode(ctx context.Context, kubeClient client.Client, workflow v1al
 // spawnEntryNode will create **one** entry workflow node for current workflow
 func (it *WorkflowEntryReconciler) spawnEntryNode(ctx context.Context, workflow v1alpha1.Workflow) (*v1alpha1.WorkflowNode, error) {
 	// This workflow is just created, create entry node
-	nodes, err := renderNodesByTemplates(&workflow, nil, workflow.Spec.Entry)
+	nodes, err := renderNodesByTemplates(&workflow, nil, nil, workflow.Spec.Entry)
 	if err != nil {
 		it.logger.Error(err, "failed create entry node", "workflow", workflow.Name, "entry", workflow.Spec.Entry)
 		return nil, err
@@ -260,3 +292,70 @@ func (it *WorkflowEntryReconciler) spawnEntryNode(ctx context.Context, workflow
 
 	return entryNode, nil
 }
+
+// syncExitHandlerNodes spawns the nodes of exit handlers with the result of the finished entry node, each exit handler
+// only spawns once for a workflow. Like the entry node, the redundant nodes created with the stale cache are cleaned up.
+func (it *WorkflowEntryReconciler) syncExitHandlerNodes(ctx context.Context, workflow v1alpha1.Workflow, entryNode v1alpha1.WorkflowNode) error {
+	handlers := exitHandlersToRun(workflow.Spec, WorkflowNodeSucceeded(entryNode))
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	exitHandlerNodes, err := fetchExitHandlerNodes(ctx, it.kubeClient, workflow)
+	if err != nil {
+		return err
+	}
+
+	spawned := make(map[string]struct{})
+	for _, node := range exitHandlerNodes {
+		node := node
+		kind := node.Labels[v1alpha1.LabelExitHandler]
+		if _, ok := spawned[kind]; !ok {
+			spawned[kind] = struct{}{}
+			continue
+		}
+		// best effort deletion
+		err := it.kubeClient.Delete(ctx, &node)
+		if err != nil {
+			it.logger.Error(err,
+				"failed to delete redundant exit handler node",
+				"workflow", fmt.Sprintf("%s/%s", workflow.Namespace, workflow.Name),
+				"redundant exit handler node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			)
+		}
+	}
+
+	var handlersToSpawn []exitHandler
+	for _, handler := range handlers {
+		if _, ok := spawned[handler.kind]; !ok {
+			handlersToSpawn = append(handlersToSpawn, handler)
+		}
+	}
+	if len(handlersToSpawn) == 0 {
+		return nil
+	}
+
+	outputs, err := fetchWorkflowOutputs(ctx, it.kubeClient, &workflow)
+	if err != nil {
+		return err
+	}
+	nodes, err := renderExitHandlerNodes(&workflow, outputs, handlersToSpawn)
+	if err != nil {
+		return err
+	}
+
+	var nodeNames []string
+	for _, node := range nodes {
+		err := it.kubeClient.Create(ctx, node)
+		if err != nil {
+			return errors.Wrapf(err, "create exit handler node %s", node.Spec.TemplateName)
+		}
+		nodeNames = append(nodeNames, node.Name)
+	}
+	it.logger.Info("exit handler nodes for workflow created",
+		"workflow", fmt.Sprintf("%s/%s", workflow.Namespace, workflow.Name),
+		"exit handler nodes", nodeNames,
+	)
+	it.eventRecorder.Event(&workflow, recorder.ExitHandlersCreated{Nodes: nodeNames})
+	return nil
+}